@@ -0,0 +1,79 @@
+// Command combined runs the HTTP server and a worker in one process sharing a database
+// pool, for single-machine deployments that don't want to run them separately.  It
+// reads the configuration of both, and starts an embedded database first if
+// VT_DB_EMBEDDED_DIR is set.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"os/signal"
+	"syscall"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtserver"
+	"github.com/krelinga/video-transcoder/vtworker"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatalf("combined error: %v", err)
+	}
+}
+
+func run() error {
+	// Create context that listens for shutdown signals
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Load configuration; both read the same database settings
+	serverCfg := internal.NewServerConfigFromEnv()
+	workerCfg := internal.NewWorkerConfigFromEnv()
+	internal.SetupLogging(serverCfg.LogLevel)
+
+	// Start the embedded database, if there's no database server to connect to
+	if serverCfg.Database.EmbeddedDir != "" {
+		stopDB, err := internal.StartEmbeddedDatabase(serverCfg.Database)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := stopDB(); err != nil {
+				slog.Warn("Failed to stop embedded database", "error", err)
+			}
+		}()
+	}
+
+	// Create database pool
+	pool, err := internal.NewDBPool(ctx, serverCfg.Database)
+	if err != nil {
+		return fmt.Errorf("failed to create database pool: %w", err)
+	}
+	defer pool.Close()
+
+	// Run migrations, or make sure someone else already has
+	if err := internal.SetUpSchema(ctx, pool, serverCfg.AutoMigrate); err != nil {
+		return err
+	}
+
+	// Stop both halves if either fails, and wait for both to shut down
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	serverErr := make(chan error, 1)
+	go func() {
+		err := vtserver.Run(ctx, pool, serverCfg)
+		cancel()
+		serverErr <- err
+	}()
+	workerErr := vtworker.Run(ctx, pool, workerCfg)
+	cancel()
+	if err := errors.Join(<-serverErr, workerErr); err != nil {
+		return err
+	}
+
+	log.Println("Shutdown complete")
+	return nil
+}
@@ -14,7 +14,11 @@ import (
 
 	"github.com/docker/docker/api/types/build"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/krelinga/video-transcoder/internal"
 	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/riverdriver/riverpgxv5"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/network"
 	"github.com/testcontainers/testcontainers-go/wait"
@@ -112,6 +116,15 @@ func TestTranscodeEndToEnd(t *testing.T) {
 		"VT_SERVER_PORT": "8080",
 	}
 
+	// Server additionally accepts an admin key, which purging jobs requires
+	adminKey := "e2e-admin-key"
+	serverEnv := map[string]string{
+		"VT_SERVER_ADMIN_KEYS": adminKey,
+	}
+	for k, v := range dbEnv {
+		serverEnv[k] = v
+	}
+
 	// Build and start server container
 	serverReq := testcontainers.ContainerRequest{
 		FromDockerfile: testcontainers.FromDockerfile{
@@ -123,7 +136,7 @@ func TestTranscodeEndToEnd(t *testing.T) {
 			},
 		},
 		ExposedPorts:   []string{"8080/tcp"},
-		Env:            dbEnv,
+		Env:            serverEnv,
 		Networks:       []string{networkName},
 		NetworkAliases: map[string][]string{networkName: {"server"}},
 		WaitingFor:     wait.ForLog("Starting HTTP server on port 8080"),
@@ -139,6 +152,14 @@ func TestTranscodeEndToEnd(t *testing.T) {
 		dumpContainerLogs(t, ctx, serverContainer, "server")
 	})
 
+	// Worker additionally verifies the media mount before accepting jobs
+	workerEnv := map[string]string{
+		"VT_WORKER_MOUNTS": "/nas/media",
+	}
+	for k, v := range dbEnv {
+		workerEnv[k] = v
+	}
+
 	// Build and start worker container with volume mount
 	workerReq := testcontainers.ContainerRequest{
 		FromDockerfile: testcontainers.FromDockerfile{
@@ -149,7 +170,7 @@ func TestTranscodeEndToEnd(t *testing.T) {
 				buildOptions.Target = "worker"
 			},
 		},
-		Env:            dbEnv,
+		Env:            workerEnv,
 		Networks:       []string{networkName},
 		NetworkAliases: map[string][]string{networkName: {"worker"}},
 		Mounts: testcontainers.Mounts(
@@ -215,7 +236,7 @@ func TestTranscodeEndToEnd(t *testing.T) {
 				t.Fatalf("timeout waiting for transcode to complete after 30 seconds")
 			}
 
-			statusResp, err := client.GetTranscodeStatusWithResponse(ctx, jobUUID)
+			statusResp, err := client.GetTranscodeStatusWithResponse(ctx, jobUUID, nil)
 			if err != nil {
 				t.Fatalf("failed to get transcode status: %v %v", err, statusResp)
 			}
@@ -264,10 +285,10 @@ func TestTranscodeEndToEnd(t *testing.T) {
 		if duplicateResp.StatusCode() != 409 {
 			t.Fatalf("expected 409 response for duplicate UUID, got status %d: %s", duplicateResp.StatusCode(), string(duplicateResp.Body))
 		}
-		if duplicateResp.JSON409 == nil {
+		if duplicateResp.ApplicationproblemJSON409 == nil {
 			t.Fatalf("expected JSON409 response body for duplicate UUID")
 		}
-		t.Logf("Duplicate UUID correctly rejected with 409: %s", duplicateResp.JSON409.Message)
+		t.Logf("Duplicate UUID correctly rejected with 409: %s", duplicateResp.ApplicationproblemJSON409.Message)
 	})
 
 	// Sub-test: Transcode with webhook
@@ -307,7 +328,7 @@ func TestTranscodeEndToEnd(t *testing.T) {
 				t.Fatalf("timeout waiting for transcode to complete after 30 seconds")
 			}
 
-			statusResp, err := client.GetTranscodeStatusWithResponse(ctx, jobUUID)
+			statusResp, err := client.GetTranscodeStatusWithResponse(ctx, jobUUID, nil)
 			if err != nil {
 				t.Fatalf("failed to get transcode status: %v %v", err, statusResp)
 			}
@@ -402,7 +423,7 @@ func TestTranscodeEndToEnd(t *testing.T) {
 				t.Fatalf("timeout waiting for transcode to complete after 30 seconds")
 			}
 
-			statusResp, err := client.GetTranscodeStatusWithResponse(ctx, jobUUID)
+			statusResp, err := client.GetTranscodeStatusWithResponse(ctx, jobUUID, nil)
 			if err != nil {
 				t.Fatalf("failed to get transcode status: %v %v", err, statusResp)
 			}
@@ -466,6 +487,154 @@ func TestTranscodeEndToEnd(t *testing.T) {
 
 		t.Logf("Heartbeat webhooks received successfully with progress updates")
 	})
+
+	// Sub-test: Purged jobs free their UUID, comparison included, for a new job
+	t.Run("purge and resubmit", func(t *testing.T) {
+		jobUUID := uuid.New()
+		request := vtrest.CreateTranscodeJSONRequestBody{
+			Uuid:            jobUUID,
+			SourcePath:      "/nas/media/testdata_sample_640x360.mkv",
+			DestinationPath: "/nas/media/output_purge.mp4",
+			Profile:         "preview",
+			Compare:         &vtrest.CompareOptions{},
+		}
+
+		// Submit the job and wait for both it and its comparison to finish
+		runJob := func() {
+			createResp, err := client.CreateTranscodeWithResponse(ctx, request)
+			if err != nil {
+				t.Fatalf("failed to create transcode job: %v", err)
+			}
+			if createResp.JSON201 == nil {
+				t.Fatalf("expected 201 response, got status %d: %s", createResp.StatusCode(), string(createResp.Body))
+			}
+
+			deadline := time.Now().Add(30 * time.Second)
+			for {
+				if time.Now().After(deadline) {
+					t.Fatalf("timeout waiting for transcode and comparison to complete after 30 seconds")
+				}
+
+				comparisonResp, err := client.GetComparisonWithResponse(ctx, jobUUID)
+				if err != nil {
+					t.Fatalf("failed to get comparison: %v", err)
+				}
+				if comparisonResp.JSON200 != nil {
+					comparison := comparisonResp.JSON200
+					t.Logf("Comparison status: %s", comparison.Status)
+					if comparison.Status == vtrest.Completed {
+						break
+					}
+					if comparison.Status == vtrest.Failed {
+						t.Fatalf("expected comparison to complete successfully, but it failed")
+					}
+				} else if comparisonResp.StatusCode() != 404 {
+					t.Fatalf("expected 200 or 404 response, got status %d: %s", comparisonResp.StatusCode(), string(comparisonResp.Body))
+				}
+
+				time.Sleep(2 * time.Second)
+			}
+		}
+
+		runJob()
+
+		purgeResp, err := client.PurgeTranscodeWithResponse(ctx, jobUUID, func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer "+adminKey)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("failed to purge transcode job: %v", err)
+		}
+		if purgeResp.StatusCode() != 204 {
+			t.Fatalf("expected 204 response, got status %d: %s", purgeResp.StatusCode(), string(purgeResp.Body))
+		}
+
+		comparisonResp, err := client.GetComparisonWithResponse(ctx, jobUUID)
+		if err != nil {
+			t.Fatalf("failed to get comparison: %v", err)
+		}
+		if comparisonResp.StatusCode() != 404 {
+			t.Fatalf("expected comparison to be purged, got status %d: %s", comparisonResp.StatusCode(), string(comparisonResp.Body))
+		}
+
+		t.Logf("Purged transcode job %s, resubmitting it", jobUUID)
+		runJob()
+	})
+
+	// Sub-test: Updating a pending job, and the 409 once a worker has started it
+	t.Run("update pending job", func(t *testing.T) {
+		postgresPort, err := postgresContainer.MappedPort(ctx, "5432")
+		if err != nil {
+			t.Fatalf("failed to get postgres mapped port: %v", err)
+		}
+		postgresHost, err := postgresContainer.Host(ctx)
+		if err != nil {
+			t.Fatalf("failed to get postgres host: %v", err)
+		}
+		pool, err := pgxpool.New(ctx, fmt.Sprintf("postgres://%s:%s@%s:%s/%s", dbUser, dbPassword, postgresHost, postgresPort.Port(), dbName))
+		if err != nil {
+			t.Fatalf("failed to connect to postgres: %v", err)
+		}
+		defer pool.Close()
+		riverClient, err := river.NewClient(riverpgxv5.New(pool), &river.Config{})
+		if err != nil {
+			t.Fatalf("failed to create river client: %v", err)
+		}
+
+		// Schedule the job far enough ahead that the worker leaves it alone
+		jobUUID := uuid.New()
+		inserted, err := riverClient.Insert(ctx, internal.TranscodeJobArgs{
+			UUID:            jobUUID,
+			SourcePath:      "/nas/media/testdata_sample_640x360.mkv",
+			DestinationPath: "/nas/media/output_update.mp4",
+			Profile:         "preview",
+		}, &river.InsertOpts{ScheduledAt: time.Now().Add(time.Hour)})
+		if err != nil {
+			t.Fatalf("failed to insert transcode job: %v", err)
+		}
+		jobID := inserted.Job.ID
+		defer pool.Exec(ctx, "DELETE FROM river_job WHERE id = $1", jobID)
+
+		newDest := "/nas/media/output_updated.mp4"
+		updateResp, err := client.UpdateTranscodeWithResponse(ctx, jobUUID, vtrest.UpdateTranscodeJSONRequestBody{DestinationPath: &newDest})
+		if err != nil {
+			t.Fatalf("failed to update transcode job: %v", err)
+		}
+		if updateResp.JSON200 == nil {
+			t.Fatalf("expected 200 response, got status %d: %s", updateResp.StatusCode(), string(updateResp.Body))
+		}
+		if updateResp.JSON200.DestinationPath != newDest {
+			t.Errorf("expected destination %s, got %s", newDest, updateResp.JSON200.DestinationPath)
+		}
+
+		// An update made from the args as they were before the PATCH lost the race to it
+		var current []byte
+		if err := pool.QueryRow(ctx, "SELECT args FROM river_job WHERE id = $1", jobID).Scan(&current); err != nil {
+			t.Fatalf("failed to read job args: %v", err)
+		}
+		if ok, err := internal.UpdatePendingJob(ctx, pool, jobID, inserted.Job.EncodedArgs, current, 0); err != nil || ok {
+			t.Errorf("expected update from stale args to be refused, got ok=%v err=%v", ok, err)
+		}
+
+		// Start the job as a worker would, after the update read it but before it wrote
+		if _, err := pool.Exec(ctx, "UPDATE river_job SET state = 'running', attempt = 1, attempted_at = now() WHERE id = $1", jobID); err != nil {
+			t.Fatalf("failed to start job: %v", err)
+		}
+		if ok, err := internal.UpdatePendingJob(ctx, pool, jobID, current, current, 1); err != nil || ok {
+			t.Errorf("expected update of started job to be refused, got ok=%v err=%v", ok, err)
+		}
+
+		updateResp, err = client.UpdateTranscodeWithResponse(ctx, jobUUID, vtrest.UpdateTranscodeJSONRequestBody{DestinationPath: &newDest})
+		if err != nil {
+			t.Fatalf("failed to update transcode job: %v", err)
+		}
+		if updateResp.StatusCode() != 409 {
+			t.Fatalf("expected 409 response, got status %d: %s", updateResp.StatusCode(), string(updateResp.Body))
+		}
+		if updateResp.ApplicationproblemJSON409 == nil || updateResp.ApplicationproblemJSON409.Code != "JOB_STARTED" {
+			t.Errorf("expected JOB_STARTED problem, got %s", string(updateResp.Body))
+		}
+	})
 }
 
 // copyFile copies a file from src to dst
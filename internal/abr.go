@@ -0,0 +1,153 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// abrSegmentSeconds is the target length of each streaming segment.
+	abrSegmentSeconds = 6
+	// abrKeyframeSeconds is the keyframe interval.  It divides abrSegmentSeconds so every
+	// rendition can be cut at the same points and players can switch between them.
+	abrKeyframeSeconds = 2
+)
+
+// ABRExtensions are the destination extensions of the abr profile: an HLS master
+// playlist or a DASH manifest.
+var ABRExtensions = []string{".m3u8", ".mpd"}
+
+// DefaultABRLadder is the ladder encoded when an abr job doesn't list its renditions.
+var DefaultABRLadder = []Rendition{
+	{Name: "1080p", Height: 1080, VideoBitrateKbps: ptr(5000)},
+	{Name: "720p", Height: 720, VideoBitrateKbps: ptr(3000)},
+	{Name: "480p", Height: 480, VideoBitrateKbps: ptr(1400)},
+	{Name: "360p", Height: 360, VideoBitrateKbps: ptr(800)},
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// isDASH reports whether destination is a DASH manifest rather than an HLS playlist.
+func isDASH(destination string) bool {
+	return strings.EqualFold(filepath.Ext(destination), ".mpd")
+}
+
+// abrLadder returns the renditions an abr job encodes.
+func abrLadder(renditions []Rendition) []Rendition {
+	if len(renditions) == 0 {
+		return DefaultABRLadder
+	}
+	return renditions
+}
+
+// abrTranscoder encodes an adaptive-bitrate ladder from a single decode of the source
+// and packages it for streaming, as HLS or DASH depending on the destination extension.
+// Segments and variant playlists are written next to the master playlist or manifest.
+type abrTranscoder struct{}
+
+func init() {
+	RegisterTranscoder(TranscoderBackend{
+		Name:     "abr",
+		Profiles: []Profile{ProfileABR},
+		Tools:    []string{ToolFFmpeg, ToolFFprobe},
+		New:      func(Profile) Transcoder { return &abrTranscoder{} },
+	})
+}
+
+func (t *abrTranscoder) Transcode(ctx context.Context, params TranscodeParams) error {
+	totalDuration, err := getDuration(ctx, params.Sandbox, params.SourcePath)
+	if err != nil {
+		return err
+	}
+	audioStreams, err := countStreams(ctx, params.Sandbox, params.SourcePath, "a")
+	if err != nil {
+		return err
+	}
+	args := abrArgs(params.SourcePath, params.DestinationPath, abrLadder(params.Renditions), audioStreams > 0, params.Audio)
+	return runFfmpeg(ctx, params.Sandbox, params.Limits, totalDuration, params.ProgressCallback, params.LogCallback, args...)
+}
+
+// abrArgs returns the ffmpeg arguments that package renditions of source at destination.
+// audio may be nil.
+func abrArgs(source, destination string, renditions []Rendition, hasAudio bool, audio *AudioOptions) []string {
+	dash := isDASH(destination)
+	args := []string{"-i", source, "-filter_complex", renditionFilter(renditions)}
+
+	for i := range renditions {
+		args = append(args, "-map", fmt.Sprintf("[v%d]", i))
+		// HLS variants each carry their own copy of the audio; DASH shares one.
+		if hasAudio && !dash {
+			args = append(args, "-map", "0:a:0")
+		}
+	}
+	if hasAudio && dash {
+		args = append(args, "-map", "0:a:0")
+	}
+
+	args = append(args,
+		"-c:v", "libx264",
+		"-preset", "medium",
+		"-pix_fmt", "yuv420p",
+		"-force_key_frames", fmt.Sprintf("expr:gte(t,n_forced*%d)", abrKeyframeSeconds),
+		"-sc_threshold", "0",
+	)
+	for i, rendition := range renditions {
+		bitrate := strconv.Itoa(*rendition.VideoBitrateKbps)
+		args = append(args,
+			fmt.Sprintf("-b:v:%d", i), bitrate+"k",
+			fmt.Sprintf("-maxrate:v:%d", i), bitrate+"k",
+			fmt.Sprintf("-bufsize:v:%d", i), strconv.Itoa(2**rendition.VideoBitrateKbps)+"k",
+		)
+	}
+	if hasAudio {
+		if audio != nil {
+			args = append(args, audio.ffmpegArgs(AudioCodecAAC)...)
+		} else {
+			args = append(args, "-c:a", "aac", "-b:a", defaultRenditionAudioKbps)
+		}
+	}
+
+	base := strings.TrimSuffix(filepath.Base(destination), filepath.Ext(destination))
+	dir := filepath.Dir(destination)
+	if dash {
+		adaptationSets := "id=0,streams=v"
+		if hasAudio {
+			adaptationSets += " id=1,streams=a"
+		}
+		return append(args,
+			"-f", "dash",
+			"-seg_duration", strconv.Itoa(abrSegmentSeconds),
+			"-use_template", "1",
+			"-use_timeline", "1",
+			"-adaptation_sets", adaptationSets,
+			"-init_seg_name", base+"_init_$RepresentationID$.m4s",
+			"-media_seg_name", base+"_$RepresentationID$_$Number%05d$.m4s",
+			"-y",
+			destination,
+		)
+	}
+
+	streamMap := make([]string, len(renditions))
+	for i, rendition := range renditions {
+		streamMap[i] = fmt.Sprintf("v:%d", i)
+		if hasAudio {
+			streamMap[i] += fmt.Sprintf(",a:%d", i)
+		}
+		streamMap[i] += ",name:" + rendition.Name
+	}
+	return append(args,
+		"-f", "hls",
+		"-hls_time", strconv.Itoa(abrSegmentSeconds),
+		"-hls_playlist_type", "vod",
+		"-hls_segment_filename", filepath.Join(dir, base+"_%v_%05d.ts"),
+		"-master_pl_name", filepath.Base(destination),
+		"-var_stream_map", strings.Join(streamMap, " "),
+		"-y",
+		filepath.Join(dir, base+"_%v.m3u8"),
+	)
+}
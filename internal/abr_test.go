@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestABRArgs(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	renditions := []Rendition{
+		{Name: "720p", Height: 720, VideoBitrateKbps: ptr(3000)},
+		{Name: "360p", Height: 360, VideoBitrateKbps: ptr(800)},
+	}
+	encode := []string{
+		"-c:v", "libx264", "-preset", "medium", "-pix_fmt", "yuv420p",
+		"-force_key_frames", "expr:gte(t,n_forced*2)", "-sc_threshold", "0",
+		"-b:v:0", "3000k", "-maxrate:v:0", "3000k", "-bufsize:v:0", "6000k",
+		"-b:v:1", "800k", "-maxrate:v:1", "800k", "-bufsize:v:1", "1600k",
+		"-c:a", "aac", "-b:a", "128k",
+	}
+	input := []string{
+		"-i", "/media/movie.mkv",
+		"-filter_complex", "[0:v:0]split=2[s0][s1];[s0]scale=-2:720[v0];[s1]scale=-2:360[v1]",
+	}
+
+	tests := []struct {
+		loc         exam.Loc
+		name        string
+		destination string
+		want        []string
+	}{
+		{
+			loc:         exam.Here(),
+			name:        "HLS",
+			destination: "/out/movie.m3u8",
+			want: slices.Concat(input,
+				[]string{"-map", "[v0]", "-map", "0:a:0", "-map", "[v1]", "-map", "0:a:0"},
+				encode,
+				[]string{
+					"-f", "hls",
+					"-hls_time", "6",
+					"-hls_playlist_type", "vod",
+					"-hls_segment_filename", "/out/movie_%v_%05d.ts",
+					"-master_pl_name", "movie.m3u8",
+					"-var_stream_map", "v:0,a:0,name:720p v:1,a:1,name:360p",
+					"-y", "/out/movie_%v.m3u8",
+				}),
+		},
+		{
+			loc:         exam.Here(),
+			name:        "DASH",
+			destination: "/out/movie.mpd",
+			want: slices.Concat(input,
+				[]string{"-map", "[v0]", "-map", "[v1]", "-map", "0:a:0"},
+				encode,
+				[]string{
+					"-f", "dash",
+					"-seg_duration", "6",
+					"-use_template", "1",
+					"-use_timeline", "1",
+					"-adaptation_sets", "id=0,streams=v id=1,streams=a",
+					"-init_seg_name", "movie_init_$RepresentationID$.m4s",
+					"-media_seg_name", "movie_$RepresentationID$_$Number%05d$.m4s",
+					"-y", "/out/movie.mpd",
+				}),
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.want, abrArgs("/media/movie.mkv", tt.destination, renditions, true, nil))
+		})
+	}
+}
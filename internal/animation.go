@@ -0,0 +1,107 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Bounds and defaults for animated thumbnails.
+const (
+	MaxAnimationDurationSeconds = 30
+	MinAnimationWidth           = 16
+	MaxAnimationWidth           = 1920
+	defaultAnimationDuration    = 3 * time.Second
+	defaultAnimationWidth       = 320
+	animationFPS                = 10
+)
+
+// AnimationExtensions are the destination extensions the animated profile can write.
+var AnimationExtensions = []string{".gif", ".webp"}
+
+// AnimationOptions configure the animated profile.
+type AnimationOptions struct {
+	// StartSeconds is where in the source the animation starts; nil starts a tenth of the
+	// way in, past most opening titles.
+	StartSeconds *float64 `json:"startSeconds,omitempty"`
+	// DurationSeconds is the length of the animation; nil means three seconds.
+	DurationSeconds *float64 `json:"durationSeconds,omitempty"`
+	// Width is the width of the animation in pixels; nil means 320.  The height follows
+	// the source aspect ratio.
+	Width *int `json:"width,omitempty"`
+}
+
+// animationTranscoder encodes a short looping animation, as a GIF or animated WebP
+// depending on the destination extension, for hover previews in media browsers.
+type animationTranscoder struct{}
+
+func init() {
+	RegisterTranscoder(TranscoderBackend{
+		Name:     "animation",
+		Profiles: []Profile{ProfileAnimated},
+		Tools:    []string{ToolFFmpeg, ToolFFprobe},
+		New:      func(Profile) Transcoder { return &animationTranscoder{} },
+	})
+}
+
+func (t *animationTranscoder) Transcode(ctx context.Context, params TranscodeParams) error {
+	totalDuration, err := getDuration(ctx, params.Sandbox, params.SourcePath)
+	if err != nil {
+		return err
+	}
+	start, duration, width := params.Animation.resolve(totalDuration)
+
+	args := []string{
+		"-ss", fmt.Sprintf("%.3f", start.Seconds()),
+		"-t", fmt.Sprintf("%.3f", duration.Seconds()),
+		"-i", params.SourcePath,
+		"-map", "0:v:0",
+	}
+	filter := fmt.Sprintf("fps=%d,scale=%d:-2:flags=lanczos", animationFPS, width)
+	switch strings.ToLower(filepath.Ext(params.DestinationPath)) {
+	case ".webp":
+		args = append(args,
+			"-vf", filter,
+			"-c:v", "libwebp",
+			"-quality", "70",
+			"-f", "webp",
+		)
+	case ".gif":
+		// A palette generated from the clip itself looks far better than the default one.
+		args = append(args,
+			"-filter_complex", filter+",split[a][b];[a]palettegen=stats_mode=diff[p];[b][p]paletteuse=dither=bayer:bayer_scale=5",
+			"-f", "gif",
+		)
+	default:
+		return fmt.Errorf("animated thumbnails must be written to one of %s", strings.Join(AnimationExtensions, ", "))
+	}
+	args = append(args,
+		"-loop", "0",
+		"-y",
+		params.DestinationPath,
+	)
+	return runFfmpeg(ctx, params.Sandbox, params.Limits, duration, params.ProgressCallback, params.LogCallback, args...)
+}
+
+// resolve returns the start, duration, and width of the animation for a source of the
+// given duration, applying defaults and keeping the animation inside the source.  o may
+// be nil.
+func (o *AnimationOptions) resolve(sourceDuration time.Duration) (start, duration time.Duration, width int) {
+	start, duration, width = sourceDuration/10, defaultAnimationDuration, defaultAnimationWidth
+	if o != nil {
+		if o.StartSeconds != nil {
+			start = time.Duration(*o.StartSeconds * float64(time.Second))
+		}
+		if o.DurationSeconds != nil {
+			duration = time.Duration(*o.DurationSeconds * float64(time.Second))
+		}
+		if o.Width != nil {
+			width = *o.Width
+		}
+	}
+	duration = min(duration, sourceDuration)
+	start = max(min(start, sourceDuration-duration), 0)
+	return start, duration, width
+}
@@ -0,0 +1,72 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestAnimationOptionsResolve(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	float := func(f float64) *float64 { return &f }
+	width := 480
+
+	tests := []struct {
+		loc          exam.Loc
+		name         string
+		options      *AnimationOptions
+		source       time.Duration
+		wantStart    time.Duration
+		wantDuration time.Duration
+		wantWidth    int
+	}{
+		{
+			loc:          exam.Here(),
+			name:         "Defaults",
+			source:       100 * time.Second,
+			wantStart:    10 * time.Second,
+			wantDuration: 3 * time.Second,
+			wantWidth:    320,
+		},
+		{
+			loc:          exam.Here(),
+			name:         "Explicit",
+			options:      &AnimationOptions{StartSeconds: float(42.5), DurationSeconds: float(5), Width: &width},
+			source:       100 * time.Second,
+			wantStart:    42500 * time.Millisecond,
+			wantDuration: 5 * time.Second,
+			wantWidth:    480,
+		},
+		{
+			loc:          exam.Here(),
+			name:         "Start past the end",
+			options:      &AnimationOptions{StartSeconds: float(200)},
+			source:       100 * time.Second,
+			wantStart:    97 * time.Second,
+			wantDuration: 3 * time.Second,
+			wantWidth:    320,
+		},
+		{
+			loc:          exam.Here(),
+			name:         "Short source",
+			options:      &AnimationOptions{DurationSeconds: float(10)},
+			source:       2 * time.Second,
+			wantStart:    0,
+			wantDuration: 2 * time.Second,
+			wantWidth:    320,
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			start, duration, width := tt.options.resolve(tt.source)
+			exam.Equal(e, env, tt.wantStart, start)
+			exam.Equal(e, env, tt.wantDuration, duration)
+			exam.Equal(e, env, tt.wantWidth, width)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package internal
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrAPITokenNotFound is returned when an API token doesn't exist or has been revoked.
+var ErrAPITokenNotFound = errors.New("API token not found")
+
+// apiTokenPrefix starts every API token created by the server, so leaked tokens are
+// easy to recognise.
+const apiTokenPrefix = "vt_"
+
+// APIToken is an API key stored in the database.  Only the hash of the key is kept.
+type APIToken struct {
+	ID        uuid.UUID
+	Tenant    string
+	Name      string
+	CreatedAt time.Time
+	RotatedAt *time.Time
+	RevokedAt *time.Time
+}
+
+const apiTokenColumns = "id, tenant, name, created_at, rotated_at, revoked_at"
+
+func scanAPIToken(row pgx.Row) (*APIToken, error) {
+	var token APIToken
+	if err := row.Scan(&token.ID, &token.Tenant, &token.Name, &token.CreatedAt, &token.RotatedAt, &token.RevokedAt); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// newAPITokenSecret generates a new API key.
+func newAPITokenSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate API token: %w", err)
+	}
+	return apiTokenPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// CreateAPIToken stores a new API key for tenant and returns it along with the key,
+// which can't be retrieved again.
+func CreateAPIToken(ctx context.Context, pool *pgxpool.Pool, tenant, name string) (*APIToken, string, error) {
+	secret, err := newAPITokenSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	token, err := scanAPIToken(pool.QueryRow(ctx, `
+		INSERT INTO api_tokens (id, tenant, name, token_hash)
+		VALUES ($1, $2, $3, $4)
+		RETURNING `+apiTokenColumns, uuid.New(), tenant, name, HashAPIKey(secret)))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create API token: %w", err)
+	}
+	return token, secret, nil
+}
+
+// ListAPITokens returns the API tokens of tenant, or of every tenant if it is empty,
+// oldest first.  Revoked tokens are included.
+func ListAPITokens(ctx context.Context, pool *pgxpool.Pool, tenant string) ([]APIToken, error) {
+	rows, err := pool.Query(ctx, `
+		SELECT `+apiTokenColumns+` FROM api_tokens
+		WHERE $1 = '' OR tenant = $1
+		ORDER BY created_at, id`, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API tokens: %w", err)
+	}
+	defer rows.Close()
+	tokens := []APIToken{}
+	for rows.Next() {
+		token, err := scanAPIToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan API token: %w", err)
+		}
+		tokens = append(tokens, *token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read API tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+// RotateAPIToken replaces the key of an API token that hasn't been revoked, and returns
+// the new key.  The old key stops working straight away.
+func RotateAPIToken(ctx context.Context, pool *pgxpool.Pool, id uuid.UUID) (*APIToken, string, error) {
+	secret, err := newAPITokenSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	token, err := scanAPIToken(pool.QueryRow(ctx, `
+		UPDATE api_tokens SET token_hash = $2, rotated_at = now()
+		WHERE id = $1 AND revoked_at IS NULL
+		RETURNING `+apiTokenColumns, id, HashAPIKey(secret)))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, "", ErrAPITokenNotFound
+	} else if err != nil {
+		return nil, "", fmt.Errorf("failed to rotate API token: %w", err)
+	}
+	return token, secret, nil
+}
+
+// RevokeAPIToken stops an API token from authenticating.  The token is kept, so it
+// still shows up when tokens are listed.
+func RevokeAPIToken(ctx context.Context, pool *pgxpool.Pool, id uuid.UUID) (*APIToken, error) {
+	token, err := scanAPIToken(pool.QueryRow(ctx, `
+		UPDATE api_tokens SET revoked_at = now()
+		WHERE id = $1 AND revoked_at IS NULL
+		RETURNING `+apiTokenColumns, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrAPITokenNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to revoke API token: %w", err)
+	}
+	return token, nil
+}
+
+// LookupAPIToken returns the tenant of the unrevoked API token with the given key
+// hash, as returned by HashAPIKey.  It returns ErrAPITokenNotFound if there is none.
+func LookupAPIToken(ctx context.Context, pool *pgxpool.Pool, keyHash string) (string, error) {
+	var tenant string
+	err := pool.QueryRow(ctx, "SELECT tenant FROM api_tokens WHERE token_hash = $1 AND revoked_at IS NULL", keyHash).Scan(&tenant)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrAPITokenNotFound
+	} else if err != nil {
+		return "", fmt.Errorf("failed to look up API token: %w", err)
+	}
+	return tenant, nil
+}
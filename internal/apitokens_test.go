@@ -0,0 +1,23 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestNewAPITokenSecret(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	first, err := newAPITokenSecret()
+	exam.Nil(e, env, err).Log(err).Must()
+	second, err := newAPITokenSecret()
+	exam.Nil(e, env, err).Log(err).Must()
+
+	exam.Equal(e, env, true, strings.HasPrefix(first, apiTokenPrefix))
+	exam.Equal(e, env, len(apiTokenPrefix)+43, len(first))
+	exam.Equal(e, env, false, first == second)
+}
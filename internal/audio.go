@@ -0,0 +1,210 @@
+package internal
+
+import "strconv"
+
+// AudioCodec is an output audio codec.
+type AudioCodec string
+
+const (
+	AudioCodecAAC  AudioCodec = "aac"
+	AudioCodecAC3  AudioCodec = "ac3"
+	AudioCodecEAC3 AudioCodec = "eac3"
+	AudioCodecOpus AudioCodec = "opus"
+	AudioCodecFLAC AudioCodec = "flac"
+	// AudioCodecCopy passes the source audio through without re-encoding.
+	AudioCodecCopy AudioCodec = "copy"
+)
+
+// IsValid reports whether c is a supported audio codec.
+func (c AudioCodec) IsValid() bool {
+	switch c {
+	case AudioCodecAAC, AudioCodecAC3, AudioCodecEAC3, AudioCodecOpus, AudioCodecFLAC, AudioCodecCopy:
+		return true
+	default:
+		return false
+	}
+}
+
+// AudioLayout is an output audio channel layout.
+type AudioLayout string
+
+const (
+	AudioLayoutMono    AudioLayout = "mono"
+	AudioLayoutStereo  AudioLayout = "stereo"
+	AudioLayout5Point1 AudioLayout = "5.1"
+	AudioLayout7Point1 AudioLayout = "7.1"
+)
+
+// IsValid reports whether l is a supported channel layout.
+func (l AudioLayout) IsValid() bool {
+	return l.channels() > 0
+}
+
+// channels returns the number of channels in l, or 0 if l is not supported.
+func (l AudioLayout) channels() int {
+	switch l {
+	case AudioLayoutMono:
+		return 1
+	case AudioLayoutStereo:
+		return 2
+	case AudioLayout5Point1:
+		return 6
+	case AudioLayout7Point1:
+		return 8
+	default:
+		return 0
+	}
+}
+
+// MinAudioBitrateKbps and MaxAudioBitrateKbps bound the per-job audio bitrate.
+const (
+	MinAudioBitrateKbps = 32
+	MaxAudioBitrateKbps = 1536
+)
+
+// AudioDownmix is the algorithm used to mix surround audio down to stereo.
+type AudioDownmix string
+
+const (
+	// AudioDownmixSimple folds the surround channels into left and right.
+	AudioDownmixSimple AudioDownmix = "simple"
+	// AudioDownmixDPL2 matrix-encodes the surround channels as Dolby Pro Logic II, so a
+	// compatible receiver can recover them.
+	AudioDownmixDPL2 AudioDownmix = "dpl2"
+)
+
+// IsValid reports whether d is a supported downmix algorithm.
+func (d AudioDownmix) IsValid() bool {
+	return d == AudioDownmixSimple || d == AudioDownmixDPL2
+}
+
+// stereoTrackBitrateKbps is the bitrate of the stereo compatibility track.
+const stereoTrackBitrateKbps = 160
+
+// AudioOptions selects the output audio for a job, replacing the profile's default.
+// Layout and BitrateKbps are ignored when passing audio through.
+type AudioOptions struct {
+	Codec AudioCodec `json:"codec"`
+	// Layout is the output channel layout; empty keeps the source layout.
+	Layout AudioLayout `json:"layout,omitempty"`
+	// BitrateKbps is the target bitrate for lossy codecs; nil uses the encoder default.
+	BitrateKbps *int `json:"bitrateKbps,omitempty"`
+	// Downmix is how surround audio is mixed to stereo, for a stereo Layout or the
+	// stereo compatibility track; empty means simple.
+	Downmix AudioDownmix `json:"downmix,omitempty"`
+	// StereoTrack adds a stereo compatibility track after the main track, which keeps
+	// its layout.  It uses Codec if that is lossy, and the fallback codec otherwise.
+	StereoTrack bool `json:"stereoTrack,omitempty"`
+}
+
+// stereoTrackCodec returns the codec of the stereo compatibility track.
+func (o *AudioOptions) stereoTrackCodec(fallback AudioCodec) AudioCodec {
+	switch o.Codec {
+	case AudioCodecFLAC, AudioCodecCopy:
+		return fallback
+	default:
+		return o.Codec
+	}
+}
+
+// ffmpegAudioEncoders maps codecs to ffmpeg encoder names.
+var ffmpegAudioEncoders = map[AudioCodec]string{
+	AudioCodecAAC:  "aac",
+	AudioCodecAC3:  "ac3",
+	AudioCodecEAC3: "eac3",
+	AudioCodecOpus: "libopus",
+	AudioCodecFLAC: "flac",
+	AudioCodecCopy: "copy",
+}
+
+// ffmpegDownmixFilter is the audio filter that matrix-encodes a Pro Logic II downmix.
+const ffmpegDownmixFilter = "aresample=matrix_encoding=dplii"
+
+// ffmpegArgs returns the ffmpeg output options for o.  fallback is the stereo
+// compatibility track codec when the main codec is lossless or passed through.
+func (o *AudioOptions) ffmpegArgs(fallback AudioCodec) []string {
+	if !o.StereoTrack {
+		return ffmpegTrackArgs("a", o.Codec, o.Layout, o.BitrateKbps, o.Downmix)
+	}
+	bitrate := stereoTrackBitrateKbps
+	args := ffmpegTrackArgs("a:0", o.Codec, o.Layout, o.BitrateKbps, "")
+	return append(args, ffmpegTrackArgs("a:1", o.stereoTrackCodec(fallback), AudioLayoutStereo, &bitrate, o.Downmix)...)
+}
+
+// ffmpegTrackArgs returns the ffmpeg options for the audio streams matching spec.
+func ffmpegTrackArgs(spec string, codec AudioCodec, layout AudioLayout, bitrateKbps *int, downmix AudioDownmix) []string {
+	args := []string{"-c:" + spec, ffmpegAudioEncoders[codec]}
+	if codec == AudioCodecCopy {
+		return args
+	}
+	if layout != "" {
+		args = append(args, "-ac:"+spec, strconv.Itoa(layout.channels()))
+	}
+	if layout == AudioLayoutStereo && downmix == AudioDownmixDPL2 {
+		args = append(args, "-filter:"+spec, ffmpegDownmixFilter)
+	}
+	if bitrateKbps != nil && codec != AudioCodecFLAC {
+		args = append(args, "-b:"+spec, strconv.Itoa(*bitrateKbps)+"k")
+	}
+	return args
+}
+
+// handbrakeAudioEncoders maps codecs to HandBrake encoder names.
+var handbrakeAudioEncoders = map[AudioCodec]string{
+	AudioCodecAAC:  "av_aac",
+	AudioCodecAC3:  "ac3",
+	AudioCodecEAC3: "eac3",
+	AudioCodecOpus: "opus",
+	AudioCodecFLAC: "flac24",
+	AudioCodecCopy: "copy",
+}
+
+// handbrakeMixdowns maps channel layouts to HandBrake mixdown names.
+var handbrakeMixdowns = map[AudioLayout]string{
+	AudioLayoutMono:    "mono",
+	AudioLayoutStereo:  "stereo",
+	AudioLayout5Point1: "5point1",
+	AudioLayout7Point1: "7point1",
+}
+
+// handbrakeMixdown returns the HandBrake mixdown for layout, or "" to keep the source layout.
+func handbrakeMixdown(layout AudioLayout, downmix AudioDownmix) string {
+	if layout == AudioLayoutStereo && downmix == AudioDownmixDPL2 {
+		return "dpl2"
+	}
+	return handbrakeMixdowns[layout]
+}
+
+// handbrakeArgs returns the HandBrakeCLI audio options for o.  They are appended after
+// the profile's options, which they override.  fallback is the stereo compatibility
+// track codec when the main codec is lossless or passed through.
+func (o *AudioOptions) handbrakeArgs(fallback AudioCodec) []string {
+	if !o.StereoTrack {
+		args := []string{"--aencoder", handbrakeAudioEncoders[o.Codec]}
+		if o.Codec == AudioCodecCopy {
+			return args
+		}
+		if mixdown := handbrakeMixdown(o.Layout, o.Downmix); mixdown != "" {
+			args = append(args, "--mixdown", mixdown)
+		}
+		if o.BitrateKbps != nil && o.Codec != AudioCodecFLAC {
+			args = append(args, "--ab", strconv.Itoa(*o.BitrateKbps))
+		}
+		return args
+	}
+
+	// Encode the first source track twice; HandBrake takes comma-separated per-track lists.
+	mainMixdown := handbrakeMixdown(o.Layout, "")
+	if mainMixdown == "" || o.Codec == AudioCodecCopy {
+		mainMixdown = "none"
+	}
+	args := []string{
+		"--audio", "1,1",
+		"--aencoder", handbrakeAudioEncoders[o.Codec] + "," + handbrakeAudioEncoders[o.stereoTrackCodec(fallback)],
+		"--mixdown", mainMixdown + "," + handbrakeMixdown(AudioLayoutStereo, o.Downmix),
+	}
+	if o.BitrateKbps != nil && o.Codec != AudioCodecFLAC && o.Codec != AudioCodecCopy {
+		args = append(args, "--ab", strconv.Itoa(*o.BitrateKbps)+","+strconv.Itoa(stereoTrackBitrateKbps))
+	}
+	return args
+}
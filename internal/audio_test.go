@@ -0,0 +1,82 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestAudioOptionsArgs(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	bitrate := 192
+	tests := []struct {
+		loc           exam.Loc
+		name          string
+		options       AudioOptions
+		wantFfmpeg    []string
+		wantHandBrake []string
+	}{
+		{
+			loc:           exam.Here(),
+			name:          "Lossy codec with layout and bitrate",
+			options:       AudioOptions{Codec: AudioCodecEAC3, Layout: AudioLayout5Point1, BitrateKbps: &bitrate},
+			wantFfmpeg:    []string{"-c:a", "eac3", "-ac:a", "6", "-b:a", "192k"},
+			wantHandBrake: []string{"--aencoder", "eac3", "--mixdown", "5point1", "--ab", "192"},
+		},
+		{
+			loc:           exam.Here(),
+			name:          "Lossless codec ignores bitrate",
+			options:       AudioOptions{Codec: AudioCodecFLAC, Layout: AudioLayoutStereo, BitrateKbps: &bitrate},
+			wantFfmpeg:    []string{"-c:a", "flac", "-ac:a", "2"},
+			wantHandBrake: []string{"--aencoder", "flac24", "--mixdown", "stereo"},
+		},
+		{
+			loc:           exam.Here(),
+			name:          "Passthrough ignores layout and bitrate",
+			options:       AudioOptions{Codec: AudioCodecCopy, Layout: AudioLayoutMono, BitrateKbps: &bitrate},
+			wantFfmpeg:    []string{"-c:a", "copy"},
+			wantHandBrake: []string{"--aencoder", "copy"},
+		},
+		{
+			loc:           exam.Here(),
+			name:          "Pro Logic II downmix",
+			options:       AudioOptions{Codec: AudioCodecAAC, Layout: AudioLayoutStereo, Downmix: AudioDownmixDPL2},
+			wantFfmpeg:    []string{"-c:a", "aac", "-ac:a", "2", "-filter:a", "aresample=matrix_encoding=dplii"},
+			wantHandBrake: []string{"--aencoder", "av_aac", "--mixdown", "dpl2"},
+		},
+		{
+			loc:        exam.Here(),
+			name:       "Surround passthrough with stereo track",
+			options:    AudioOptions{Codec: AudioCodecCopy, Downmix: AudioDownmixDPL2, StereoTrack: true},
+			wantFfmpeg: []string{"-c:a:0", "copy", "-c:a:1", "aac", "-ac:a:1", "2", "-filter:a:1", "aresample=matrix_encoding=dplii", "-b:a:1", "160k"},
+			wantHandBrake: []string{
+				"--audio", "1,1",
+				"--aencoder", "copy,av_aac",
+				"--mixdown", "none,dpl2",
+			},
+		},
+		{
+			loc:        exam.Here(),
+			name:       "Re-encoded surround with stereo track",
+			options:    AudioOptions{Codec: AudioCodecEAC3, Layout: AudioLayout5Point1, BitrateKbps: &bitrate, StereoTrack: true},
+			wantFfmpeg: []string{"-c:a:0", "eac3", "-ac:a:0", "6", "-b:a:0", "192k", "-c:a:1", "eac3", "-ac:a:1", "2", "-b:a:1", "160k"},
+			wantHandBrake: []string{
+				"--audio", "1,1",
+				"--aencoder", "eac3,eac3",
+				"--mixdown", "5point1,stereo",
+				"--ab", "192,160",
+			},
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+
+			exam.Equal(e, env, tt.wantFfmpeg, tt.options.ffmpegArgs(AudioCodecAAC))
+			exam.Equal(e, env, tt.wantHandBrake, tt.options.handbrakeArgs(AudioCodecAAC))
+		})
+	}
+}
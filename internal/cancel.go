@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RequestSoftCancel asks the worker running a job to stop its encoder gracefully, so
+// the output written so far is finalized and kept, and then cancel the job.  It
+// reports false if the job isn't running, in which case there is nothing to keep.
+func RequestSoftCancel(ctx context.Context, pool *pgxpool.Pool, jobID int64) (bool, error) {
+	tag, err := pool.Exec(ctx, `
+		UPDATE river_job SET metadata = metadata || '{"softCancel": true}'
+		WHERE id = $1 AND state = 'running'`, jobID)
+	if err != nil {
+		return false, fmt.Errorf("failed to request soft cancel: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// SoftCancelRequested reports whether RequestSoftCancel has been called for a job.
+func SoftCancelRequested(ctx context.Context, pool *pgxpool.Pool, jobID int64) (bool, error) {
+	var metadata []byte
+	if err := pool.QueryRow(ctx, "SELECT metadata FROM river_job WHERE id = $1", jobID).Scan(&metadata); err != nil {
+		return false, fmt.Errorf("failed to check for soft cancel: %w", err)
+	}
+	return ParseJobMetadata(metadata).SoftCancel, nil
+}
+
+// ClearSoftCancel forgets a soft cancel requested for a job, so a cancelled job that is
+// retried isn't stopped again as soon as it starts.
+func ClearSoftCancel(ctx context.Context, pool *pgxpool.Pool, jobID int64) error {
+	if _, err := pool.Exec(ctx, "UPDATE river_job SET metadata = metadata - 'softCancel' WHERE id = $1", jobID); err != nil {
+		return fmt.Errorf("failed to clear soft cancel: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// CaptionMode controls what happens to broadcast closed captions (CEA-608/708) embedded
+// in the source video, which re-encoding would otherwise drop.
+type CaptionMode string
+
+const (
+	// CaptionModePreserve converts the captions to a text subtitle track in the output.
+	CaptionModePreserve CaptionMode = "preserve"
+	// CaptionModeSidecar extracts the captions to an .srt file next to the output.
+	CaptionModeSidecar CaptionMode = "sidecar"
+)
+
+// IsValid reports whether m is a supported caption mode.
+func (m CaptionMode) IsValid() bool {
+	return m == CaptionModePreserve || m == CaptionModeSidecar
+}
+
+// CaptionSidecarPath returns the path captions are extracted to for destination.
+func CaptionSidecarPath(destination string) string {
+	return strings.TrimSuffix(destination, filepath.Ext(destination)) + ".cc.srt"
+}
+
+// captionTranscoder extracts embedded closed captions before running an inner
+// Transcoder, either to a sidecar file or to a temporary file muxed in as a subtitle.
+type captionTranscoder struct {
+	inner Transcoder
+}
+
+// NewCaptionTranscoder wraps inner so that jobs with a caption mode keep their captions.
+func NewCaptionTranscoder(inner Transcoder) Transcoder {
+	return &captionTranscoder{inner: inner}
+}
+
+func (t *captionTranscoder) Transcode(ctx context.Context, params TranscodeParams) error {
+	if params.Subtitles == nil || params.Subtitles.Captions == "" {
+		return t.inner.Transcode(ctx, params)
+	}
+	present, err := hasClosedCaptions(ctx, params.Sandbox, params.SourcePath)
+	if err != nil {
+		return err
+	}
+	if !present {
+		return t.inner.Transcode(ctx, params)
+	}
+
+	switch params.Subtitles.Captions {
+	case CaptionModeSidecar:
+		if err := extractCaptions(ctx, params.Sandbox, params.SourcePath, CaptionSidecarPath(params.DestinationPath)); err != nil {
+			return err
+		}
+		return t.inner.Transcode(ctx, params)
+	case CaptionModePreserve:
+		dir := params.ScratchDir
+		if dir == "" {
+			dir = filepath.Dir(params.DestinationPath)
+		}
+		file, err := os.CreateTemp(dir, ".vt-captions-*.srt")
+		if err != nil {
+			return fmt.Errorf("failed to create caption file: %w", err)
+		}
+		file.Close()
+		defer os.Remove(file.Name())
+		if err := extractCaptions(ctx, params.Sandbox, params.SourcePath, file.Name()); err != nil {
+			return err
+		}
+
+		subtitles := *params.Subtitles
+		subtitles.External = append(slices.Clone(subtitles.External), ExternalSubtitle{Path: file.Name()})
+		params.Subtitles = &subtitles
+		return t.inner.Transcode(ctx, params)
+	default:
+		return fmt.Errorf("unsupported caption mode %q", params.Subtitles.Captions)
+	}
+}
+
+// hasClosedCaptions reports whether the first video stream of path carries embedded captions.
+func hasClosedCaptions(ctx context.Context, sandbox *Sandbox, path string) (bool, error) {
+	cmd := encoderCommand(ctx, sandbox, nil, "ffprobe",
+		"-v", "error",
+		"-select_streams", "v:0",
+		"-show_entries", "stream=closed_captions",
+		"-of", "default=noprint_wrappers=1:nokey=1",
+		path,
+	)
+	output, err := cmd.Output()
+	if err != nil {
+		return false, fmt.Errorf("failed to probe captions: %w", err)
+	}
+	return strings.TrimSpace(string(output)) == "1", nil
+}
+
+// extractCaptions decodes the captions embedded in the video of source and writes them
+// to destination as SubRip.
+func extractCaptions(ctx context.Context, sandbox *Sandbox, source, destination string) error {
+	cmd := encoderCommand(ctx, sandbox, nil, "ffmpeg",
+		"-f", "lavfi",
+		"-i", "movie=filename="+escapeFilterValue(source)+"[out0+subcc]",
+		"-map", "0:s:0",
+		"-c:s", "srt",
+		"-y",
+		destination,
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: failed to extract captions: %w: %s", ErrFFmpegFailed, err, output)
+	}
+	return nil
+}
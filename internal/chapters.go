@@ -0,0 +1,248 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Bounds and defaults for generated chapters.
+const (
+	MinChapterLengthSeconds     = 60
+	MaxChapterLengthSeconds     = 3600
+	defaultChapterLengthSeconds = 300
+	// sceneChangeThreshold is the ffmpeg scene score, from 0 to 1, above which a frame
+	// starts a new scene.
+	sceneChangeThreshold = 0.4
+	// sceneDetectionShare is the part of a job's progress given to detecting scenes in
+	// the output; encoding gets the rest.
+	sceneDetectionShare = 0.05
+)
+
+// ChapterOptions controls chapters generated for sources that have none.  Only
+// profiles for which Profile.SupportsChapters is true accept them.
+type ChapterOptions struct {
+	// Generate adds chapters at scene changes to the output if the source has none.
+	Generate bool `json:"generate,omitempty"`
+	// MinLengthSeconds is the shortest a generated chapter may be; nil means 300.
+	MinLengthSeconds *int `json:"minLengthSeconds,omitempty"`
+}
+
+// minLength returns the effective minimum chapter length.
+func (o *ChapterOptions) minLength() time.Duration {
+	if o.MinLengthSeconds == nil {
+		return defaultChapterLengthSeconds * time.Second
+	}
+	return time.Duration(*o.MinLengthSeconds) * time.Second
+}
+
+// SupportsChapters reports whether chapters can be generated for the profile, which
+// needs a single output covering the whole source.
+func (p Profile) SupportsChapters() bool {
+	return p.writesWholeSource()
+}
+
+// chapter is a generated chapter of an output.
+type chapter struct {
+	start, end time.Duration
+}
+
+// chapterTranscoder runs an inner Transcoder and then, if the source has no chapters,
+// detects scene changes in the output and writes chapters starting at them.
+type chapterTranscoder struct {
+	inner Transcoder
+}
+
+// NewChapterTranscoder wraps inner so that jobs asking for generated chapters get them.
+func NewChapterTranscoder(inner Transcoder) Transcoder {
+	return &chapterTranscoder{inner: inner}
+}
+
+func (t *chapterTranscoder) Transcode(ctx context.Context, params TranscodeParams) error {
+	if params.Chapters == nil || !params.Chapters.Generate {
+		return t.inner.Transcode(ctx, params)
+	}
+	count, err := countChapters(ctx, params.Sandbox, params.SourcePath)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		// The encoders keep the source's chapters
+		return t.inner.Transcode(ctx, params)
+	}
+
+	report := params.ProgressCallback
+	innerParams := params
+	if report != nil {
+		innerParams.ProgressCallback = func(progress Progress) {
+			progress.Percent *= 1 - sceneDetectionShare
+			report(progress)
+		}
+	}
+	if err := t.inner.Transcode(ctx, innerParams); err != nil {
+		return err
+	}
+
+	duration, err := getDuration(ctx, params.Sandbox, params.DestinationPath)
+	if err != nil {
+		return err
+	}
+	var detectProgress ProgressCallback
+	if report != nil {
+		detectProgress = func(progress Progress) {
+			report(Progress{Percent: (1-sceneDetectionShare)*100 + progress.Percent*sceneDetectionShare})
+		}
+	}
+	changes, err := detectSceneChanges(ctx, params.Sandbox, params.Limits, params.DestinationPath, duration, detectProgress, params.LogCallback)
+	if err != nil {
+		return err
+	}
+	chapters := sceneChapters(changes, duration, params.Chapters.minLength())
+	if len(chapters) == 0 {
+		return nil
+	}
+	return writeChapters(ctx, params.Sandbox, params.Limits, params.DestinationPath, chapters)
+}
+
+// countChapters returns how many chapters path has.
+func countChapters(ctx context.Context, sandbox *Sandbox, path string) (int, error) {
+	cmd := encoderCommand(ctx, sandbox, nil, "ffprobe",
+		"-v", "error",
+		"-show_chapters",
+		"-of", "json",
+		path,
+	)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return 0, fmt.Errorf("failed to probe chapters: %w: %s", err, exitErr.Stderr)
+		}
+		return 0, fmt.Errorf("failed to probe chapters: %w", err)
+	}
+	var probe struct {
+		Chapters []json.RawMessage `json:"chapters"`
+	}
+	if err := json.Unmarshal(output, &probe); err != nil {
+		return 0, fmt.Errorf("unexpected ffprobe output: %w", err)
+	}
+	return len(probe.Chapters), nil
+}
+
+// sceneChangeRegex matches the lines ffmpeg's metadata filter logs for each frame it
+// passes, which are the frames that start a new scene.
+var sceneChangeRegex = regexp.MustCompile(`pts_time:(\d+(?:\.\d+)?)`)
+
+// detectSceneChanges returns when each scene of the first video stream of path starts,
+// after the first.  Frames are scaled down first, which speeds up decoding without
+// hiding cuts.
+func detectSceneChanges(ctx context.Context, sandbox *Sandbox, limits *ProcessLimits, path string, duration time.Duration, progressCallback ProgressCallback, logCallback LogCallback) ([]time.Duration, error) {
+	var changes []time.Duration
+	err := runFfmpeg(ctx, sandbox, limits, duration, progressCallback, func(line string) {
+		if matches := sceneChangeRegex.FindStringSubmatch(line); len(matches) == 2 {
+			if seconds, err := strconv.ParseFloat(matches[1], 64); err == nil {
+				changes = append(changes, time.Duration(seconds*float64(time.Second)))
+			}
+			return
+		}
+		if logCallback != nil {
+			logCallback(line)
+		}
+	},
+		"-hide_banner",
+		"-nostdin",
+		"-i", path,
+		"-map", "0:v:0",
+		"-vf", fmt.Sprintf("scale=-2:240,select='gt(scene,%g)',metadata=print", sceneChangeThreshold),
+		"-f", "null", "-",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("scene detection failed: %w", err)
+	}
+	slices.Sort(changes)
+	return changes, nil
+}
+
+// sceneChapters returns chapters covering duration that start at scene changes, each
+// at least minLength long, or nil if that leaves fewer than two.
+func sceneChapters(changes []time.Duration, duration, minLength time.Duration) []chapter {
+	var chapters []chapter
+	var start time.Duration
+	for _, change := range changes {
+		if change-start < minLength || duration-change < minLength {
+			continue
+		}
+		chapters = append(chapters, chapter{start: start, end: change})
+		start = change
+	}
+	if len(chapters) == 0 {
+		return nil
+	}
+	return append(chapters, chapter{start: start, end: duration})
+}
+
+// ffmetadata returns chapters in ffmpeg's metadata file format.
+func ffmetadata(chapters []chapter) string {
+	var b strings.Builder
+	b.WriteString(";FFMETADATA1\n")
+	for i, c := range chapters {
+		fmt.Fprintf(&b, "[CHAPTER]\nTIMEBASE=1/1000\nSTART=%d\nEND=%d\ntitle=Chapter %d\n", c.start.Milliseconds(), c.end.Milliseconds(), i+1)
+	}
+	return b.String()
+}
+
+// writeChapters remuxes path with chapters, replacing the file once the remux is
+// complete so a failure leaves the output as it was.
+func writeChapters(ctx context.Context, sandbox *Sandbox, limits *ProcessLimits, path string, chapters []chapter) error {
+	dir := filepath.Dir(path)
+	metadata, err := os.CreateTemp(dir, ".vt-chapters-*.txt")
+	if err != nil {
+		return fmt.Errorf("failed to create chapter file: %w", err)
+	}
+	defer os.Remove(metadata.Name())
+	_, err = metadata.WriteString(ffmetadata(chapters))
+	if closeErr := metadata.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write chapter file: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat output: %w", err)
+	}
+	remuxed, err := os.CreateTemp(dir, ".vt-chapters-*"+filepath.Ext(path))
+	if err != nil {
+		return fmt.Errorf("failed to create remuxed output: %w", err)
+	}
+	remuxed.Close()
+	defer os.Remove(remuxed.Name())
+	// CreateTemp makes the file private, but it replaces an output others may read
+	if err := os.Chmod(remuxed.Name(), info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to set mode of remuxed output: %w", err)
+	}
+	if err := runFfmpeg(ctx, sandbox, limits, 0, nil, nil,
+		"-nostdin",
+		"-i", path,
+		"-f", "ffmetadata", "-i", metadata.Name(),
+		"-map", "0",
+		"-map_chapters", "1",
+		"-c", "copy",
+		"-y",
+		remuxed.Name(),
+	); err != nil {
+		return fmt.Errorf("failed to write chapters: %w", err)
+	}
+	if err := os.Rename(remuxed.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace output with chaptered copy: %w", err)
+	}
+	return nil
+}
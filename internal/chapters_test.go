@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestSceneChapters(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc      exam.Loc
+		name     string
+		changes  []time.Duration
+		duration time.Duration
+		want     []chapter
+	}{
+		{
+			loc:      exam.Here(),
+			name:     "Changes too close together are skipped",
+			changes:  []time.Duration{30 * time.Second, 6 * time.Minute, 7 * time.Minute, 12 * time.Minute, 28 * time.Minute},
+			duration: 30 * time.Minute,
+			want: []chapter{
+				{start: 0, end: 6 * time.Minute},
+				{start: 6 * time.Minute, end: 12 * time.Minute},
+				{start: 12 * time.Minute, end: 30 * time.Minute},
+			},
+		},
+		{
+			loc:      exam.Here(),
+			name:     "Too short for two chapters",
+			changes:  []time.Duration{2 * time.Minute, 4 * time.Minute},
+			duration: 8 * time.Minute,
+		},
+		{
+			loc:      exam.Here(),
+			name:     "No scene changes",
+			duration: time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.want, sceneChapters(tt.changes, tt.duration, 5*time.Minute))
+		})
+	}
+}
+
+func TestFFMetadata(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	got := ffmetadata([]chapter{
+		{start: 0, end: 300500 * time.Millisecond},
+		{start: 300500 * time.Millisecond, end: 10 * time.Minute},
+	})
+	exam.Equal(e, env, `;FFMETADATA1
+[CHAPTER]
+TIMEBASE=1/1000
+START=0
+END=300500
+title=Chapter 1
+[CHAPTER]
+TIMEBASE=1/1000
+START=300500
+END=600000
+title=Chapter 2
+`, got)
+}
@@ -0,0 +1,108 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// clipSamples is how many points of the source a preview clip is sampled from.
+	clipSamples = 5
+	// clipSampleDuration is the length of each sample, for a 30 second clip.
+	clipSampleDuration = 6 * time.Second
+	// clipHeight is the height of a preview clip.
+	clipHeight = 480
+)
+
+// clipTranscoder encodes a short preview clip stitched together from samples spread
+// evenly through the source.  Only the samples are decoded, so it costs a few seconds
+// of encoding regardless of the source's length.
+type clipTranscoder struct{}
+
+func init() {
+	RegisterTranscoder(TranscoderBackend{
+		Name:     "clip",
+		Profiles: []Profile{ProfilePreviewClip},
+		Tools:    []string{ToolFFmpeg, ToolFFprobe},
+		New:      func(Profile) Transcoder { return &clipTranscoder{} },
+	})
+}
+
+func (t *clipTranscoder) Transcode(ctx context.Context, params TranscodeParams) error {
+	totalDuration, err := getDuration(ctx, params.Sandbox, params.SourcePath)
+	if err != nil {
+		return err
+	}
+	audioStreams, err := countStreams(ctx, params.Sandbox, params.SourcePath, "a")
+	if err != nil {
+		return err
+	}
+	hasAudio := audioStreams > 0
+
+	starts := clipSampleStarts(totalDuration, clipSamples, clipSampleDuration)
+	sampleDuration := min(clipSampleDuration, totalDuration)
+
+	var args []string
+	for _, start := range starts {
+		args = append(args,
+			"-ss", fmt.Sprintf("%.3f", start.Seconds()),
+			"-t", fmt.Sprintf("%.3f", sampleDuration.Seconds()),
+			"-i", params.SourcePath,
+		)
+	}
+	args = append(args, "-filter_complex", clipFilter(len(starts), hasAudio), "-map", "[v]")
+	args = append(args,
+		"-c:v", "libx264",
+		"-preset", "veryfast",
+		"-crf", "26",
+		"-pix_fmt", "yuv420p",
+	)
+	if hasAudio {
+		args = append(args, "-map", "[a]", "-c:a", "aac", "-b:a", "128k")
+	}
+	args = append(args,
+		"-movflags", "+faststart",
+		"-y",
+		params.DestinationPath,
+	)
+	clipDuration := sampleDuration * time.Duration(len(starts))
+	return runFfmpeg(ctx, params.Sandbox, params.Limits, clipDuration, params.ProgressCallback, params.LogCallback, args...)
+}
+
+// clipSampleStarts returns where each of up to samples samples of sampleDuration start
+// in a source of the given duration.  The samples are centred in equal slices of the
+// source; a source too short for more than one is used whole.
+func clipSampleStarts(duration time.Duration, samples int, sampleDuration time.Duration) []time.Duration {
+	if duration <= sampleDuration*time.Duration(samples) {
+		return []time.Duration{0}
+	}
+	slice := duration / time.Duration(samples)
+	starts := make([]time.Duration, samples)
+	for i := range starts {
+		starts[i] = slice*time.Duration(i) + (slice-sampleDuration)/2
+	}
+	return starts
+}
+
+// clipFilter returns the filtergraph that scales the first video and audio stream of
+// each of the given number of inputs to a common format and concatenates them into
+// [v] and, if hasAudio, [a].
+func clipFilter(inputs int, hasAudio bool) string {
+	var graph, concat strings.Builder
+	for i := range inputs {
+		fmt.Fprintf(&graph, "[%d:v:0]scale=-2:%d,setsar=1[v%d];", i, clipHeight, i)
+		fmt.Fprintf(&concat, "[v%d]", i)
+		if hasAudio {
+			fmt.Fprintf(&graph, "[%d:a:0]aformat=sample_rates=48000:channel_layouts=stereo[a%d];", i, i)
+			fmt.Fprintf(&concat, "[a%d]", i)
+		}
+	}
+	audio, outputs := 0, "[v]"
+	if hasAudio {
+		audio, outputs = 1, "[v][a]"
+	}
+	fmt.Fprintf(&graph, "%sconcat=n=%d:v=1:a=%d%s", concat.String(), inputs, audio, outputs)
+	return graph.String()
+}
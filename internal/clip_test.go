@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestClipSampleStarts(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc      exam.Loc
+		name     string
+		duration time.Duration
+		want     []time.Duration
+	}{
+		{
+			loc:      exam.Here(),
+			name:     "Short source",
+			duration: 20 * time.Second,
+			want:     []time.Duration{0},
+		},
+		{
+			loc:      exam.Here(),
+			name:     "Long source",
+			duration: 100 * time.Second,
+			want:     []time.Duration{7 * time.Second, 27 * time.Second, 47 * time.Second, 67 * time.Second, 87 * time.Second},
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.want, clipSampleStarts(tt.duration, 5, 6*time.Second))
+		})
+	}
+}
+
+func TestClipFilter(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	exam.Equal(e, env,
+		"[0:v:0]scale=-2:480,setsar=1[v0];[0:a:0]aformat=sample_rates=48000:channel_layouts=stereo[a0];"+
+			"[1:v:0]scale=-2:480,setsar=1[v1];[1:a:0]aformat=sample_rates=48000:channel_layouts=stereo[a1];"+
+			"[v0][a0][v1][a1]concat=n=2:v=1:a=1[v][a]",
+		clipFilter(2, true))
+	exam.Equal(e, env, "[0:v:0]scale=-2:480,setsar=1[v0];[v0]concat=n=1:v=1:a=0[v]", clipFilter(1, false))
+}
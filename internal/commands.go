@@ -0,0 +1,106 @@
+package internal
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// redacted replaces secrets in recorded command lines.
+const redacted = "REDACTED"
+
+// secretFlags are encoder options whose value is a secret.
+var secretFlags = map[string]bool{
+	"-decryption_key": true,
+	"-encryption_key": true,
+	"-cenc_key":       true,
+	"-key":            true,
+	"-password":       true,
+	"-headers":        true,
+}
+
+// secretQueryParam matches URL query parameters that carry credentials.
+var secretQueryParam = regexp.MustCompile(`(?i)token|key|secret|password|signature|^sig$|credential`)
+
+// shellSafe matches arguments that needn't be quoted for a POSIX shell.
+var shellSafe = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+// CommandRecorder collects the command lines of the encoders run for a job, so the
+// encode can be reproduced by hand.  It is safe for concurrent use, as segmented
+// transcodes run several encoders at once.
+type CommandRecorder struct {
+	mu       sync.Mutex
+	commands []string
+}
+
+type commandRecorderKey struct{}
+
+// WithCommandRecorder returns a context whose encoders are recorded by recorder.
+func WithCommandRecorder(ctx context.Context, recorder *CommandRecorder) context.Context {
+	return context.WithValue(ctx, commandRecorderKey{}, recorder)
+}
+
+// Commands returns the command lines recorded so far, in the order the encoders started.
+func (r *CommandRecorder) Commands() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.commands...)
+}
+
+// recordCommand adds argv, with secrets redacted, to the recorder ctx carries, if any.
+// ffprobe only reads the source, so it isn't recorded.
+func recordCommand(ctx context.Context, argv []string) {
+	recorder, _ := ctx.Value(commandRecorderKey{}).(*CommandRecorder)
+	if recorder == nil || argv[0] == "ffprobe" {
+		return
+	}
+	line := CommandLine(argv)
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.commands = append(recorder.commands, line)
+}
+
+// CommandLine returns argv as a POSIX shell command line, with the values of secret
+// options and the credentials in URLs redacted.
+func CommandLine(argv []string) string {
+	quoted := make([]string, len(argv))
+	for i, arg := range argv {
+		if i > 0 && secretFlags[argv[i-1]] {
+			arg = redacted
+		} else {
+			arg = redactURL(arg)
+		}
+		if !shellSafe.MatchString(arg) {
+			arg = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		}
+		quoted[i] = arg
+	}
+	return strings.Join(quoted, " ")
+}
+
+// redactURL returns arg with the password and secret query parameters of a URL
+// redacted, or arg unchanged if it isn't a URL.
+func redactURL(arg string) string {
+	if !strings.Contains(arg, "://") {
+		return arg
+	}
+	u, err := url.Parse(arg)
+	if err != nil || u.Host == "" {
+		return arg
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redacted)
+	}
+	query := u.Query()
+	for name := range query {
+		if secretQueryParam.MatchString(name) {
+			query.Set(name, redacted)
+		}
+	}
+	if len(query) > 0 {
+		u.RawQuery = query.Encode()
+	}
+	return u.String()
+}
@@ -0,0 +1,301 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// CompareOptions configures a comparison of a transcode's output against its source.
+type CompareOptions struct {
+	// VMAF also scores the output's picture quality against the source, which takes
+	// about as long as decoding both.
+	VMAF bool `json:"vmaf,omitempty"`
+}
+
+// CompareJobArgs are the arguments of a job that compares an output file against its
+// source.  Jobs inserted after a transcode have the transcode's UUID.
+type CompareJobArgs struct {
+	UUID       uuid.UUID `json:"uuid" river:"unique"`
+	SourcePath string    `json:"sourcePath"`
+	OutputPath string    `json:"outputPath"`
+	CompareOptions
+	// Tenant is the tenant that submitted the comparison, if the server has tenants.
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// Kind returns the job kind identifier for River.
+func (CompareJobArgs) Kind() string {
+	return "compare"
+}
+
+// InsertOpts makes the UUID unique across comparisons in every state, as for transcodes.
+func (CompareJobArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:  true,
+			ByState: rivertype.JobStates(),
+		},
+	}
+}
+
+// CompareJobArgsFor returns the comparison to run once the transcode args describe has
+// completed, or nil if it didn't ask for one.
+func CompareJobArgsFor(args TranscodeJobArgs) *CompareJobArgs {
+	if args.Compare == nil {
+		return nil
+	}
+	return &CompareJobArgs{
+		UUID:           args.UUID,
+		SourcePath:     args.SourcePath,
+		OutputPath:     args.DestinationPath,
+		CompareOptions: *args.Compare,
+		Tenant:         args.Tenant,
+	}
+}
+
+// SupportsCompare reports whether the profile writes a single output that covers its
+// whole source, which is all a comparison can check.
+func (p Profile) SupportsCompare() bool {
+	return p.writesWholeSource()
+}
+
+// ComparisonReport is recorded as the output of a compare job.
+type ComparisonReport struct {
+	Source *MediaSummary `json:"source,omitempty"`
+	Output *MediaSummary `json:"output,omitempty"`
+	// DurationsMatch reports whether the durations are within DurationsMatch's tolerance.
+	DurationsMatch bool `json:"durationsMatch"`
+	// StreamLayoutMatches reports whether both files have the same number of video,
+	// audio, and subtitle streams, with the same audio channels and languages.
+	StreamLayoutMatches bool `json:"streamLayoutMatches"`
+	// Differences describe each way the files differ, for people reading the report.
+	Differences []string `json:"differences,omitempty"`
+	// VMAF scores the output against the source, if it was asked for.
+	VMAF *VMAFScore `json:"vmaf,omitempty"`
+	// Error contains an error message if the comparison failed.
+	Error *string `json:"error,omitempty"`
+	// ErrorCode contains a machine-readable failure code if the comparison failed.
+	ErrorCode *ErrorCode `json:"errorCode,omitempty"`
+}
+
+// MediaSummary describes a file being compared.
+type MediaSummary struct {
+	DurationSeconds float64         `json:"durationSeconds"`
+	SizeBytes       int64           `json:"sizeBytes"`
+	Streams         []StreamSummary `json:"streams"`
+}
+
+// StreamSummary describes one stream of a file being compared.
+type StreamSummary struct {
+	Index int `json:"index"`
+	// Type is the ffprobe codec type: video, audio, subtitle, data, or attachment.
+	Type     string `json:"type"`
+	Codec    string `json:"codec,omitempty"`
+	Width    int    `json:"width,omitempty"`
+	Height   int    `json:"height,omitempty"`
+	Channels int    `json:"channels,omitempty"`
+	Language string `json:"language,omitempty"`
+}
+
+// VMAFScore is the pooled VMAF of an output against its source, from 0 to 100.
+type VMAFScore struct {
+	Mean         float64 `json:"mean"`
+	Min          float64 `json:"min"`
+	Max          float64 `json:"max"`
+	HarmonicMean float64 `json:"harmonicMean"`
+}
+
+// CompareMedia compares the output args names against its source.  Differences are
+// recorded in the report rather than returned as errors; only files that can't be
+// read fail the comparison.
+func CompareMedia(ctx context.Context, sandbox *Sandbox, limits *ProcessLimits, args CompareJobArgs) (*ComparisonReport, error) {
+	source, err := summarizeMedia(ctx, sandbox, args.SourcePath)
+	if err != nil {
+		return nil, err
+	}
+	output, err := summarizeMedia(ctx, sandbox, args.OutputPath)
+	if err != nil {
+		return nil, err
+	}
+	report := compareSummaries(source, output)
+	if args.VMAF {
+		if report.VMAF, err = measureVMAF(ctx, sandbox, limits, args.SourcePath, args.OutputPath, source); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// summarizeMedia probes the duration, size, and streams of the file at path.
+func summarizeMedia(ctx context.Context, sandbox *Sandbox, path string) (*MediaSummary, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+	cmd := encoderCommand(ctx, sandbox, nil, "ffprobe",
+		"-v", "error",
+		"-show_entries", "format=duration,size:stream=index,codec_type,codec_name,width,height,channels:stream_tags=language",
+		"-of", "json",
+		path,
+	)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("failed to probe %s: %w: %s", path, err, exitErr.Stderr)
+		}
+		return nil, fmt.Errorf("failed to probe %s: %w", path, err)
+	}
+	return parseMediaSummary(output)
+}
+
+// parseMediaSummary reads ffprobe's JSON description of a file.
+func parseMediaSummary(output []byte) (*MediaSummary, error) {
+	var probe struct {
+		Format struct {
+			Duration string `json:"duration"`
+			Size     string `json:"size"`
+		} `json:"format"`
+		Streams []struct {
+			Index     int    `json:"index"`
+			CodecType string `json:"codec_type"`
+			CodecName string `json:"codec_name"`
+			Width     int    `json:"width"`
+			Height    int    `json:"height"`
+			Channels  int    `json:"channels"`
+			Tags      struct {
+				Language string `json:"language"`
+			} `json:"tags"`
+		} `json:"streams"`
+	}
+	if err := json.Unmarshal(output, &probe); err != nil {
+		return nil, fmt.Errorf("unexpected ffprobe output: %w", err)
+	}
+	summary := &MediaSummary{Streams: make([]StreamSummary, len(probe.Streams))}
+	var err error
+	if summary.DurationSeconds, err = strconv.ParseFloat(probe.Format.Duration, 64); err != nil {
+		return nil, fmt.Errorf("failed to parse duration: %w", err)
+	}
+	if probe.Format.Size != "" {
+		if summary.SizeBytes, err = strconv.ParseInt(probe.Format.Size, 10, 64); err != nil {
+			return nil, fmt.Errorf("failed to parse size: %w", err)
+		}
+	}
+	for i, stream := range probe.Streams {
+		summary.Streams[i] = StreamSummary{
+			Index:    stream.Index,
+			Type:     stream.CodecType,
+			Codec:    stream.CodecName,
+			Width:    stream.Width,
+			Height:   stream.Height,
+			Channels: stream.Channels,
+			Language: stream.Tags.Language,
+		}
+	}
+	return summary, nil
+}
+
+// streamsOfType returns the streams of summary with the given type, in order.
+func (m *MediaSummary) streamsOfType(streamType string) []StreamSummary {
+	var streams []StreamSummary
+	for _, stream := range m.Streams {
+		if stream.Type == streamType {
+			streams = append(streams, stream)
+		}
+	}
+	return streams
+}
+
+// compareSummaries reports how output differs from source.  Codecs and video sizes
+// are expected to change, so only durations, stream counts, and the channels and
+// languages of audio streams are compared.
+func compareSummaries(source, output *MediaSummary) *ComparisonReport {
+	report := &ComparisonReport{Source: source, Output: output, StreamLayoutMatches: true}
+
+	sourceDuration := time.Duration(source.DurationSeconds * float64(time.Second))
+	outputDuration := time.Duration(output.DurationSeconds * float64(time.Second))
+	report.DurationsMatch = DurationsMatch(sourceDuration, outputDuration)
+	if !report.DurationsMatch {
+		report.Differences = append(report.Differences, fmt.Sprintf("output is %s long, but the source is %s", outputDuration.Round(time.Millisecond), sourceDuration.Round(time.Millisecond)))
+	}
+
+	for _, streamType := range []string{"video", "audio", "subtitle"} {
+		sourceStreams, outputStreams := source.streamsOfType(streamType), output.streamsOfType(streamType)
+		if len(sourceStreams) != len(outputStreams) {
+			report.StreamLayoutMatches = false
+			report.Differences = append(report.Differences, fmt.Sprintf("output has %d %s streams, but the source has %d", len(outputStreams), streamType, len(sourceStreams)))
+			continue
+		}
+		if streamType != "audio" {
+			continue
+		}
+		for i := range sourceStreams {
+			if sourceStreams[i].Channels != outputStreams[i].Channels {
+				report.StreamLayoutMatches = false
+				report.Differences = append(report.Differences, fmt.Sprintf("audio stream %d has %d channels, but %d in the source", i, outputStreams[i].Channels, sourceStreams[i].Channels))
+			}
+			if sourceStreams[i].Language != outputStreams[i].Language {
+				report.StreamLayoutMatches = false
+				report.Differences = append(report.Differences, fmt.Sprintf("audio stream %d has language %q, but %q in the source", i, outputStreams[i].Language, sourceStreams[i].Language))
+			}
+		}
+	}
+	return report
+}
+
+// measureVMAF scores the first video stream of output against the source's with
+// libvmaf, scaling the output to the source's size first.  The log is written to
+// stdout, as a sandboxed ffmpeg can't write anywhere the worker can read.
+func measureVMAF(ctx context.Context, sandbox *Sandbox, limits *ProcessLimits, sourcePath, outputPath string, source *MediaSummary) (*VMAFScore, error) {
+	video := source.streamsOfType("video")
+	if len(video) == 0 {
+		return nil, fmt.Errorf("%w: %s has no video stream to score against", ErrFFmpegFailed, sourcePath)
+	}
+	filter := fmt.Sprintf("[0:v:0]scale=%d:%d:flags=bicubic,setpts=PTS-STARTPTS[distorted];[1:v:0]setpts=PTS-STARTPTS[reference];[distorted][reference]libvmaf=log_fmt=json:log_path=/dev/stdout",
+		video[0].Width, video[0].Height)
+	output, err := encoderCommand(ctx, sandbox, limits, "ffmpeg",
+		"-v", "error",
+		"-nostdin",
+		"-i", outputPath,
+		"-i", sourcePath,
+		"-lavfi", filter,
+		"-f", "null", "-",
+	).Output()
+	if err != nil {
+		var stderr string
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			stderr = string(exitErr.Stderr)
+		}
+		return nil, limits.classifyExit(ctx, fmt.Errorf("%w: VMAF scoring failed: %w: %s", ErrFFmpegFailed, err, stderr), stderr)
+	}
+	return parseVMAFLog(output)
+}
+
+// parseVMAFLog reads the pooled scores from libvmaf's JSON log.
+func parseVMAFLog(data []byte) (*VMAFScore, error) {
+	var vmafLog struct {
+		PooledMetrics struct {
+			VMAF *struct {
+				Min          float64 `json:"min"`
+				Max          float64 `json:"max"`
+				Mean         float64 `json:"mean"`
+				HarmonicMean float64 `json:"harmonic_mean"`
+			} `json:"vmaf"`
+		} `json:"pooled_metrics"`
+	}
+	if err := json.Unmarshal(data, &vmafLog); err != nil {
+		return nil, fmt.Errorf("unexpected VMAF log: %w", err)
+	}
+	pooled := vmafLog.PooledMetrics.VMAF
+	if pooled == nil {
+		return nil, fmt.Errorf("VMAF log has no pooled vmaf score")
+	}
+	return &VMAFScore{Mean: pooled.Mean, Min: pooled.Min, Max: pooled.Max, HarmonicMean: pooled.HarmonicMean}, nil
+}
@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestParseMediaSummary(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	output := []byte(`{
+		"streams": [
+			{"index": 0, "codec_name": "h264", "codec_type": "video", "width": 1920, "height": 1080},
+			{"index": 1, "codec_name": "ac3", "codec_type": "audio", "channels": 6, "tags": {"language": "eng"}},
+			{"index": 2, "codec_name": "subrip", "codec_type": "subtitle", "tags": {"language": "fre"}}
+		],
+		"format": {"duration": "5400.250000", "size": "4294967296"}
+	}`)
+	got, err := parseMediaSummary(output)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, &MediaSummary{
+		DurationSeconds: 5400.25,
+		SizeBytes:       4294967296,
+		Streams: []StreamSummary{
+			{Index: 0, Type: "video", Codec: "h264", Width: 1920, Height: 1080},
+			{Index: 1, Type: "audio", Codec: "ac3", Channels: 6, Language: "eng"},
+			{Index: 2, Type: "subtitle", Codec: "subrip", Language: "fre"},
+		},
+	}, got)
+
+	_, err = parseMediaSummary([]byte(`{"streams": [], "format": {}}`))
+	exam.NotNil(e, env, err)
+}
+
+func TestCompareSummaries(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	source := &MediaSummary{
+		DurationSeconds: 600,
+		Streams: []StreamSummary{
+			{Index: 0, Type: "video", Codec: "mpeg2video", Width: 720, Height: 480},
+			{Index: 1, Type: "audio", Codec: "ac3", Channels: 6, Language: "eng"},
+			{Index: 2, Type: "audio", Codec: "ac3", Channels: 2, Language: "spa"},
+			{Index: 3, Type: "subtitle", Codec: "dvd_subtitle", Language: "eng"},
+		},
+	}
+	tests := []struct {
+		loc                 exam.Loc
+		name                string
+		output              *MediaSummary
+		wantDurationsMatch  bool
+		wantLayoutMatches   bool
+		wantDifferenceCount int
+	}{
+		{
+			loc:  exam.Here(),
+			name: "Same layout, new codecs",
+			output: &MediaSummary{
+				DurationSeconds: 600.4,
+				Streams: []StreamSummary{
+					{Index: 0, Type: "video", Codec: "hevc", Width: 1280, Height: 720},
+					{Index: 1, Type: "audio", Codec: "aac", Channels: 6, Language: "eng"},
+					{Index: 2, Type: "audio", Codec: "aac", Channels: 2, Language: "spa"},
+					{Index: 3, Type: "subtitle", Codec: "subrip", Language: "eng"},
+				},
+			},
+			wantDurationsMatch: true,
+			wantLayoutMatches:  true,
+		},
+		{
+			loc:  exam.Here(),
+			name: "Truncated and downmixed",
+			output: &MediaSummary{
+				DurationSeconds: 540,
+				Streams: []StreamSummary{
+					{Index: 0, Type: "video", Codec: "hevc"},
+					{Index: 1, Type: "audio", Codec: "aac", Channels: 2, Language: "eng"},
+					{Index: 2, Type: "audio", Codec: "aac", Channels: 2, Language: "spa"},
+				},
+			},
+			wantDifferenceCount: 3,
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			report := compareSummaries(source, tt.output)
+			exam.Equal(e, env, tt.wantDurationsMatch, report.DurationsMatch)
+			exam.Equal(e, env, tt.wantLayoutMatches, report.StreamLayoutMatches)
+			exam.Equal(e, env, tt.wantDifferenceCount, len(report.Differences)).Log(report.Differences)
+		})
+	}
+}
+
+func TestParseVMAFLog(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	got, err := parseVMAFLog([]byte(`{
+		"version": "2.3.1",
+		"frames": [{"frameNum": 0, "metrics": {"vmaf": 95.1}}],
+		"pooled_metrics": {"vmaf": {"min": 88.5, "max": 99.2, "mean": 95.25, "harmonic_mean": 95.1}}
+	}`))
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, &VMAFScore{Mean: 95.25, Min: 88.5, Max: 99.2, HarmonicMean: 95.1}, got)
+
+	_, err = parseVMAFLog([]byte(`{"pooled_metrics": {}}`))
+	exam.NotNil(e, env, err)
+}
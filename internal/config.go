@@ -3,53 +3,325 @@ package internal
 import (
 	"errors"
 	"fmt"
-	"os"
+	"log/slog"
+	"math"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 )
 
 var (
-	ErrPanicEnvNotSet = errors.New("environment variable not set")
-	ErrPanicEnvNotInt = errors.New("environment variable is not an integer")
+	ErrPanicEnvNotSet  = errors.New("environment variable not set")
+	ErrPanicEnvNotInt  = errors.New("environment variable is not an integer")
+	ErrPanicEnvInvalid = errors.New("environment variable is invalid")
 )
 
 const (
-	EnvServerPort       = "VT_SERVER_PORT"
-	EnvDatabaseHost     = "VT_DB_HOST"
-	EnvDatabasePort     = "VT_DB_PORT"
-	EnvDatabaseUser     = "VT_DB_USER"
-	EnvDatabasePassword = "VT_DB_PASSWORD"
-	EnvDatabaseName     = "VT_DB_NAME"
+	EnvServerPort                    = "VT_SERVER_PORT"
+	EnvServerPublicURL               = "VT_SERVER_PUBLIC_URL"
+	EnvServerDownloadKey             = "VT_SERVER_DOWNLOAD_KEY"
+	EnvServerDownloadURLTTLSeconds   = "VT_SERVER_DOWNLOAD_URL_TTL_SECONDS"
+	EnvServerAllowedPaths            = "VT_SERVER_ALLOWED_PATHS"
+	EnvServerBlockPrivateWebhooks    = "VT_SERVER_BLOCK_PRIVATE_WEBHOOKS"
+	EnvServerTenantsFile             = "VT_SERVER_TENANTS_FILE"
+	EnvServerAdminKeys               = "VT_SERVER_ADMIN_KEYS"
+	EnvAutoMigrate                   = "VT_AUTO_MIGRATE"
+	EnvLogLevel                      = "VT_LOG_LEVEL"
+	EnvSecretsKeys                   = "VT_SECRETS_KEYS"
+	EnvDatabaseURL                   = "VT_DATABASE_URL"
+	EnvDatabaseReplicaURL            = "VT_DATABASE_REPLICA_URL"
+	EnvDatabaseHost                  = "VT_DB_HOST"
+	EnvDatabasePort                  = "VT_DB_PORT"
+	EnvDatabaseUser                  = "VT_DB_USER"
+	EnvDatabasePassword              = "VT_DB_PASSWORD"
+	EnvDatabaseName                  = "VT_DB_NAME"
+	EnvDatabaseSSLMode               = "VT_DB_SSLMODE"
+	EnvDatabaseSSLRootCert           = "VT_DB_SSLROOTCERT"
+	EnvDatabaseSSLCert               = "VT_DB_SSLCERT"
+	EnvDatabaseSSLKey                = "VT_DB_SSLKEY"
+	EnvDatabaseSchema                = "VT_DB_SCHEMA"
+	EnvDatabaseEmbeddedDir           = "VT_DB_EMBEDDED_DIR"
+	EnvDatabaseMaxConns              = "VT_DB_MAX_CONNS"
+	EnvDatabaseMinConns              = "VT_DB_MIN_CONNS"
+	EnvDatabaseMaxConnLifetime       = "VT_DB_MAX_CONN_LIFETIME_SECONDS"
+	EnvDatabaseHealthCheckPeriod     = "VT_DB_HEALTH_CHECK_PERIOD_SECONDS"
+	EnvWorkerMounts                  = "VT_WORKER_MOUNTS"
+	EnvWorkerProgressIntervalSeconds = "VT_WORKER_PROGRESS_INTERVAL_SECONDS"
+	EnvWorkerStallTimeoutSeconds     = "VT_WORKER_STALL_TIMEOUT_SECONDS"
+	EnvWorkerShutdownGraceSeconds    = "VT_WORKER_SHUTDOWN_GRACE_SECONDS"
+	EnvWorkerHeartbeatMinDelta       = "VT_WORKER_HEARTBEAT_MIN_PROGRESS_DELTA"
+	EnvWorkerPlugins                 = "VT_WORKER_PLUGINS"
+	EnvWorkerNice                    = "VT_WORKER_NICE"
+	EnvWorkerIOClass                 = "VT_WORKER_IONICE_CLASS"
+	EnvWorkerIOLevel                 = "VT_WORKER_IONICE_LEVEL"
+	EnvWorkerMemoryLimitMB           = "VT_WORKER_MEMORY_LIMIT_MB"
+	EnvWorkerOutputUID               = "VT_WORKER_OUTPUT_UID"
+	EnvWorkerOutputGID               = "VT_WORKER_OUTPUT_GID"
+	EnvWorkerOutputMode              = "VT_WORKER_OUTPUT_MODE"
+	EnvWorkerScratchDir              = "VT_WORKER_SCRATCH_DIR"
+	EnvWorkerScratchQuotaMB          = "VT_WORKER_SCRATCH_QUOTA_MB"
+	EnvWorkerSandboxUID              = "VT_WORKER_SANDBOX_UID"
+	EnvWorkerSandboxGID              = "VT_WORKER_SANDBOX_GID"
+	EnvWorkerSandboxBubblewrap       = "VT_WORKER_SANDBOX_BWRAP"
+	EnvWorkerSandboxWritablePaths    = "VT_WORKER_SANDBOX_WRITABLE_PATHS"
+	EnvWorkerSandboxSeccomp          = "VT_WORKER_SANDBOX_SECCOMP"
+	EnvWorkerWebhookTimeoutSeconds   = "VT_WORKER_WEBHOOK_TIMEOUT_SECONDS"
+	EnvWorkerWebhookMaxRedirects     = "VT_WORKER_WEBHOOK_MAX_REDIRECTS"
+	EnvWorkerWebhookCAFile           = "VT_WORKER_WEBHOOK_CA_FILE"
+	EnvWorkerWebhookInsecure         = "VT_WORKER_WEBHOOK_INSECURE_SKIP_VERIFY"
+	EnvWorkerWebhookClientCert       = "VT_WORKER_WEBHOOK_CLIENT_CERT"
+	EnvWorkerWebhookClientKey        = "VT_WORKER_WEBHOOK_CLIENT_KEY"
+	EnvWorkerWebhookSigningKey       = "VT_WORKER_WEBHOOK_SIGNING_KEY"
+	EnvWorkerWebhookBlockPrivate     = "VT_WORKER_WEBHOOK_BLOCK_PRIVATE"
+	EnvEventsBackend                 = "VT_EVENTS_BACKEND"
+	EnvEventsURL                     = "VT_EVENTS_URL"
+	EnvEventsSubject                 = "VT_EVENTS_SUBJECT"
+	EnvWatchServerURL                = "VT_WATCH_SERVER_URL"
+	EnvWatchAPIKey                   = "VT_WATCH_API_KEY"
+	EnvWatchDirs                     = "VT_WATCH_DIRS"
+	EnvWatchProfile                  = "VT_WATCH_PROFILE"
+	EnvWatchExtensions               = "VT_WATCH_EXTENSIONS"
+	EnvWatchOutputExtension          = "VT_WATCH_OUTPUT_EXTENSION"
+	EnvWatchScanIntervalSeconds      = "VT_WATCH_SCAN_INTERVAL_SECONDS"
+	EnvWatchSettleSeconds            = "VT_WATCH_SETTLE_SECONDS"
 )
 
+const (
+	// defaultServerPort is the port the HTTP server listens on by default.
+	defaultServerPort = 8080
+	// defaultDatabasePort is the standard PostgreSQL port.
+	defaultDatabasePort = 5432
+	// defaultDatabaseSSLMode preserves the historical behaviour of connecting without TLS.
+	defaultDatabaseSSLMode = "disable"
+	// defaultProgressInterval is how often running jobs record progress and send heartbeats by default.
+	defaultProgressInterval = 30 * time.Second
+	// defaultHeartbeatMinDelta is how many percentage points progress must advance between
+	// heartbeat webhooks by default.
+	defaultHeartbeatMinDelta = 1.0
+	// defaultStallTimeout is how long a running job's progress may stand still before the
+	// watchdog rescues it by default.
+	defaultStallTimeout = 30 * time.Minute
+	// defaultShutdownGrace is how long running jobs may keep going after a worker is
+	// asked to shut down by default.
+	defaultShutdownGrace = 30 * time.Second
+	// defaultDownloadURLTTL is how long signed output download URLs remain valid by default.
+	defaultDownloadURLTTL = time.Hour
+	// defaultWatchScanInterval is how often watched directories are scanned by default.
+	defaultWatchScanInterval = 10 * time.Second
+	// defaultWatchSettle is how long a file must be unchanged before it is submitted by default.
+	defaultWatchSettle = 30 * time.Second
+	// defaultEventsSubject is the NATS subject prefix or Kafka topic events are published to by default.
+	defaultEventsSubject = "video-transcoder"
+	// defaultWatchOutputExtension is the extension given to watch-folder outputs by default.
+	defaultWatchOutputExtension = ".mp4"
+)
+
+// defaultWatchExtensions are the source file extensions picked up by the watcher by default.
+var defaultWatchExtensions = []string{".mkv", ".mp4", ".m4v", ".mov", ".avi", ".ts", ".m2ts", ".webm"}
+
 // ServerConfig contains configuration for the HTTP server.
 type ServerConfig struct {
 	Port     int
 	Database *DatabaseConfig
+	// ReplicaDatabase is a read-only replica of Database that job status, list, and
+	// stats reads are sent to, leaving the primary to inserts and River.  If nil, every
+	// query goes to Database.
+	ReplicaDatabase *DatabaseConfig
+	// PublicURL is the externally reachable base URL of the server, used to build
+	// absolute download URLs.  If empty, download URLs are relative.
+	PublicURL string
+	// DownloadKey is the secret used to sign output download URLs.  If empty, a
+	// random key is generated at startup and URLs do not survive a restart.
+	DownloadKey string
+	// DownloadURLTTL is how long signed output download URLs remain valid.
+	DownloadURLTTL time.Duration
+	// AllowedPaths lists the directories that source and destination paths must be
+	// inside.  If empty, any path is allowed.
+	AllowedPaths []string
+	// BlockPrivateWebhooks rejects webhook URIs whose host is, or resolves to, a
+	// loopback, private, or link-local address.
+	BlockPrivateWebhooks bool
+	// Tenants scope jobs to the API key that created them.  If empty, requests aren't
+	// authenticated and every job is visible to every client.
+	Tenants []Tenant
+	// AdminKeys authenticate requests to the admin endpoints, which manage the API
+	// tokens stored in the database.  If empty, the admin endpoints are unavailable.
+	AdminKeys []string
+	// SecretsKeys are base64 AES-256 keys to encrypt webhook tokens with before they are
+	// stored in job args.  The first key encrypts; the rest only decrypt, for rotation.
+	// Workers need the same keys.  If empty, tokens are stored in plaintext.
+	SecretsKeys []string
+	// AutoMigrate runs migrations at startup.  If false, startup fails unless the schema
+	// is already up to date.
+	AutoMigrate bool
+	// Events configures job event publication.  If nil, no events are published.
+	Events *EventsConfig
+	// LogLevel is the minimum level of log output.  The zero value is info.
+	LogLevel slog.Level
 }
 
 // WorkerConfig contains configuration for the worker.
 type WorkerConfig struct {
 	Database *DatabaseConfig
+	// Mounts lists media mount points that must be mounted and writable
+	// before the worker starts and before each job runs.
+	Mounts []string
+	// ProgressInterval is how often running jobs record progress and send heartbeat
+	// webhooks, unless overridden per job.
+	ProgressInterval time.Duration
+	// HeartbeatMinDelta is how many percentage points progress must advance, on top of
+	// the progress interval passing, before another heartbeat webhook is sent.  Zero
+	// sends one every interval.
+	HeartbeatMinDelta float64
+	// StallTimeout is how long a running job's progress may stand still before the
+	// watchdog retries or fails it as stalled.  Zero only rescues jobs whose worker died.
+	StallTimeout time.Duration
+	// ShutdownGrace is how long running transcodes may keep going after the worker is
+	// asked to shut down.  Those still running are then stopped and requeued without
+	// using up an attempt.
+	ShutdownGrace time.Duration
+	// Limits controls the resources available to encoder subprocesses.
+	Limits *ProcessLimits
+	// Plugins are the paths of transcoder plugin executables, loaded at startup and
+	// again whenever a reload changes them.
+	Plugins []string
+	// Sandbox restricts encoder subprocesses.  If nil, encoders run unsandboxed.
+	Sandbox *Sandbox
+	// Output is the default ownership and mode of output files, which jobs may
+	// override.  If nil, outputs are left as the encoder created them.
+	Output *OutputOwnership
+	// Scratch holds the intermediate files of jobs.  If nil, they are kept next to
+	// the destination or in the system temporary directory.
+	Scratch *ScratchDir
+	// Webhooks configures the HTTP client that delivers webhooks.  If nil, it has a 30
+	// second timeout and follows up to 10 redirects.
+	Webhooks *WebhookHTTPConfig
+	// WebhookSigningKey is the path of a PEM ed25519 private key to sign webhook
+	// deliveries with.  If empty, deliveries are unsigned.
+	WebhookSigningKey string
+	// SecretsKeys decrypt the webhook tokens the server encrypted with the same keys.
+	SecretsKeys []string
+	// AutoMigrate runs migrations at startup.  If false, startup fails unless the schema
+	// is already up to date.
+	AutoMigrate bool
+	// Events configures job event publication.  If nil, no events are published.
+	Events *EventsConfig
+	// LogLevel is the minimum level of log output.  The zero value is info.
+	LogLevel slog.Level
+}
+
+// EventsConfig configures publication of job lifecycle events to an event bus.
+type EventsConfig struct {
+	Backend EventBackend
+	// URLs are the NATS server URLs or Kafka broker addresses.
+	URLs []string
+	// Subject is the NATS subject prefix, or the Kafka topic.
+	Subject string
+}
+
+// MigrateConfig contains configuration for the migrate command.
+type MigrateConfig struct {
+	Database *DatabaseConfig
+}
+
+// WatcherConfig contains configuration for the watch-folder daemon.
+type WatcherConfig struct {
+	// ServerURL is the base URL of the transcoder API server.
+	ServerURL string
+	// APIKey authenticates the watcher to a server with tenants, as the tenant its jobs
+	// belong to.
+	APIKey string
+	// Dirs maps each watched source directory to the directory outputs are written to.
+	Dirs []WatchDir
+	// Profile is the transcoding profile used for submitted jobs.
+	Profile Profile
+	// Extensions lists the (lowercase, dot-prefixed) source file extensions to submit.
+	Extensions []string
+	// OutputExtension replaces the source extension in destination paths.
+	OutputExtension string
+	// ScanInterval is how often the watched directories are scanned.
+	ScanInterval time.Duration
+	// Settle is how long a file's size and modification time must be unchanged
+	// before it is submitted, so files still being copied are skipped.
+	Settle time.Duration
+}
+
+// WatchDir is a watched source directory and its mapped destination directory.
+type WatchDir struct {
+	Source      string
+	Destination string
 }
 
 type DatabaseConfig struct {
+	// URL is a complete postgres:// connection string.  If set, the discrete fields are unused.
+	URL      string
 	Host     string
 	Port     int
 	User     string
 	Password string
 	Name     string
+	// SSLMode is the libpq sslmode (disable, allow, prefer, require, verify-ca, or verify-full).
+	SSLMode string
+	// SSLRootCert is the path to the CA certificate used to verify the server, if any.
+	SSLRootCert string
+	// SSLCert and SSLKey are the paths to a client certificate and key, if any.
+	SSLCert string
+	SSLKey  string
+	// Schema is the schema holding the application and River tables, which is created
+	// by migrations if it doesn't exist.  If empty, the connection's default search_path
+	// is used, which is normally public.
+	Schema string
+	// Pool tunes the connection pool.
+	Pool PoolConfig
+	// EmbeddedDir, if set, is where the combined binary keeps an embedded PostgreSQL
+	// server it starts on localhost at Port, for single-machine deployments without a
+	// database server of their own.
+	EmbeddedDir string
 }
 
-func mustGetenv(key string) string {
-	value, ok := os.LookupEnv(key)
+// PoolConfig tunes a database connection pool.  Zero values keep the pgxpool defaults.
+type PoolConfig struct {
+	MaxConns          int32
+	MinConns          int32
+	MaxConnLifetime   time.Duration
+	HealthCheckPeriod time.Duration
+}
+
+// validSSLModes are the sslmode values understood by pgx.
+var validSSLModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+// requiredEnv collects the values of required settings, remembering every one that is
+// missing so they can all be reported at once.
+type requiredEnv struct {
+	missing []string
+}
+
+// get returns the value of key, recording it as missing if it is unset.
+func (r *requiredEnv) get(key string) string {
+	value, ok := lookupEnv(key)
 	if !ok {
-		panic(fmt.Errorf("%w: %q", ErrPanicEnvNotSet, key))
+		r.missing = append(r.missing, strconv.Quote(key))
 	}
 	return value
 }
 
-func mustGetenvAtoi(key string) int {
-	valueStr := mustGetenv(key)
+// check panics with a single error listing every missing setting, if there are any.
+func (r *requiredEnv) check() {
+	if len(r.missing) > 0 {
+		panic(fmt.Errorf("%w: %s", ErrPanicEnvNotSet, strings.Join(r.missing, ", ")))
+	}
+}
+
+// getenvAtoi returns the integer value of key, or defaultValue if it is unset.
+func getenvAtoi(key string, defaultValue int) int {
+	valueStr, ok := lookupEnv(key)
+	if !ok {
+		return defaultValue
+	}
 	value, err := strconv.Atoi(valueStr)
 	if err != nil {
 		panic(fmt.Errorf("%w: %q", ErrPanicEnvNotInt, key))
@@ -57,27 +329,456 @@ func mustGetenvAtoi(key string) int {
 	return value
 }
 
+// getenvFloat returns the numeric value of key, or defaultValue if it is unset.
+func getenvFloat(key string, defaultValue float64) float64 {
+	valueStr, ok := lookupEnv(key)
+	if !ok {
+		return defaultValue
+	}
+	value, err := strconv.ParseFloat(valueStr, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		panic(fmt.Errorf("%w: %q: must be a number", ErrPanicEnvInvalid, key))
+	}
+	return value
+}
+
+// getenvBool returns the boolean value of key, or defaultValue if it is unset.
+func getenvBool(key string, defaultValue bool) bool {
+	valueStr, ok := lookupEnv(key)
+	if !ok {
+		return defaultValue
+	}
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		panic(fmt.Errorf("%w: %q: must be true or false", ErrPanicEnvInvalid, key))
+	}
+	return value
+}
+
+// getenvList returns the comma-separated values of key, or nil if it is unset or empty.
+func getenvList(key string) []string {
+	var values []string
+	for _, v := range strings.Split(getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// getenvDefault returns the value of key, or defaultValue if it is unset or empty.
+func getenvDefault(key, defaultValue string) string {
+	if value := getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// getenvSeconds returns the value of key as a number of seconds, or defaultValue if it is unset.
+func getenvSeconds(key string, defaultValue time.Duration) time.Duration {
+	return time.Duration(getenvAtoi(key, int(defaultValue/time.Second))) * time.Second
+}
+
+// databaseConfigFromEnv reads the database settings, recording missing ones in req.
+// VT_DATABASE_URL or VT_DB_EMBEDDED_DIR, if set, replaces the discrete VT_DB_* settings.
+func databaseConfigFromEnv(req *requiredEnv) *DatabaseConfig {
+	if dir := getenv(EnvDatabaseEmbeddedDir); dir != "" {
+		if getenv(EnvDatabaseURL) != "" {
+			panic(fmt.Errorf("%w: %q and %q must not be set together", ErrPanicEnvInvalid, EnvDatabaseURL, EnvDatabaseEmbeddedDir))
+		}
+		return &DatabaseConfig{
+			Host:        "localhost",
+			Port:        getenvAtoi(EnvDatabasePort, defaultDatabasePort),
+			User:        embeddedDatabaseName,
+			Password:    embeddedDatabaseName,
+			Name:        embeddedDatabaseName,
+			SSLMode:     "disable",
+			Schema:      databaseSchemaFromEnv(),
+			Pool:        poolConfigFromEnv(),
+			EmbeddedDir: dir,
+		}
+	}
+	if dbURL := getenv(EnvDatabaseURL); dbURL != "" {
+		checkDatabaseURL(EnvDatabaseURL, dbURL)
+		return &DatabaseConfig{URL: dbURL, Schema: databaseSchemaFromEnv(), Pool: poolConfigFromEnv()}
+	}
+	cfg := &DatabaseConfig{
+		Host:        req.get(EnvDatabaseHost),
+		Port:        getenvAtoi(EnvDatabasePort, defaultDatabasePort),
+		User:        req.get(EnvDatabaseUser),
+		Password:    req.get(EnvDatabasePassword),
+		Name:        req.get(EnvDatabaseName),
+		SSLMode:     getenvDefault(EnvDatabaseSSLMode, defaultDatabaseSSLMode),
+		SSLRootCert: getenv(EnvDatabaseSSLRootCert),
+		SSLCert:     getenv(EnvDatabaseSSLCert),
+		SSLKey:      getenv(EnvDatabaseSSLKey),
+		Schema:      databaseSchemaFromEnv(),
+		Pool:        poolConfigFromEnv(),
+	}
+	if !slices.Contains(validSSLModes, cfg.SSLMode) {
+		panic(fmt.Errorf("%w: %q: must be one of %s", ErrPanicEnvInvalid, EnvDatabaseSSLMode, strings.Join(validSSLModes, ", ")))
+	}
+	if (cfg.SSLCert == "") != (cfg.SSLKey == "") {
+		panic(fmt.Errorf("%w: %q and %q must be set together", ErrPanicEnvInvalid, EnvDatabaseSSLCert, EnvDatabaseSSLKey))
+	}
+	return cfg
+}
+
+// replicaDatabaseConfigFromEnv reads VT_DATABASE_REPLICA_URL, returning nil if it is
+// unset.  The replica has the same schema and pool settings as primary.
+func replicaDatabaseConfigFromEnv(primary *DatabaseConfig) *DatabaseConfig {
+	replicaURL := getenv(EnvDatabaseReplicaURL)
+	if replicaURL == "" {
+		return nil
+	}
+	checkDatabaseURL(EnvDatabaseReplicaURL, replicaURL)
+	return &DatabaseConfig{URL: replicaURL, Schema: primary.Schema, Pool: primary.Pool}
+}
+
+// checkDatabaseURL panics unless value, the value of key, is a postgres:// URL.
+func checkDatabaseURL(key, value string) {
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "postgres" && parsed.Scheme != "postgresql") {
+		panic(fmt.Errorf("%w: %q: must be a postgres:// URL", ErrPanicEnvInvalid, key))
+	}
+}
+
+// databaseSchemaPattern matches schema names that needn't be quoted and leave River
+// room to prefix the schema to its notification channels.
+var databaseSchemaPattern = regexp.MustCompile(`^[a-z_][a-z0-9_]{0,45}$`)
+
+func databaseSchemaFromEnv() string {
+	schema := getenv(EnvDatabaseSchema)
+	if schema != "" && !databaseSchemaPattern.MatchString(schema) {
+		panic(fmt.Errorf("%w: %q: must be at most 46 lowercase letters, digits, or underscores, not starting with a digit", ErrPanicEnvInvalid, EnvDatabaseSchema))
+	}
+	return schema
+}
+
+func poolConfigFromEnv() PoolConfig {
+	pool := PoolConfig{
+		MaxConns:          int32(getenvAtoi(EnvDatabaseMaxConns, 0)),
+		MinConns:          int32(getenvAtoi(EnvDatabaseMinConns, 0)),
+		MaxConnLifetime:   getenvSeconds(EnvDatabaseMaxConnLifetime, 0),
+		HealthCheckPeriod: getenvSeconds(EnvDatabaseHealthCheckPeriod, 0),
+	}
+	if pool.MaxConns < 0 || pool.MinConns < 0 || pool.MaxConnLifetime < 0 || pool.HealthCheckPeriod < 0 {
+		panic(fmt.Errorf("%w: database pool settings must not be negative", ErrPanicEnvInvalid))
+	}
+	if pool.MaxConns > 0 && pool.MinConns > pool.MaxConns {
+		panic(fmt.Errorf("%w: %q must not exceed %q", ErrPanicEnvInvalid, EnvDatabaseMinConns, EnvDatabaseMaxConns))
+	}
+	return pool
+}
+
+// logLevelFromEnv reads VT_LOG_LEVEL, which is debug, info, warn, or error, defaulting
+// to info.
+func logLevelFromEnv() slog.Level {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(getenvDefault(EnvLogLevel, "info"))); err != nil {
+		panic(fmt.Errorf("%w: %q: must be debug, info, warn, or error", ErrPanicEnvInvalid, EnvLogLevel))
+	}
+	return level
+}
+
+// eventsConfigFromEnv reads the event bus settings, recording missing ones in req.
+// It returns nil if VT_EVENTS_BACKEND is unset.
+func eventsConfigFromEnv(req *requiredEnv) *EventsConfig {
+	backend := EventBackend(getenv(EnvEventsBackend))
+	if backend == "" {
+		return nil
+	}
+	if !backend.IsValid() {
+		panic(fmt.Errorf("%w: %q: must be nats or kafka", ErrPanicEnvInvalid, EnvEventsBackend))
+	}
+	req.get(EnvEventsURL)
+	return &EventsConfig{
+		Backend: backend,
+		URLs:    getenvList(EnvEventsURL),
+		Subject: getenvDefault(EnvEventsSubject, defaultEventsSubject),
+	}
+}
+
 func NewServerConfigFromEnv() *ServerConfig {
+	loadConfigFile()
+	var req requiredEnv
+	database := databaseConfigFromEnv(&req)
+	events := eventsConfigFromEnv(&req)
+	req.check()
+
 	return &ServerConfig{
-		Port: mustGetenvAtoi(EnvServerPort),
-		Database: &DatabaseConfig{
-			Host:     mustGetenv(EnvDatabaseHost),
-			Port:     mustGetenvAtoi(EnvDatabasePort),
-			User:     mustGetenv(EnvDatabaseUser),
-			Password: mustGetenv(EnvDatabasePassword),
-			Name:     mustGetenv(EnvDatabaseName),
-		},
+		Port:                 getenvAtoi(EnvServerPort, defaultServerPort),
+		Database:             database,
+		ReplicaDatabase:      replicaDatabaseConfigFromEnv(database),
+		PublicURL:            getenv(EnvServerPublicURL),
+		DownloadKey:          getenv(EnvServerDownloadKey),
+		DownloadURLTTL:       getenvSeconds(EnvServerDownloadURLTTLSeconds, defaultDownloadURLTTL),
+		AllowedPaths:         getenvList(EnvServerAllowedPaths),
+		BlockPrivateWebhooks: getenvBool(EnvServerBlockPrivateWebhooks, false),
+		Tenants:              tenantsFromEnv(),
+		AdminKeys:            getenvList(EnvServerAdminKeys),
+		SecretsKeys:          getenvList(EnvSecretsKeys),
+		AutoMigrate:          getenvBool(EnvAutoMigrate, true),
+		Events:               events,
+		LogLevel:             logLevelFromEnv(),
 	}
 }
 
+func tenantsFromEnv() []Tenant {
+	path := getenv(EnvServerTenantsFile)
+	if path == "" {
+		return nil
+	}
+	tenants, err := LoadTenants(path)
+	if err != nil {
+		panic(fmt.Errorf("%w: %q: %w", ErrPanicEnvInvalid, EnvServerTenantsFile, err))
+	}
+	return tenants
+}
+
 func NewWorkerConfigFromEnv() *WorkerConfig {
+	loadConfigFile()
+	var req requiredEnv
+	database := databaseConfigFromEnv(&req)
+	events := eventsConfigFromEnv(&req)
+	req.check()
+
+	mounts := getenvList(EnvWorkerMounts)
+	scratch := scratchDirFromEnv()
 	return &WorkerConfig{
-		Database: &DatabaseConfig{
-			Host:     mustGetenv(EnvDatabaseHost),
-			Port:     mustGetenvAtoi(EnvDatabasePort),
-			User:     mustGetenv(EnvDatabaseUser),
-			Password: mustGetenv(EnvDatabasePassword),
-			Name:     mustGetenv(EnvDatabaseName),
-		},
+		Database:          database,
+		Mounts:            mounts,
+		ProgressInterval:  getenvSeconds(EnvWorkerProgressIntervalSeconds, defaultProgressInterval),
+		HeartbeatMinDelta: heartbeatMinDeltaFromEnv(),
+		StallTimeout:      getenvSeconds(EnvWorkerStallTimeoutSeconds, defaultStallTimeout),
+		ShutdownGrace:     shutdownGraceFromEnv(),
+		Limits:            processLimitsFromEnv(),
+		Plugins:           getenvList(EnvWorkerPlugins),
+		Sandbox:           sandboxFromEnv(mounts, scratch),
+		Output:            outputOwnershipFromEnv(),
+		Scratch:           scratch,
+		Webhooks:          webhookHTTPFromEnv(),
+		WebhookSigningKey: getenv(EnvWorkerWebhookSigningKey),
+		SecretsKeys:       getenvList(EnvSecretsKeys),
+		AutoMigrate:       getenvBool(EnvAutoMigrate, true),
+		Events:            events,
+		LogLevel:          logLevelFromEnv(),
+	}
+}
+
+func shutdownGraceFromEnv() time.Duration {
+	grace := getenvSeconds(EnvWorkerShutdownGraceSeconds, defaultShutdownGrace)
+	if grace < 0 {
+		panic(fmt.Errorf("%w: %q: must not be negative", ErrPanicEnvInvalid, EnvWorkerShutdownGraceSeconds))
+	}
+	// Past WorkerStaleAfter the watchdog could rescue draining jobs from a worker that
+	// hangs without deregistering
+	if grace >= WorkerStaleAfter {
+		panic(fmt.Errorf("%w: %q: must be less than %d", ErrPanicEnvInvalid, EnvWorkerShutdownGraceSeconds, int(WorkerStaleAfter.Seconds())))
+	}
+	return grace
+}
+
+func heartbeatMinDeltaFromEnv() float64 {
+	delta := getenvFloat(EnvWorkerHeartbeatMinDelta, defaultHeartbeatMinDelta)
+	if delta < 0 || delta > 100 {
+		panic(fmt.Errorf("%w: %q: must be between 0 and 100", ErrPanicEnvInvalid, EnvWorkerHeartbeatMinDelta))
+	}
+	return delta
+}
+
+func processLimitsFromEnv() *ProcessLimits {
+	limits := &ProcessLimits{
+		Nice:             getenvAtoi(EnvWorkerNice, 0),
+		IOClass:          IOClass(getenv(EnvWorkerIOClass)),
+		IOLevel:          getenvAtoi(EnvWorkerIOLevel, 4),
+		MemoryLimitBytes: int64(getenvAtoi(EnvWorkerMemoryLimitMB, 0)) << 20,
+	}
+	if limits.Nice < -20 || limits.Nice > 19 {
+		panic(fmt.Errorf("%w: %q: must be between -20 and 19", ErrPanicEnvInvalid, EnvWorkerNice))
+	}
+	if !limits.IOClass.IsValid() {
+		panic(fmt.Errorf("%w: %q: must be one of realtime, best-effort, or idle", ErrPanicEnvInvalid, EnvWorkerIOClass))
+	}
+	if limits.IOLevel < 0 || limits.IOLevel > 7 {
+		panic(fmt.Errorf("%w: %q: must be between 0 and 7", ErrPanicEnvInvalid, EnvWorkerIOLevel))
+	}
+	if limits.MemoryLimitBytes < 0 {
+		panic(fmt.Errorf("%w: %q: must not be negative", ErrPanicEnvInvalid, EnvWorkerMemoryLimitMB))
+	}
+	return limits
+}
+
+// sandboxFromEnv returns the encoder sandbox, or nil if none is configured.  Under
+// bubblewrap, encoders may write to the media mounts unless writable paths are set,
+// and always to the scratch directory.
+func sandboxFromEnv(mounts []string, scratch *ScratchDir) *Sandbox {
+	uid := getenvAtoi(EnvWorkerSandboxUID, 0)
+	gid := getenvAtoi(EnvWorkerSandboxGID, 0)
+	if uid < 0 {
+		panic(fmt.Errorf("%w: %q: must not be negative", ErrPanicEnvInvalid, EnvWorkerSandboxUID))
+	}
+	if gid < 0 {
+		panic(fmt.Errorf("%w: %q: must not be negative", ErrPanicEnvInvalid, EnvWorkerSandboxGID))
+	}
+	s := &Sandbox{
+		UID:           uint32(uid),
+		GID:           uint32(gid),
+		Bubblewrap:    getenvBool(EnvWorkerSandboxBubblewrap, false),
+		WritablePaths: getenvList(EnvWorkerSandboxWritablePaths),
+		SeccompPath:   getenv(EnvWorkerSandboxSeccomp),
+	}
+	if !s.Bubblewrap {
+		if s.WritablePaths != nil {
+			panic(fmt.Errorf("%w: %q: requires %q", ErrPanicEnvInvalid, EnvWorkerSandboxWritablePaths, EnvWorkerSandboxBubblewrap))
+		}
+		if s.SeccompPath != "" {
+			panic(fmt.Errorf("%w: %q: requires %q", ErrPanicEnvInvalid, EnvWorkerSandboxSeccomp, EnvWorkerSandboxBubblewrap))
+		}
+		if s.UID == 0 && s.GID == 0 {
+			return nil
+		}
+	} else {
+		if s.WritablePaths == nil {
+			s.WritablePaths = mounts
+		}
+		if scratch != nil {
+			s.WritablePaths = append(slices.Clone(s.WritablePaths), scratch.Path)
+		}
+	}
+	return s
+}
+
+// scratchDirFromEnv returns the scratch directory, or nil if none is configured.
+func scratchDirFromEnv() *ScratchDir {
+	path := getenv(EnvWorkerScratchDir)
+	quotaMB := getenvAtoi(EnvWorkerScratchQuotaMB, 0)
+	if quotaMB < 0 {
+		panic(fmt.Errorf("%w: %q: must not be negative", ErrPanicEnvInvalid, EnvWorkerScratchQuotaMB))
+	}
+	if path == "" {
+		if quotaMB != 0 {
+			panic(fmt.Errorf("%w: %q: requires %q", ErrPanicEnvInvalid, EnvWorkerScratchQuotaMB, EnvWorkerScratchDir))
+		}
+		return nil
+	}
+	if !filepath.IsAbs(path) {
+		panic(fmt.Errorf("%w: %q: must be an absolute path", ErrPanicEnvInvalid, EnvWorkerScratchDir))
+	}
+	return &ScratchDir{Path: path, QuotaBytes: int64(quotaMB) << 20}
+}
+
+// outputOwnershipFromEnv returns the default output ownership, or nil if none is configured.
+func outputOwnershipFromEnv() *OutputOwnership {
+	var output OutputOwnership
+	for _, id := range []struct {
+		key   string
+		value **int
+	}{{EnvWorkerOutputUID, &output.UID}, {EnvWorkerOutputGID, &output.GID}} {
+		if _, ok := lookupEnv(id.key); !ok {
+			continue
+		}
+		value := getenvAtoi(id.key, 0)
+		if value < 0 || value > MaxOutputID {
+			panic(fmt.Errorf("%w: %q: must be between 0 and %d", ErrPanicEnvInvalid, id.key, MaxOutputID))
+		}
+		*id.value = &value
+	}
+	if modeStr, ok := lookupEnv(EnvWorkerOutputMode); ok {
+		mode, err := ParseFileMode(modeStr)
+		if err != nil {
+			panic(fmt.Errorf("%w: %q: %w", ErrPanicEnvInvalid, EnvWorkerOutputMode, err))
+		}
+		output.Mode = &mode
+	}
+	if output == (OutputOwnership{}) {
+		return nil
+	}
+	return &output
+}
+
+// webhookHTTPFromEnv returns the webhook client settings, or nil if none are configured.
+func webhookHTTPFromEnv() *WebhookHTTPConfig {
+	cfg := WebhookHTTPConfig{
+		Timeout:            getenvSeconds(EnvWorkerWebhookTimeoutSeconds, defaultWebhookTimeout),
+		MaxRedirects:       getenvAtoi(EnvWorkerWebhookMaxRedirects, defaultWebhookMaxRedirects),
+		CAFile:             getenv(EnvWorkerWebhookCAFile),
+		InsecureSkipVerify: getenvBool(EnvWorkerWebhookInsecure, false),
+		CertFile:           getenv(EnvWorkerWebhookClientCert),
+		KeyFile:            getenv(EnvWorkerWebhookClientKey),
+		// Follows the server's setting unless overridden, so a combined deployment
+		// blocks private webhooks where they are delivered as well as where they are
+		// submitted
+		BlockPrivate: getenvBool(EnvWorkerWebhookBlockPrivate, getenvBool(EnvServerBlockPrivateWebhooks, false)),
+	}
+	if cfg.Timeout <= 0 {
+		panic(fmt.Errorf("%w: %q: must be positive", ErrPanicEnvInvalid, EnvWorkerWebhookTimeoutSeconds))
+	}
+	if cfg.MaxRedirects < 0 {
+		panic(fmt.Errorf("%w: %q: must not be negative", ErrPanicEnvInvalid, EnvWorkerWebhookMaxRedirects))
+	}
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		panic(fmt.Errorf("%w: %q and %q must be set together", ErrPanicEnvInvalid, EnvWorkerWebhookClientCert, EnvWorkerWebhookClientKey))
+	}
+	if cfg == (WebhookHTTPConfig{Timeout: defaultWebhookTimeout, MaxRedirects: defaultWebhookMaxRedirects}) {
+		return nil
+	}
+	return &cfg
+}
+
+func NewMigrateConfigFromEnv() *MigrateConfig {
+	loadConfigFile()
+	var req requiredEnv
+	database := databaseConfigFromEnv(&req)
+	req.check()
+
+	return &MigrateConfig{Database: database}
+}
+
+func NewWatcherConfigFromEnv() *WatcherConfig {
+	loadConfigFile()
+	var req requiredEnv
+	serverURL := req.get(EnvWatchServerURL)
+	req.get(EnvWatchDirs)
+	profile := Profile(req.get(EnvWatchProfile))
+	req.check()
+
+	var dirs []WatchDir
+	for _, spec := range getenvList(EnvWatchDirs) {
+		source, destination, ok := strings.Cut(spec, "=")
+		if !ok || source == "" || destination == "" {
+			panic(fmt.Errorf("%w: %q: expected source=destination, got %q", ErrPanicEnvInvalid, EnvWatchDirs, spec))
+		}
+		dirs = append(dirs, WatchDir{Source: source, Destination: destination})
+	}
+	if len(dirs) == 0 {
+		panic(fmt.Errorf("%w: %q", ErrPanicEnvNotSet, EnvWatchDirs))
+	}
+
+	if !profile.IsValid() {
+		panic(fmt.Errorf("%w: %q", ErrPanicInvalidProfile, profile))
+	}
+
+	extensions := defaultWatchExtensions
+	if values := getenvList(EnvWatchExtensions); values != nil {
+		extensions = nil
+		for _, ext := range values {
+			ext = strings.ToLower(ext)
+			if !strings.HasPrefix(ext, ".") {
+				ext = "." + ext
+			}
+			extensions = append(extensions, ext)
+		}
+	}
+
+	return &WatcherConfig{
+		ServerURL:       serverURL,
+		APIKey:          getenv(EnvWatchAPIKey),
+		Dirs:            dirs,
+		Profile:         profile,
+		Extensions:      extensions,
+		OutputExtension: getenvDefault(EnvWatchOutputExtension, defaultWatchOutputExtension),
+		ScanInterval:    getenvSeconds(EnvWatchScanIntervalSeconds, defaultWatchScanInterval),
+		Settle:          getenvSeconds(EnvWatchSettleSeconds, defaultWatchSettle),
 	}
 }
@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnvConfigFile names a YAML file that supplies defaults for the other VT_ settings.
+const EnvConfigFile = "VT_CONFIG_FILE"
+
+var ErrPanicConfigFile = errors.New("invalid config file")
+
+// configFileValues holds the settings loaded from the config file, keyed by environment
+// variable name.  Environment variables take precedence over these.  It is replaced
+// whole on each load, since reloads run alongside readers on other goroutines.
+var configFileValues atomic.Pointer[map[string]string]
+
+// loadConfigFile reads the file named by VT_CONFIG_FILE, if set.
+//
+// Keys are the environment variable names in lowercase without the VT_ prefix, so
+// db_host sets VT_DB_HOST.  Lists are joined with commas, matching the env var syntax:
+//
+//	server_port: 8080
+//	db_host: postgres
+//	db_password_file: /run/secrets/db-password
+//	worker_mounts:
+//	  - /nas/media
+func loadConfigFile() {
+	path := os.Getenv(EnvConfigFile)
+	if path == "" {
+		configFileValues.Store(nil)
+		return
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		panic(fmt.Errorf("%w: %w", ErrPanicConfigFile, err))
+	}
+	values, err := parseConfigFile(data)
+	if err != nil {
+		panic(fmt.Errorf("%w: %s: %w", ErrPanicConfigFile, path, err))
+	}
+	configFileValues.Store(&values)
+}
+
+// parseConfigFile converts a YAML config file into environment variable values.
+func parseConfigFile(data []byte) (map[string]string, error) {
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(raw))
+	for key, value := range raw {
+		envKey := "VT_" + strings.ToUpper(key)
+		switch v := value.(type) {
+		case nil:
+			continue
+		case []any:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				s, ok := configScalar(item)
+				if !ok {
+					return nil, fmt.Errorf("%s: list items must be scalars", key)
+				}
+				items = append(items, s)
+			}
+			values[envKey] = strings.Join(items, ",")
+		default:
+			s, ok := configScalar(v)
+			if !ok {
+				return nil, fmt.Errorf("%s: must be a scalar or a list", key)
+			}
+			values[envKey] = s
+		}
+	}
+	return values, nil
+}
+
+func configScalar(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case int, int64, uint64, float64, bool:
+		return fmt.Sprint(v), true
+	default:
+		return "", false
+	}
+}
+
+// fileSuffix marks a setting whose value is read from the named file, following the
+// Docker and Kubernetes secrets convention (e.g. VT_DB_PASSWORD_FILE).
+const fileSuffix = "_FILE"
+
+// lookupEnv returns the value of key from the environment, falling back to the config file.
+// In each place, key itself takes precedence over its _FILE variant.
+func lookupEnv(key string) (string, bool) {
+	if value, ok := os.LookupEnv(key); ok {
+		return value, true
+	}
+	if path, ok := os.LookupEnv(key + fileSuffix); ok {
+		return readSecretFile(key+fileSuffix, path), true
+	}
+	var values map[string]string
+	if loaded := configFileValues.Load(); loaded != nil {
+		values = *loaded
+	}
+	if value, ok := values[key]; ok {
+		return value, true
+	}
+	if path, ok := values[key+fileSuffix]; ok {
+		return readSecretFile(key+fileSuffix, path), true
+	}
+	return "", false
+}
+
+// readSecretFile returns the contents of path without the trailing newline most editors
+// and secret stores add.
+func readSecretFile(key, path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		panic(fmt.Errorf("%w: %q: %w", ErrPanicEnvInvalid, key, err))
+	}
+	return strings.TrimRight(string(data), "\r\n")
+}
+
+// getenv is like os.Getenv, but falls back to the config file.
+func getenv(key string) string {
+	value, _ := lookupEnv(key)
+	return value
+}
@@ -3,28 +3,72 @@ package internal
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// ConnString returns the connection string for the database.
+func (cfg *DatabaseConfig) ConnString() string {
+	if cfg.URL != "" {
+		return cfg.URL
+	}
+	query := url.Values{}
+	query.Set("sslmode", cfg.SSLMode)
+	if cfg.SSLRootCert != "" {
+		query.Set("sslrootcert", cfg.SSLRootCert)
+	}
+	if cfg.SSLCert != "" {
+		query.Set("sslcert", cfg.SSLCert)
+		query.Set("sslkey", cfg.SSLKey)
+	}
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		Path:     cfg.Name,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
+
+// PoolSchema returns the schema a pool created by NewDBPool uses, or "" for the
+// default search_path.
+func PoolSchema(pool *pgxpool.Pool) string {
+	return pool.Config().ConnConfig.RuntimeParams["search_path"]
+}
+
 // NewDBPool creates a new pgxpool.Pool from the given DatabaseConfig.
 func NewDBPool(ctx context.Context, cfg *DatabaseConfig) (*pgxpool.Pool, error) {
-	connString := fmt.Sprintf(
-		"postgres://%s:%s@%s:%d/%s?sslmode=disable",
-		cfg.User,
-		cfg.Password,
-		cfg.Host,
-		cfg.Port,
-		cfg.Name,
-	)
-
-	pool, err := pgxpool.New(ctx, connString)
+	poolConfig, err := pgxpool.ParseConfig(cfg.ConnString())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database config: %w", err)
+	}
+	if cfg.Schema != "" {
+		poolConfig.ConnConfig.RuntimeParams["search_path"] = cfg.Schema
+	}
+	if cfg.Pool.MaxConns > 0 {
+		poolConfig.MaxConns = cfg.Pool.MaxConns
+	}
+	if cfg.Pool.MinConns > 0 {
+		poolConfig.MinConns = cfg.Pool.MinConns
+	}
+	if cfg.Pool.MaxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = cfg.Pool.MaxConnLifetime
+	}
+	if cfg.Pool.HealthCheckPeriod > 0 {
+		poolConfig.HealthCheckPeriod = cfg.Pool.HealthCheckPeriod
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create database pool: %w", err)
 	}
 
-	// Verify connection
-	if err := pool.Ping(ctx); err != nil {
+	// Verify connection, waiting out a database that is restarting
+	if err := RetryDB(ctx, func() error { return pool.Ping(ctx) }); err != nil {
 		pool.Close()
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
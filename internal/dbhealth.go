@@ -0,0 +1,142 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// dbRetryTimeout is how long RetryDB keeps retrying while the database is
+	// unreachable, long enough to ride out a Postgres restart or failover.
+	dbRetryTimeout = 2 * time.Minute
+	// dbRetryMinBackoff and dbRetryMaxBackoff bound the wait between retries.
+	dbRetryMinBackoff = 500 * time.Millisecond
+	dbRetryMaxBackoff = 10 * time.Second
+	// dbMonitorInterval is how often a DBMonitor pings the database.
+	dbMonitorInterval = 5 * time.Second
+	// dbMonitorPingTimeout is how long a DBMonitor waits for a ping to be answered.
+	dbMonitorPingTimeout = 3 * time.Second
+)
+
+// IsDBUnavailable reports whether err means the database couldn't be reached or
+// dropped the connection, rather than that it rejected the statement, so the statement
+// is worth trying again once the database is back.
+func IsDBUnavailable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// Connection exceptions, and the server shutting down or still starting up
+		return strings.HasPrefix(pgErr.Code, "08") || pgErr.Code == "57P01" || pgErr.Code == "57P02" || pgErr.Code == "57P03"
+	}
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	return errors.As(err, &connectErr) || errors.As(err, &netErr) || pgconn.SafeToRetry(err) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// RetryDB calls fn until it succeeds, fails for a reason other than the database being
+// unavailable, or the database has been unavailable for dbRetryTimeout, backing off
+// between attempts.  fn must be safe to repeat, such as a whole transaction.
+func RetryDB(ctx context.Context, fn func() error) error {
+	return retryDB(ctx, dbRetryTimeout, dbRetryMinBackoff, fn)
+}
+
+func retryDB(ctx context.Context, timeout, backoff time.Duration, fn func() error) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		err := fn()
+		if !IsDBUnavailable(err) || time.Now().Add(backoff).After(deadline) {
+			return err
+		}
+		slog.Warn("Database unavailable, retrying", "backoff", backoff, "error", err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, dbRetryMaxBackoff)
+	}
+}
+
+// DBMonitor pings the database in the background and remembers whether it is reachable,
+// so readiness checks don't each need a connection of their own.
+type DBMonitor struct {
+	pool *pgxpool.Pool
+
+	mu    sync.Mutex
+	err   error
+	since time.Time
+}
+
+// NewDBMonitor creates a monitor of pool, which is assumed reachable until Run's first
+// ping says otherwise.
+func NewDBMonitor(pool *pgxpool.Pool) *DBMonitor {
+	return &DBMonitor{pool: pool, since: time.Now()}
+}
+
+// Run pings the database every dbMonitorInterval until ctx is done, logging each time
+// it becomes unreachable or reachable again.
+func (m *DBMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(dbMonitorInterval)
+	defer ticker.Stop()
+	for {
+		pingCtx, cancel := context.WithTimeout(ctx, dbMonitorPingTimeout)
+		err := m.pool.Ping(pingCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		m.record(err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *DBMonitor) record(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if (err == nil) != (m.err == nil) {
+		if err != nil {
+			slog.Warn("Database became unavailable", "error", err)
+		} else {
+			log.Printf("Database available again after %s", time.Since(m.since).Round(time.Second))
+		}
+		m.since = time.Now()
+	}
+	m.err = err
+}
+
+// Check pings the database now, records the result as Run does, and returns the
+// ping's error, for callers that can't act on a status that may be a few seconds old.
+func (m *DBMonitor) Check(ctx context.Context) error {
+	pingCtx, cancel := context.WithTimeout(ctx, dbMonitorPingTimeout)
+	defer cancel()
+	err := m.pool.Ping(pingCtx)
+	if ctx.Err() == nil {
+		m.record(err)
+	}
+	return err
+}
+
+// Status returns when the database last became reachable or unreachable, and the error
+// of the last ping, which is nil if the database was reachable.
+func (m *DBMonitor) Status() (since time.Time, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.since, m.err
+}
@@ -0,0 +1,112 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestIsDBUnavailable(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc  exam.Loc
+		name string
+		err  error
+		want bool
+	}{
+		{
+			loc:  exam.Here(),
+			name: "No error",
+		},
+		{
+			loc:  exam.Here(),
+			name: "Connection refused",
+			err:  fmt.Errorf("failed to begin transaction: %w", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}),
+			want: true,
+		},
+		{
+			loc:  exam.Here(),
+			name: "Connection dropped",
+			err:  fmt.Errorf("failed to commit transaction: %w", io.ErrUnexpectedEOF),
+			want: true,
+		},
+		{
+			loc:  exam.Here(),
+			name: "Server shutting down",
+			err:  &pgconn.PgError{Code: "57P01", Message: "terminating connection due to administrator command"},
+			want: true,
+		},
+		{
+			loc:  exam.Here(),
+			name: "Server starting up",
+			err:  &pgconn.PgError{Code: "57P03", Message: "the database system is starting up"},
+			want: true,
+		},
+		{
+			loc:  exam.Here(),
+			name: "Constraint violation",
+			err:  fmt.Errorf("failed to insert: %w", &pgconn.PgError{Code: "23505", Message: "duplicate key value violates unique constraint"}),
+		},
+		{
+			loc:  exam.Here(),
+			name: "Context cancelled",
+			err:  fmt.Errorf("failed to ping database: %w", context.Canceled),
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.want, IsDBUnavailable(tt.err))
+		})
+	}
+}
+
+func TestRetryDB(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+	unavailable := fmt.Errorf("failed to begin transaction: %w", io.ErrUnexpectedEOF)
+
+	e.Run("Retries until the database is back", func(e exam.E) {
+		calls := 0
+		err := retryDB(context.Background(), time.Second, time.Millisecond, func() error {
+			calls++
+			if calls < 3 {
+				return unavailable
+			}
+			return nil
+		})
+		exam.Nil(e, env, err).Log(err).Must()
+		exam.Equal(e, env, 3, calls)
+	})
+
+	e.Run("Doesn't retry other errors", func(e exam.E) {
+		calls := 0
+		rejected := &pgconn.PgError{Code: "23505"}
+		err := retryDB(context.Background(), time.Second, time.Millisecond, func() error {
+			calls++
+			return rejected
+		})
+		exam.Equal(e, env, error(rejected), err)
+		exam.Equal(e, env, 1, calls)
+	})
+
+	e.Run("Gives up after the timeout", func(e exam.E) {
+		calls := 0
+		err := retryDB(context.Background(), 10*time.Millisecond, 4*time.Millisecond, func() error {
+			calls++
+			return unavailable
+		})
+		exam.Equal(e, env, true, errors.Is(err, io.ErrUnexpectedEOF))
+		exam.Equal(e, env, 2, calls)
+	})
+}
@@ -0,0 +1,43 @@
+package internal
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+)
+
+// embeddedDatabaseName is the database, user, and password of the embedded server,
+// which only listens on localhost.
+const embeddedDatabaseName = "videotranscoder"
+
+// embeddedDatabaseVersion is the PostgreSQL version the embedded server runs.  Its data
+// directory is only reused by the same major version, so changing it loses existing jobs.
+const embeddedDatabaseVersion = embeddedpostgres.V16
+
+// StartEmbeddedDatabase starts the embedded PostgreSQL server cfg.EmbeddedDir describes,
+// creating its data directory there on first use, and returns a function that stops it.
+// The server binaries are downloaded into the directory the first time, and PostgreSQL
+// refuses to run as root.
+func StartEmbeddedDatabase(cfg *DatabaseConfig) (func() error, error) {
+	dir, err := filepath.Abs(cfg.EmbeddedDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve embedded database directory: %w", err)
+	}
+	db := embeddedpostgres.NewDatabase(embeddedpostgres.DefaultConfig().
+		Version(embeddedDatabaseVersion).
+		Port(uint32(cfg.Port)).
+		Username(cfg.User).
+		Password(cfg.Password).
+		Database(cfg.Name).
+		DataPath(filepath.Join(dir, "data")).
+		RuntimePath(filepath.Join(dir, "runtime")).
+		CachePath(filepath.Join(dir, "cache")).
+		Logger(log.Writer()))
+	if err := db.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start embedded database: %w", err)
+	}
+	log.Printf("Started embedded database in %s on port %d", dir, cfg.Port)
+	return db.Stop, nil
+}
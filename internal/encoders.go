@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"time"
+)
+
+// The external programs used to encode jobs.
+const (
+	ToolFFmpeg    = "ffmpeg"
+	ToolFFprobe   = "ffprobe"
+	ToolHandBrake = "HandBrakeCLI"
+)
+
+// encoderVersionTimeout bounds how long a tool may take to report its version.
+const encoderVersionTimeout = 10 * time.Second
+
+// encoderVersionArgs are the arguments that make each tool print its version and exit.
+var encoderVersionArgs = map[string][]string{
+	ToolFFmpeg:    {"-version"},
+	ToolFFprobe:   {"-version"},
+	ToolHandBrake: {"--version"},
+}
+
+// encoderVersionRegex matches the version in the first lines of "ffmpeg version 6.1.1
+// Copyright ..." and "HandBrake 1.7.2".
+var encoderVersionRegex = regexp.MustCompile(`(?m)^(?:\S+ version|HandBrake) (\S+)`)
+
+// EncoderCheck is the result of checking that an encoding tool can be run.
+type EncoderCheck struct {
+	Tool string
+	// Path is where the tool was found on the PATH.
+	Path string
+	// Version is the version the tool reported, if it ran.
+	Version string
+	// Err is why the tool can't be used, or nil.
+	Err error
+}
+
+// CheckEncoders looks for each encoding tool on the PATH and runs it to get its version.
+func CheckEncoders(ctx context.Context) []EncoderCheck {
+	checks := make([]EncoderCheck, 0, len(encoderVersionArgs))
+	for _, tool := range []string{ToolFFmpeg, ToolFFprobe, ToolHandBrake} {
+		checks = append(checks, checkEncoder(ctx, tool))
+	}
+	return checks
+}
+
+func checkEncoder(ctx context.Context, tool string) EncoderCheck {
+	check := EncoderCheck{Tool: tool}
+	path, err := exec.LookPath(tool)
+	if err != nil {
+		check.Err = err
+		return check
+	}
+	check.Path = path
+
+	ctx, cancel := context.WithTimeout(ctx, encoderVersionTimeout)
+	defer cancel()
+	// HandBrake logs to stderr, so only stdout is searched for the version.
+	output, err := exec.CommandContext(ctx, path, encoderVersionArgs[tool]...).Output()
+	if err != nil {
+		check.Err = fmt.Errorf("failed to run %s: %w", tool, err)
+		return check
+	}
+	matches := encoderVersionRegex.FindSubmatch(output)
+	if matches == nil {
+		check.Err = fmt.Errorf("%s did not report a version", tool)
+		return check
+	}
+	check.Version = string(matches[1])
+	return check
+}
+
+// AvailableTools returns the tools of the checks that succeeded.
+func AvailableTools(checks []EncoderCheck) []string {
+	tools := []string{}
+	for _, check := range checks {
+		if check.Err == nil {
+			tools = append(tools, check.Tool)
+		}
+	}
+	return tools
+}
+
+// ToolVersions returns the versions reported by the checks that succeeded, keyed by tool.
+func ToolVersions(checks []EncoderCheck) map[string]string {
+	versions := make(map[string]string, len(checks))
+	for _, check := range checks {
+		if check.Err == nil {
+			versions[check.Tool] = check.Version
+		}
+	}
+	return versions
+}
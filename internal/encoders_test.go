@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestCheckEncoders(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	dir := t.TempDir()
+	scripts := map[string]string{
+		ToolFFmpeg:    "#!/bin/sh\necho 'ffmpeg version 6.1.1-3ubuntu5 Copyright (c) 2000-2023 the FFmpeg developers'\n",
+		ToolHandBrake: "#!/bin/sh\necho '[12:00:00] hb_init: starting libhb thread' >&2\necho 'HandBrake 1.7.2'\n",
+		ToolFFprobe:   "#!/bin/sh\nexit 1\n",
+	}
+	for tool, script := range scripts {
+		err := os.WriteFile(filepath.Join(dir, tool), []byte(script), 0o755)
+		exam.Nil(e, env, err).Log(err).Must()
+	}
+	t.Setenv("PATH", dir)
+
+	checks := CheckEncoders(context.Background())
+	exam.Equal(e, env, 3, len(checks))
+	exam.Equal(e, env, "6.1.1-3ubuntu5", checks[0].Version)
+	exam.Equal(e, env, filepath.Join(dir, ToolFFmpeg), checks[0].Path)
+	exam.NotNil(e, env, checks[1].Err)
+	exam.Equal(e, env, "1.7.2", checks[2].Version)
+	exam.Equal(e, env, []string{ToolFFmpeg, ToolHandBrake}, AvailableTools(checks))
+
+	versions := ToolVersions(checks)
+	exam.Equal(e, env, map[string]string{ToolFFmpeg: "6.1.1-3ubuntu5", ToolHandBrake: "1.7.2"}, versions)
+	exam.Equal(e, env, map[string]string{ToolFFmpeg: "6.1.1-3ubuntu5"}, ProfilePreview.ToolVersions(versions))
+}
+
+func TestProfileMissingTools(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc       exam.Loc
+		profile   Profile
+		available []string
+		want      []string
+	}{
+		{loc: exam.Here(), profile: ProfilePreview, available: []string{ToolFFmpeg, ToolFFprobe}, want: nil},
+		{loc: exam.Here(), profile: ProfileArchive, available: []string{ToolFFmpeg, ToolFFprobe}, want: []string{ToolHandBrake}},
+		{loc: exam.Here(), profile: ProfileWebM, available: []string{ToolHandBrake}, want: []string{ToolFFmpeg, ToolFFprobe}},
+	}
+	for _, tt := range tests {
+		e.Run(string(tt.profile), func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.want, tt.profile.MissingTools(tt.available))
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	// kafkaQueueSize bounds the events waiting to be written to Kafka.
+	kafkaQueueSize = 1000
+	// kafkaWriteTimeout bounds each write to Kafka, so an unreachable broker can't keep
+	// Close waiting.
+	kafkaWriteTimeout = 10 * time.Second
+)
+
+var (
+	// ErrEventQueueFull is returned when an event is dropped because too many events
+	// are already waiting to be published.
+	ErrEventQueueFull = errors.New("event queue is full")
+	// ErrEventPublisherClosed is returned when an event is published after Close.
+	ErrEventPublisherClosed = errors.New("event publisher is closed")
+)
+
+// EventBackend is the event bus job events are published to.
+type EventBackend string
+
+const (
+	EventBackendNATS  EventBackend = "nats"
+	EventBackendKafka EventBackend = "kafka"
+)
+
+// IsValid reports whether b is a supported event backend.
+func (b EventBackend) IsValid() bool {
+	return b == EventBackendNATS || b == EventBackendKafka
+}
+
+// JobEventType is the kind of a job lifecycle event.
+type JobEventType string
+
+const (
+	JobEventCreated   JobEventType = "created"
+	JobEventStarted   JobEventType = "started"
+	JobEventProgress  JobEventType = "progress"
+	JobEventCompleted JobEventType = "completed"
+	JobEventFailed    JobEventType = "failed"
+)
+
+// JobEvent is the JSON message published for each job lifecycle event.
+type JobEvent struct {
+	Type       JobEventType        `json:"type"`
+	UUID       uuid.UUID           `json:"uuid"`
+	OccurredAt time.Time           `json:"occurredAt"`
+	Attempt    int                 `json:"attempt,omitempty"`
+	RequestID  string              `json:"requestId,omitempty"`
+	Labels     map[string]string   `json:"labels,omitempty"`
+	Status     *TranscodeJobStatus `json:"status,omitempty"`
+}
+
+// EventPublisher publishes job lifecycle events.
+type EventPublisher interface {
+	Publish(ctx context.Context, event JobEvent) error
+	Close() error
+}
+
+// NewEventPublisher connects to the configured event bus.  If cfg is nil, the returned
+// publisher discards every event.
+func NewEventPublisher(cfg *EventsConfig) (EventPublisher, error) {
+	if cfg == nil {
+		return nopPublisher{}, nil
+	}
+	switch cfg.Backend {
+	case EventBackendNATS:
+		conn, err := nats.Connect(strings.Join(cfg.URLs, ","))
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+		}
+		return &natsPublisher{conn: conn, subject: cfg.Subject}, nil
+	case EventBackendKafka:
+		return newKafkaPublisher(&kafka.Writer{
+			Addr:     kafka.TCP(cfg.URLs...),
+			Topic:    cfg.Subject,
+			Balancer: &kafka.Hash{},
+			// Events are published one at a time; don't wait for a batch to fill.
+			BatchTimeout: 10 * time.Millisecond,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unsupported event backend %q", cfg.Backend)
+	}
+}
+
+type nopPublisher struct{}
+
+func (nopPublisher) Publish(context.Context, JobEvent) error { return nil }
+func (nopPublisher) Close() error                            { return nil }
+
+// natsPublisher publishes each event to the subject "<prefix>.<type>", so consumers can
+// subscribe to a single kind of event or to "<prefix>.>" for all of them.
+type natsPublisher struct {
+	conn    *nats.Conn
+	subject string
+}
+
+func (p *natsPublisher) Publish(_ context.Context, event JobEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	if err := p.conn.Publish(p.subject+"."+string(event.Type), data); err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+	return nil
+}
+
+func (p *natsPublisher) Close() error {
+	return p.conn.Drain()
+}
+
+// kafkaPublisher publishes every event to one topic, keyed by job UUID so all events for
+// a job land on the same partition and are consumed in order.  Events are queued and
+// written in the background, so a slow or unreachable broker can't hold up the job
+// publishing them; once kafkaQueueSize events are waiting, further ones are dropped.
+type kafkaPublisher struct {
+	writer *kafka.Writer
+	queue  chan kafka.Message
+	done   chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+func newKafkaPublisher(writer *kafka.Writer) *kafkaPublisher {
+	p := &kafkaPublisher{
+		writer: writer,
+		queue:  make(chan kafka.Message, kafkaQueueSize),
+		done:   make(chan struct{}),
+	}
+	go p.run()
+	return p
+}
+
+func (p *kafkaPublisher) Publish(_ context.Context, event JobEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return ErrEventPublisherClosed
+	}
+	select {
+	case p.queue <- kafka.Message{
+		Key:     []byte(event.UUID.String()),
+		Value:   data,
+		Headers: []kafka.Header{{Key: "type", Value: []byte(event.Type)}},
+	}:
+		return nil
+	default:
+		return ErrEventQueueFull
+	}
+}
+
+// run writes the queued events until the queue is closed.
+func (p *kafkaPublisher) run() {
+	defer close(p.done)
+	for msg := range p.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), kafkaWriteTimeout)
+		if err := p.writer.WriteMessages(ctx, msg); err != nil {
+			slog.Warn("failed to publish event", "type", string(msg.Headers[0].Value), "uuid", string(msg.Key), "error", err)
+		}
+		cancel()
+	}
+}
+
+// Close writes the events still queued and disconnects.
+func (p *kafkaPublisher) Close() error {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.queue)
+	}
+	p.mu.Unlock()
+	<-p.done
+	return p.writer.Close()
+}
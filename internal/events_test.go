@@ -0,0 +1,25 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+	"github.com/segmentio/kafka-go"
+)
+
+func TestKafkaPublisherDropsWhenQueueFull(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	// Without run draining the queue, the second event doesn't fit
+	p := &kafkaPublisher{writer: &kafka.Writer{}, queue: make(chan kafka.Message, 1)}
+	event := JobEvent{Type: JobEventProgress, UUID: uuid.New()}
+	exam.Nil(e, env, p.Publish(context.Background(), event)).Must()
+	exam.Equal(e, env, ErrEventQueueFull, p.Publish(context.Background(), event))
+
+	p.closed = true
+	exam.Equal(e, env, ErrEventPublisherClosed, p.Publish(context.Background(), event))
+}
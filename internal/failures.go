@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"errors"
+	"io/fs"
+	"os/exec"
+	"strings"
+	"syscall"
+)
+
+var (
+	// ErrFFmpegFailed is wrapped by errors from ffmpeg runs that exit unsuccessfully.
+	ErrFFmpegFailed = errors.New("ffmpeg failed")
+	// ErrHandBrakeFailed is wrapped by errors from HandBrake runs that exit unsuccessfully.
+	ErrHandBrakeFailed = errors.New("HandBrake failed")
+)
+
+// exitCommandNotFound is the exit status the process wrappers (nice, ionice, prlimit)
+// use when they can't find the encoder to exec.
+const exitCommandNotFound = 127
+
+// invalidInputMessages are encoder messages reporting a source that can't be decoded.
+var invalidInputMessages = []string{
+	"Invalid data found when processing input",
+	"could not find codec parameters",
+	"moov atom not found",
+	"Decoder (codec",
+	"Unknown decoder",
+	"No title found",
+	"matches no streams",
+}
+
+// noSpaceMessages are encoder messages reporting a full filesystem.
+var noSpaceMessages = []string{
+	"No space left on device",
+}
+
+// mountTimeoutMessages are encoder messages reporting an unresponsive network mount.
+var mountTimeoutMessages = []string{
+	"Connection timed out",
+	"Stale file handle",
+	"Input/output error",
+	"Host is down",
+}
+
+// ClassifyFailure returns the error code for a transcode failure and whether it is
+// permanent.  Permanent failures will fail again on retry, so they shouldn't be retried;
+// anything else is assumed to be transient.  Unrecognised errors have no code.
+func ClassifyFailure(err error) (code *ErrorCode, permanent bool) {
+	classify := func(c ErrorCode, p bool) (*ErrorCode, bool) {
+		return &c, p
+	}
+	msg := err.Error()
+	switch {
+	case errors.Is(err, ErrMountUnavailable),
+		errors.Is(err, syscall.ETIMEDOUT),
+		errors.Is(err, syscall.ESTALE),
+		errors.Is(err, syscall.EIO),
+		containsAny(msg, mountTimeoutMessages):
+		return classify(ErrorCodeMountUnavailable, false)
+	case errors.Is(err, syscall.ENOSPC), errors.Is(err, ErrScratchQuotaExceeded), containsAny(msg, noSpaceMessages):
+		return classify(ErrorCodeDiskFull, false)
+	case errors.Is(err, exec.ErrNotFound), exitStatus(err) == exitCommandNotFound:
+		return classify(ErrorCodeEncoderNotInstalled, true)
+	case errors.Is(err, ErrMemoryLimitExceeded):
+		return classify(ErrorCodeMemoryLimitExceeded, true)
+	case errors.Is(err, fs.ErrNotExist):
+		return classify(ErrorCodeSourceNotFound, true)
+	case containsAny(msg, invalidInputMessages):
+		return classify(ErrorCodeInvalidInput, true)
+	case errors.Is(err, ErrFFmpegFailed):
+		return classify(ErrorCodeFFmpegError, false)
+	case errors.Is(err, ErrHandBrakeFailed):
+		return classify(ErrorCodeHandBrakeError, false)
+	default:
+		return nil, false
+	}
+}
+
+// exitStatus returns the exit status of the process that failed with err, or -1.
+func exitStatus(err error) int {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
+
+func containsAny(s string, substrs []string) bool {
+	for _, substr := range substrs {
+		if strings.Contains(s, substr) {
+			return true
+		}
+	}
+	return false
+}
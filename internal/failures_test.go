@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestClassifyFailure(t *testing.T) {
+	tests := []struct {
+		loc           exam.Loc
+		name          string
+		err           error
+		wantCode      ErrorCode
+		wantPermanent bool
+	}{
+		{
+			loc:      exam.Here(),
+			name:     "Mount unavailable",
+			err:      fmt.Errorf("%w: %q is not mounted", ErrMountUnavailable, "/nas"),
+			wantCode: ErrorCodeMountUnavailable,
+		},
+		{
+			loc:      exam.Here(),
+			name:     "Mount timeout",
+			err:      fmt.Errorf("source not accessible: %w", &os.PathError{Op: "stat", Path: "/nas/a.mkv", Err: syscall.ETIMEDOUT}),
+			wantCode: ErrorCodeMountUnavailable,
+		},
+		{
+			loc:      exam.Here(),
+			name:     "Disk full",
+			err:      fmt.Errorf("%w: exit status 1: av_interleaved_write_frame(): No space left on device", ErrFFmpegFailed),
+			wantCode: ErrorCodeDiskFull,
+		},
+		{
+			loc:           exam.Here(),
+			name:          "Missing source",
+			err:           fmt.Errorf("source not accessible: %w", &os.PathError{Op: "stat", Path: "/nas/a.mkv", Err: syscall.ENOENT}),
+			wantCode:      ErrorCodeSourceNotFound,
+			wantPermanent: true,
+		},
+		{
+			loc:           exam.Here(),
+			name:          "Undecodable source",
+			err:           errors.New("failed to probe duration: exit status 1: /nas/a.mkv: Invalid data found when processing input"),
+			wantCode:      ErrorCodeInvalidInput,
+			wantPermanent: true,
+		},
+		{
+			loc:           exam.Here(),
+			name:          "Memory limit",
+			err:           fmt.Errorf("%w: %w", ErrFFmpegFailed, ErrMemoryLimitExceeded),
+			wantCode:      ErrorCodeMemoryLimitExceeded,
+			wantPermanent: true,
+		},
+		{
+			loc:           exam.Here(),
+			name:          "Encoder not installed",
+			err:           fmt.Errorf("failed to start HandBrake: %w", exec.ErrNotFound),
+			wantCode:      ErrorCodeEncoderNotInstalled,
+			wantPermanent: true,
+		},
+		{
+			loc:      exam.Here(),
+			name:     "Other ffmpeg failure",
+			err:      fmt.Errorf("%w: signal: terminated", ErrFFmpegFailed),
+			wantCode: ErrorCodeFFmpegError,
+		},
+		{
+			loc:  exam.Here(),
+			name: "Unknown",
+			err:  errors.New("failed to create stderr pipe: too many open files"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			e.Log("Running test at", tt.loc)
+			env := deep.NewEnv()
+			code, permanent := ClassifyFailure(tt.err)
+			var gotCode ErrorCode
+			if code != nil {
+				gotCode = *code
+			}
+			exam.Equal(e, env, tt.wantCode, gotCode)
+			exam.Equal(e, env, tt.wantPermanent, permanent)
+		})
+	}
+}
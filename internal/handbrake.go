@@ -0,0 +1,70 @@
+package internal
+
+import (
+	"strconv"
+)
+
+// MaxHandBrakeQuality is the highest (lowest quality) RF HandBrake's x264 and x265
+// encoders accept.
+const MaxHandBrakeQuality = 51
+
+// HandBrakeEncoderPresets are the encoder presets a job may choose, from fastest to
+// smallest output.  x264 and x265 share them.
+var HandBrakeEncoderPresets = []string{
+	"ultrafast", "superfast", "veryfast", "faster", "fast", "medium", "slow", "slower", "veryslow",
+}
+
+// HandBrakeEncoderTunes are the encoder tunes a job may choose, those both x264 and
+// x265 support.
+var HandBrakeEncoderTunes = []string{"animation", "grain", "psnr", "ssim", "fastdecode", "zerolatency"}
+
+// HandBrakeAudioEncoders are the audio encoders a job may choose.  The copy encoders
+// pass the source audio through, falling back to the preset's encoder for audio of
+// other codecs.
+var HandBrakeAudioEncoders = []string{
+	"av_aac", "ac3", "eac3", "opus", "mp3", "flac16", "flac24",
+	"copy", "copy:aac", "copy:ac3", "copy:eac3", "copy:dts", "copy:dtshd", "copy:truehd", "copy:flac",
+}
+
+// HandBrakeOptions are HandBrakeCLI settings layered onto a profile's preset for a
+// single job.  Only profiles for which Profile.SupportsHandBrakeOptions is true accept
+// them, and only values from the allowlists above, so jobs can't pass arbitrary
+// arguments to HandBrake.
+type HandBrakeOptions struct {
+	// EncoderPreset is one of HandBrakeEncoderPresets; empty keeps the profile's.
+	EncoderPreset string `json:"encoderPreset,omitempty"`
+	// EncoderTune is one of HandBrakeEncoderTunes; empty keeps the profile's.
+	EncoderTune string `json:"encoderTune,omitempty"`
+	// Quality is the constant quality RF, from 0 to MaxHandBrakeQuality; nil keeps the
+	// profile's.
+	Quality *float64 `json:"quality,omitempty"`
+	// AudioEncoder is one of HandBrakeAudioEncoders; empty keeps the profile's.
+	AudioEncoder string `json:"audioEncoder,omitempty"`
+	// AllSubtitles keeps every subtitle track, rather than the preset's selection.
+	AllSubtitles bool `json:"allSubtitles,omitempty"`
+}
+
+// handbrakeArgs returns the HandBrakeCLI options for o, which come after the profile's
+// so they take precedence.  o may be nil.
+func (o *HandBrakeOptions) handbrakeArgs() []string {
+	if o == nil {
+		return nil
+	}
+	var args []string
+	if o.EncoderPreset != "" {
+		args = append(args, "--encoder-preset", o.EncoderPreset)
+	}
+	if o.EncoderTune != "" {
+		args = append(args, "--encoder-tune", o.EncoderTune)
+	}
+	if o.Quality != nil {
+		args = append(args, "--quality", strconv.FormatFloat(*o.Quality, 'f', -1, 64))
+	}
+	if o.AudioEncoder != "" {
+		args = append(args, "--aencoder", o.AudioEncoder)
+	}
+	if o.AllSubtitles {
+		args = append(args, "--all-subtitles")
+	}
+	return args
+}
@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestHandBrakeOptionsArgs(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	quality := 20.5
+	tests := []struct {
+		loc     exam.Loc
+		name    string
+		options *HandBrakeOptions
+		want    []string
+	}{
+		{loc: exam.Here(), name: "Nil", options: nil, want: nil},
+		{loc: exam.Here(), name: "Empty", options: &HandBrakeOptions{}, want: nil},
+		{
+			loc:  exam.Here(),
+			name: "Everything",
+			options: &HandBrakeOptions{
+				EncoderPreset: "slower",
+				EncoderTune:   "animation",
+				Quality:       &quality,
+				AudioEncoder:  "copy:ac3",
+				AllSubtitles:  true,
+			},
+			want: []string{
+				"--encoder-preset", "slower",
+				"--encoder-tune", "animation",
+				"--quality", "20.5",
+				"--aencoder", "copy:ac3",
+				"--all-subtitles",
+			},
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.want, tt.options.handbrakeArgs())
+		})
+	}
+}
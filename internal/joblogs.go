@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// JobLogFlushInterval is how often buffered encoder output is written to job_logs,
+	// and so how far behind a followed log can be.
+	JobLogFlushInterval = time.Second
+	// maxJobLogLines bounds the lines kept per attempt so a chatty encoder can't fill
+	// the database.
+	maxJobLogLines = 10000
+	// maxJobLogLineBytes bounds the length of a single line.
+	maxJobLogLineBytes = 4096
+	// jobLogWriteTimeout bounds each attempt to write to job_logs.
+	jobLogWriteTimeout = 10 * time.Second
+)
+
+// JobLogger collects the encoder output of one attempt of a job and writes it to the
+// job_logs table in batches.  Its Line method is a LogCallback and may be called
+// concurrently.
+type JobLogger struct {
+	pool    *pgxpool.Pool
+	jobID   int64
+	attempt int
+
+	mu      sync.Mutex
+	pending []string
+	lines   int
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewJobLogger starts a JobLogger for the given River job attempt.  Call Close to
+// write the remaining output.
+func NewJobLogger(pool *pgxpool.Pool, jobID int64, attempt int) *JobLogger {
+	l := &JobLogger{
+		pool:    pool,
+		jobID:   jobID,
+		attempt: attempt,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go l.run()
+	return l
+}
+
+// Line records a line of encoder output.
+func (l *JobLogger) Line(line string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	switch {
+	case l.lines < maxJobLogLines:
+		if len(line) > maxJobLogLineBytes {
+			line = strings.ToValidUTF8(line[:maxJobLogLineBytes], "")
+		}
+		l.pending = append(l.pending, line)
+	case l.lines == maxJobLogLines:
+		l.pending = append(l.pending, fmt.Sprintf("[log truncated after %d lines]", maxJobLogLines))
+	}
+	l.lines++
+}
+
+// take returns the lines recorded since the last call.
+func (l *JobLogger) take() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	lines := l.pending
+	l.pending = nil
+	return lines
+}
+
+func (l *JobLogger) run() {
+	defer close(l.done)
+	ticker := time.NewTicker(JobLogFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.stop:
+			l.flush()
+			return
+		case <-ticker.C:
+			l.flush()
+		}
+	}
+}
+
+// flush writes the pending lines, retrying while the database is unavailable.  That
+// happens in the logger's goroutine, so the encoder isn't held up meanwhile.  Other
+// failures are logged and the lines dropped; the job log is a diagnostic aid and
+// mustn't fail the transcode.
+func (l *JobLogger) flush() {
+	lines := l.take()
+	if len(lines) == 0 {
+		return
+	}
+	err := RetryDB(context.Background(), func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), jobLogWriteTimeout)
+		defer cancel()
+		_, err := l.pool.Exec(ctx,
+			"INSERT INTO job_logs (river_job_id, attempt, line) SELECT $1, $2, unnest($3::text[])",
+			l.jobID, l.attempt, lines)
+		return err
+	})
+	if err != nil {
+		slog.Warn("failed to write job log lines", "lines", len(lines), "error", err)
+	}
+}
+
+// Close writes the remaining output and stops the logger.
+func (l *JobLogger) Close() {
+	close(l.stop)
+	<-l.done
+}
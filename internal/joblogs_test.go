@@ -0,0 +1,29 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestJobLoggerLine(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	l := &JobLogger{}
+	l.Line("first")
+	l.Line(strings.Repeat("x", maxJobLogLineBytes+10))
+	got := l.take()
+	exam.Equal(e, env, []string{"first", strings.Repeat("x", maxJobLogLineBytes)}, got)
+	exam.Equal(e, env, []string(nil), l.take())
+
+	for i := l.lines; i < maxJobLogLines+5; i++ {
+		l.Line("more")
+	}
+	got = l.take()
+	exam.Equal(e, env, maxJobLogLines-2+1, len(got))
+	exam.Equal(e, env, fmt.Sprintf("[log truncated after %d lines]", maxJobLogLines), got[len(got)-1])
+}
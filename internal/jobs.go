@@ -1,17 +1,68 @@
 package internal
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
 
 // TranscodeJobArgs contains the arguments for a transcode job.
 // This is used as the River job args payload.
 type TranscodeJobArgs struct {
-	UUID                uuid.UUID `json:"uuid"`
+	UUID                uuid.UUID `json:"uuid" river:"unique"`
 	SourcePath          string    `json:"sourcePath"`
 	DestinationPath     string    `json:"destinationPath"`
-	Profile             Profile    `json:"profile"`
+	Profile             Profile   `json:"profile"`
 	WebhookURI          *string   `json:"webhookUri,omitempty"`
 	WebhookToken        []byte    `json:"webhookToken,omitempty"`
 	HeartbeatWebhookURI *string   `json:"heartbeatWebhookUri,omitempty"`
+	// SealedWebhookToken holds WebhookToken instead, encrypted, when secrets keys are configured.
+	SealedWebhookToken *SealedSecret `json:"sealedWebhookToken,omitempty"`
+	// WebhookTokenHeader sends WebhookToken in this header instead of the payload.
+	WebhookTokenHeader string `json:"webhookTokenHeader,omitempty"`
+	// HeartbeatIntervalSeconds overrides how often progress is recorded and heartbeats are sent.
+	HeartbeatIntervalSeconds *int `json:"heartbeatIntervalSeconds,omitempty"`
+	// Labels are arbitrary client-defined labels attached to the job.
+	Labels map[string]string `json:"labels,omitempty"`
+	// GroupID places the job in a client-defined group, such as a season of a TV show,
+	// whose aggregate status can be queried.
+	GroupID string `json:"groupId,omitempty"`
+	// ParallelSegments is how many segments to encode concurrently; nil encodes one at a time.
+	ParallelSegments *int `json:"parallelSegments,omitempty"`
+	// Webhooks are additional webhook destinations, each with its own token and event filter.
+	Webhooks []WebhookTarget `json:"webhooks,omitempty"`
+	// Audio overrides the profile's audio encoding.
+	Audio *AudioOptions `json:"audio,omitempty"`
+	// Subtitles controls the output subtitles.
+	Subtitles *SubtitleOptions `json:"subtitles,omitempty"`
+	// Video adjusts the profile's video processing.
+	Video *VideoOptions `json:"video,omitempty"`
+	// Streams overrides which source streams are kept.
+	Streams *StreamSelection `json:"streams,omitempty"`
+	// Animation configures the animated profile.
+	Animation *AnimationOptions `json:"animation,omitempty"`
+	// HandBrake adds HandBrakeCLI settings to the preset of HandBrake profiles.
+	HandBrake *HandBrakeOptions `json:"handbrake,omitempty"`
+	// Chapters controls chapters generated for sources without any.
+	Chapters *ChapterOptions `json:"chapters,omitempty"`
+	// Renditions are the outputs of the renditions profile, written alongside DestinationPath.
+	Renditions []Rendition `json:"renditions,omitempty"`
+	// Workflow places the job in a workflow, if it was submitted as a workflow step.
+	Workflow *WorkflowRef `json:"workflow,omitempty"`
+	// Output overrides the worker's ownership and mode for the output files.
+	Output *OutputOwnership `json:"output,omitempty"`
+	// ReuseCompleted skips encoding when a recently completed job that also set it
+	// encoded an identical source the same way into the same destination.
+	ReuseCompleted bool `json:"reuseCompleted,omitempty"`
+	// SkipIfValid skips encoding when the outputs already exist and pass verification.
+	SkipIfValid bool `json:"skipIfValid,omitempty"`
+	// Compare inserts a comparison of the output against the source, with the job's
+	// UUID, once the job completes.
+	Compare *CompareOptions `json:"compare,omitempty"`
+	// Tenant is the tenant that submitted the job, if the server has tenants.
+	Tenant string `json:"tenant,omitempty"`
 }
 
 // Kind returns the job kind identifier for River.
@@ -19,26 +70,124 @@ func (TranscodeJobArgs) Kind() string {
 	return "transcode"
 }
 
+// InsertOpts makes the UUID unique across transcode jobs in every state, so River
+// rejects a second job with the same UUID for as long as the first is retained.
+func (TranscodeJobArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:  true,
+			ByState: rivertype.JobStates(),
+		},
+	}
+}
+
 // TranscodeJobStatus represents the current status of a transcode job.
 // This is stored as River job output via river.RecordOutput() and can be
 // read by both server and worker.
 type TranscodeJobStatus struct {
 	// Progress is the transcoding progress percentage (0-100).
 	Progress float64 `json:"progress"`
+	// Speed is the encoding speed as a multiple of realtime, if known.
+	Speed *float64 `json:"speed,omitempty"`
+	// EstimatedSecondsRemaining is the estimated time until the transcode finishes, if known.
+	EstimatedSecondsRemaining *float64 `json:"estimatedSecondsRemaining,omitempty"`
+	// Frame is the number of frames encoded so far, if known.
+	Frame *int64 `json:"frame,omitempty"`
+	// FPS is the current encoding rate in frames per second, if known.
+	FPS *float64 `json:"fps,omitempty"`
+	// BitrateKbps is the current output bitrate in kilobits per second, if known.
+	BitrateKbps *float64 `json:"bitrateKbps,omitempty"`
+	// ProgressAt is when Progress last advanced, for detecting stalled jobs.
+	ProgressAt *time.Time `json:"progressAt,omitempty"`
+	// PositionSeconds is how far into the source the encoder had got, if known.
+	PositionSeconds *float64 `json:"positionSeconds,omitempty"`
+	// PausedAt is when the job was stopped by its worker shutting down.  Progress and
+	// PositionSeconds are where it stopped, until another worker picks it up.
+	PausedAt *time.Time `json:"pausedAt,omitempty"`
+	// OutputSizeBytes is the size of the finished output file, if the job succeeded.
+	OutputSizeBytes *int64 `json:"outputSizeBytes,omitempty"`
+	// OutputDurationSeconds is the duration of the finished output file, if the job succeeded.
+	OutputDurationSeconds *float64 `json:"outputDurationSeconds,omitempty"`
+	// EncodeSeconds is the wall-clock time spent transcoding, once the job has finished.
+	EncodeSeconds *float64 `json:"encodeSeconds,omitempty"`
 	// Error contains an error message if the job failed.
 	Error *string `json:"error,omitempty"`
+	// ErrorCode contains a machine-readable failure code if the job failed.
+	ErrorCode *ErrorCode `json:"errorCode,omitempty"`
+	// Renditions is the status of each rendition of a renditions job.
+	Renditions []RenditionStatus `json:"renditions,omitempty"`
+	// ToolVersions are the versions of the tools used to encode the job, keyed by tool.
+	ToolVersions map[string]string `json:"toolVersions,omitempty"`
+	// Commands are the encoder command lines the job's latest attempt has run so far,
+	// with secrets redacted.
+	Commands []string `json:"commands,omitempty"`
+	// SourceSHA256 is the content hash of the source, for jobs that reuse completed jobs.
+	SourceSHA256 string `json:"sourceSha256,omitempty"`
+	// ReuseKey identifies the outputs of the job, for jobs that reuse completed jobs.
+	ReuseKey string `json:"reuseKey,omitempty"`
+	// ReusedFrom is the UUID of the completed job whose outputs this job reused
+	// instead of encoding.
+	ReusedFrom *uuid.UUID `json:"reusedFrom,omitempty"`
+	// SkippedExisting is set when the outputs already existed and passed verification,
+	// so the job didn't encode them.
+	SkippedExisting bool `json:"skippedExisting,omitempty"`
+	// Partial is set when a soft cancel stopped the encoder early and it finalized
+	// the outputs it had written.
+	Partial bool `json:"partial,omitempty"`
 }
 
+// ErrorCode is a machine-readable classification of a transcode failure.
+type ErrorCode string
+
+const (
+	// ErrorCodeMountUnavailable indicates that a required media mount was missing or not writable.
+	ErrorCodeMountUnavailable ErrorCode = "MOUNT_UNAVAILABLE"
+	// ErrorCodeMemoryLimitExceeded indicates that the encoder exceeded its configured memory limit.
+	ErrorCodeMemoryLimitExceeded ErrorCode = "MEMORY_LIMIT_EXCEEDED"
+	// ErrorCodeSourceNotFound indicates that the source file does not exist.
+	ErrorCodeSourceNotFound ErrorCode = "SOURCE_NOT_FOUND"
+	// ErrorCodeInvalidInput indicates that the source could not be decoded.
+	ErrorCodeInvalidInput ErrorCode = "INVALID_INPUT"
+	// ErrorCodeDiskFull indicates that the destination filesystem ran out of space.
+	ErrorCodeDiskFull ErrorCode = "DISK_FULL"
+	// ErrorCodeEncoderNotInstalled indicates that the encoder binary is missing on the worker.
+	ErrorCodeEncoderNotInstalled ErrorCode = "ENCODER_NOT_INSTALLED"
+	// ErrorCodeCancelled indicates that the job was cancelled while it was running.
+	ErrorCodeCancelled ErrorCode = "CANCELLED"
+	// ErrorCodeFFmpegError indicates that ffmpeg failed for a reason not covered by another code.
+	ErrorCodeFFmpegError ErrorCode = "FFMPEG_ERROR"
+	// ErrorCodeHandBrakeError indicates that HandBrake failed for a reason not covered by another code.
+	ErrorCodeHandBrakeError ErrorCode = "HANDBRAKE_ERROR"
+	// ErrorCodeStalled indicates that the job's worker died or its progress stopped advancing.
+	ErrorCodeStalled ErrorCode = "STALLED"
+)
+
 // WebhookJobArgs contains the arguments for a webhook notification job.
 type WebhookJobArgs struct {
-	URI         string              `json:"uri"`
-	Token       []byte              `json:"token,omitempty"`
+	URI   string `json:"uri"`
+	Token []byte `json:"token,omitempty"`
+	// SealedToken holds Token instead, encrypted, when secrets keys are configured.
+	SealedToken *SealedSecret `json:"sealedToken,omitempty"`
+	// TokenHeader sends Token in this header instead of the payload.
+	TokenHeader string              `json:"tokenHeader,omitempty"`
 	UUID        uuid.UUID           `json:"uuid"`
 	Status      *TranscodeJobStatus `json:"status,omitempty"`
 	IsHeartbeat bool                `json:"isHeartbeat,omitempty"`
+	Labels      map[string]string   `json:"labels,omitempty"`
+	// RequestID is the ID of the API request that created the transcode job.
+	RequestID string `json:"requestId,omitempty"`
+	// DestinationPath and Profile describe the transcode job in completion webhooks.
+	DestinationPath string  `json:"destinationPath,omitempty"`
+	Profile         Profile `json:"profile,omitempty"`
 }
 
 // Kind returns the job kind identifier for River.
 func (WebhookJobArgs) Kind() string {
 	return "webhook"
 }
+
+// WebhookDeliveryOutput is recorded on a webhook job after each attempt that got a response.
+type WebhookDeliveryOutput struct {
+	// StatusCode is the HTTP status code returned by the receiver.
+	StatusCode int `json:"statusCode"`
+}
@@ -0,0 +1,22 @@
+package internal
+
+import (
+	"log/slog"
+	"os"
+)
+
+// logLevel is the minimum level of the output of the handler installed by SetupLogging.
+var logLevel slog.LevelVar
+
+// SetupLogging sends log output, including that of the log package, to stderr through a
+// handler that drops records below level.  Output of the log package is at info level.
+func SetupLogging(level slog.Level) {
+	logLevel.Set(level)
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: &logLevel})))
+}
+
+// SetLogLevel changes the minimum level of the log output installed by SetupLogging.
+// It has no effect on output that doesn't go through it.
+func SetLogLevel(level slog.Level) {
+	logLevel.Set(level)
+}
@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestSetLogLevel(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	// SetupLogging takes over the log package too, so put both back
+	previous, writer, flags := slog.Default(), log.Writer(), log.Flags()
+	defer func() {
+		slog.SetDefault(previous)
+		log.SetOutput(writer)
+		log.SetFlags(flags)
+	}()
+	ctx := context.Background()
+
+	SetupLogging(slog.LevelInfo)
+	exam.Equal(e, env, true, slog.Default().Enabled(ctx, slog.LevelInfo))
+	exam.Equal(e, env, false, slog.Default().Enabled(ctx, slog.LevelDebug))
+
+	// The installed logger follows later changes of the level
+	SetLogLevel(slog.LevelDebug)
+	exam.Equal(e, env, true, slog.Default().Enabled(ctx, slog.LevelDebug))
+	SetLogLevel(slog.LevelWarn)
+	exam.Equal(e, env, false, slog.Default().Enabled(ctx, slog.LevelInfo))
+	exam.Equal(e, env, true, slog.Default().Enabled(ctx, slog.LevelWarn))
+}
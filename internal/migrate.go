@@ -5,10 +5,15 @@ import (
 	"embed"
 	"errors"
 	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/riverqueue/river/riverdriver/riverpgxv5"
 	"github.com/riverqueue/river/rivermigrate"
@@ -39,6 +44,18 @@ func releaseAdvisoryLock(ctx context.Context, pool *pgxpool.Pool) error {
 	return nil
 }
 
+// ensureSchema creates the pool's schema if it has one that doesn't exist yet.
+func ensureSchema(ctx context.Context, pool *pgxpool.Pool) error {
+	schema := PoolSchema(pool)
+	if schema == "" {
+		return nil
+	}
+	if _, err := pool.Exec(ctx, "CREATE SCHEMA IF NOT EXISTS "+pgx.Identifier{schema}.Sanitize()); err != nil {
+		return fmt.Errorf("failed to create schema %s: %w", schema, err)
+	}
+	return nil
+}
+
 // createMigrator creates a new golang-migrate instance using the embedded SQL files.
 func createMigrator(pool *pgxpool.Pool) (*migrate.Migrate, error) {
 	sourceDriver, err := iofs.New(migrationsFS, "migrations")
@@ -47,6 +64,17 @@ func createMigrator(pool *pgxpool.Pool) (*migrate.Migrate, error) {
 	}
 
 	dbURL := pool.Config().ConnString()
+	if schema := PoolSchema(pool); schema != "" {
+		// Keep the schema_migrations table and application tables in the schema too
+		parsed, err := url.Parse(dbURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse connection string: %w", err)
+		}
+		query := parsed.Query()
+		query.Set("search_path", schema)
+		parsed.RawQuery = query.Encode()
+		dbURL = parsed.String()
+	}
 	m, err := migrate.NewWithSourceInstance("iofs", sourceDriver, dbURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create migrator: %w.  Connection string: %q", err, dbURL)
@@ -62,9 +90,12 @@ func MigrateUp(ctx context.Context, pool *pgxpool.Pool) error {
 		return err
 	}
 	defer releaseAdvisoryLock(ctx, pool)
+	if err := ensureSchema(ctx, pool); err != nil {
+		return err
+	}
 
 	// Run River migrations first
-	riverMigrator, err := rivermigrate.New(riverpgxv5.New(pool), nil)
+	riverMigrator, err := rivermigrate.New(riverpgxv5.New(pool), &rivermigrate.Config{Schema: PoolSchema(pool)})
 	if err != nil {
 		return fmt.Errorf("failed to create river migrator: %w", err)
 	}
@@ -108,7 +139,7 @@ func MigrateDown(ctx context.Context, pool *pgxpool.Pool) error {
 	}
 
 	// Run River migrations down
-	riverMigrator, err := rivermigrate.New(riverpgxv5.New(pool), nil)
+	riverMigrator, err := rivermigrate.New(riverpgxv5.New(pool), &rivermigrate.Config{Schema: PoolSchema(pool)})
 	if err != nil {
 		return fmt.Errorf("failed to create river migrator: %w", err)
 	}
@@ -120,3 +151,167 @@ func MigrateDown(ctx context.Context, pool *pgxpool.Pool) error {
 
 	return nil
 }
+
+// MigrationStatus describes the schema versions currently applied to a database.
+type MigrationStatus struct {
+	// River is the latest applied River migration, or 0 if none.
+	River int
+	// App is the latest applied application migration, or 0 if none.
+	App uint
+	// Dirty is true if the last application migration failed part way through.
+	Dirty bool
+}
+
+// MigrationVersion reports the River and application schema versions.
+func MigrationVersion(ctx context.Context, pool *pgxpool.Pool) (*MigrationStatus, error) {
+	riverMigrator, err := rivermigrate.New(riverpgxv5.New(pool), &rivermigrate.Config{Schema: PoolSchema(pool)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create river migrator: %w", err)
+	}
+	riverVersions, err := riverMigrator.ExistingVersions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get river migration versions: %w", err)
+	}
+
+	m, err := createMigrator(pool)
+	if err != nil {
+		return nil, err
+	}
+	defer m.Close()
+
+	status := &MigrationStatus{}
+	if len(riverVersions) > 0 {
+		status.River = riverVersions[len(riverVersions)-1].Version
+	}
+	status.App, status.Dirty, err = m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return nil, fmt.Errorf("failed to get application migration version: %w", err)
+	}
+	return status, nil
+}
+
+// MigrateSteps applies n application migrations, or rolls back -n of them if n is
+// negative.  River migrations are left alone.
+// It acquires a postgres advisory lock to prevent concurrent migrations.
+func MigrateSteps(ctx context.Context, pool *pgxpool.Pool, n int) error {
+	if err := acquireAdvisoryLock(ctx, pool); err != nil {
+		return err
+	}
+	defer releaseAdvisoryLock(ctx, pool)
+	if err := ensureSchema(ctx, pool); err != nil {
+		return err
+	}
+
+	m, err := createMigrator(pool)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := m.Steps(n); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("failed to step application migrations by %d: %w", n, err)
+	}
+	return nil
+}
+
+// MigrateTo migrates the application schema up or down to the given version, after
+// bringing River's schema up to date.
+// It acquires a postgres advisory lock to prevent concurrent migrations.
+func MigrateTo(ctx context.Context, pool *pgxpool.Pool, version uint) error {
+	if err := acquireAdvisoryLock(ctx, pool); err != nil {
+		return err
+	}
+	defer releaseAdvisoryLock(ctx, pool)
+	if err := ensureSchema(ctx, pool); err != nil {
+		return err
+	}
+
+	riverMigrator, err := rivermigrate.New(riverpgxv5.New(pool), &rivermigrate.Config{Schema: PoolSchema(pool)})
+	if err != nil {
+		return fmt.Errorf("failed to create river migrator: %w", err)
+	}
+	if _, err := riverMigrator.Migrate(ctx, rivermigrate.DirectionUp, nil); err != nil {
+		return fmt.Errorf("failed to run river migrations up: %w", err)
+	}
+
+	m, err := createMigrator(pool)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := m.Migrate(version); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("failed to migrate application schema to version %d: %w", version, err)
+	}
+	return nil
+}
+
+// SetUpSchema runs migrations if autoMigrate is true, and otherwise makes sure someone
+// else already has.
+func SetUpSchema(ctx context.Context, pool *pgxpool.Pool, autoMigrate bool) error {
+	if !autoMigrate {
+		if err := CheckSchema(ctx, pool); err != nil {
+			return fmt.Errorf("schema check failed: %w", err)
+		}
+		return nil
+	}
+	log.Println("Running database migrations...")
+	if err := MigrateUp(ctx, pool); err != nil {
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+	log.Println("Migrations complete")
+	return nil
+}
+
+// ErrSchemaOutOfDate is returned by CheckSchema when migrations still need to be applied.
+var ErrSchemaOutOfDate = errors.New("database schema is out of date")
+
+// CheckSchema verifies that every River and application migration has been applied, for
+// deployments that run migrations separately rather than at startup.
+func CheckSchema(ctx context.Context, pool *pgxpool.Pool) error {
+	status, err := MigrationVersion(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	riverMigrator, err := rivermigrate.New(riverpgxv5.New(pool), &rivermigrate.Config{Schema: PoolSchema(pool)})
+	if err != nil {
+		return fmt.Errorf("failed to create river migrator: %w", err)
+	}
+	riverVersions := riverMigrator.AllVersions()
+	wantRiver := riverVersions[len(riverVersions)-1].Version
+
+	wantApp, err := latestAppMigration()
+	if err != nil {
+		return err
+	}
+
+	if status.Dirty {
+		return fmt.Errorf("%w: application migration %d is dirty", ErrSchemaOutOfDate, status.App)
+	}
+	if status.River < wantRiver || status.App < wantApp {
+		return fmt.Errorf("%w: have river %d, app %d; want river %d, app %d", ErrSchemaOutOfDate, status.River, status.App, wantRiver, wantApp)
+	}
+	return nil
+}
+
+// latestAppMigration returns the highest application migration version embedded in the binary.
+func latestAppMigration() (uint, error) {
+	entries, err := migrationsFS.ReadDir("migrations")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read embedded migrations: %w", err)
+	}
+	var latest uint
+	for _, entry := range entries {
+		prefix, _, ok := strings.Cut(entry.Name(), "_")
+		if !ok {
+			continue
+		}
+		version, err := strconv.ParseUint(prefix, 10, 0)
+		if err != nil {
+			continue
+		}
+		latest = max(latest, uint(version))
+	}
+	return latest, nil
+}
@@ -0,0 +1,9 @@
+CREATE TABLE uuid_job_mapping (
+    uuid UUID PRIMARY KEY,
+    river_job_id BIGINT NOT NULL REFERENCES river_job(id) ON DELETE CASCADE
+);
+
+INSERT INTO uuid_job_mapping (uuid, river_job_id)
+SELECT (args->>'uuid')::uuid, id FROM river_job WHERE kind = 'transcode';
+
+DROP INDEX IF EXISTS river_job_args_uuid_idx;
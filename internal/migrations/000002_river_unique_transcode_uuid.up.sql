@@ -0,0 +1,11 @@
+-- Transcode job UUIDs are now deduplicated by River's unique jobs (ByArgs on the uuid
+-- field, across every job state) instead of the mapping table.  Give existing jobs the
+-- unique key River would have computed so their UUIDs stay reserved.
+UPDATE river_job
+SET unique_key = sha256(convert_to('&kind=transcode&args={"uuid":"' || (args->>'uuid') || '"}', 'UTF8')),
+    unique_states = B'11111111'
+WHERE kind = 'transcode' AND unique_key IS NULL;
+
+CREATE INDEX river_job_args_uuid_idx ON river_job ((args->>'uuid'));
+
+DROP TABLE uuid_job_mapping;
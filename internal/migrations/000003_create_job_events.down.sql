@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS river_job_record_event ON river_job;
+DROP FUNCTION IF EXISTS record_job_event();
+DROP TABLE IF EXISTS job_events;
@@ -0,0 +1,35 @@
+-- job_events records every state transition of a transcode job, including each retry.
+CREATE TABLE job_events (
+    id BIGSERIAL PRIMARY KEY,
+    river_job_id BIGINT NOT NULL REFERENCES river_job(id) ON DELETE CASCADE,
+    state TEXT NOT NULL,
+    attempt SMALLINT NOT NULL,
+    error TEXT,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX job_events_river_job_id_idx ON job_events (river_job_id, id);
+
+-- River changes job states in many places (including its own maintenance services), so
+-- transitions are captured by a trigger rather than by application code.
+CREATE FUNCTION record_job_event() RETURNS trigger AS $$
+BEGIN
+    IF NEW.kind = 'transcode' AND (TG_OP = 'INSERT' OR OLD.state IS DISTINCT FROM NEW.state) THEN
+        INSERT INTO job_events (river_job_id, state, attempt, error)
+        VALUES (
+            NEW.id,
+            NEW.state::text,
+            NEW.attempt,
+            CASE
+                WHEN NEW.state IN ('retryable', 'discarded') AND cardinality(NEW.errors) > 0
+                THEN NEW.errors[cardinality(NEW.errors)]->>'error'
+            END
+        );
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER river_job_record_event
+AFTER INSERT OR UPDATE OF state ON river_job
+FOR EACH ROW EXECUTE FUNCTION record_job_event();
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS river_job_args_workflow_id_idx;
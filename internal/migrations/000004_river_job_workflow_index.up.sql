@@ -0,0 +1,3 @@
+-- Workflow steps are looked up by workflow ID when a step finishes and when the
+-- workflow's status is requested.
+CREATE INDEX river_job_args_workflow_id_idx ON river_job ((args->'workflow'->>'id'));
@@ -0,0 +1,2 @@
+-- Job groups are looked up by group ID when their aggregate status is requested.
+CREATE INDEX river_job_args_group_id_idx ON river_job ((args->>'groupId'));
@@ -0,0 +1,11 @@
+-- workers records each running worker process so the fleet can be listed.  Rows are
+-- kept alive by heartbeats and removed when a worker shuts down cleanly.
+CREATE TABLE workers (
+    id TEXT PRIMARY KEY,
+    hostname TEXT NOT NULL,
+    capabilities JSONB NOT NULL DEFAULT '[]',
+    started_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    heartbeat_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX workers_heartbeat_at_idx ON workers (heartbeat_at);
@@ -0,0 +1,11 @@
+-- job_logs holds the encoder output of each transcode attempt, so it can be read, and
+-- followed while the job runs, through the API.
+CREATE TABLE job_logs (
+    id BIGSERIAL PRIMARY KEY,
+    river_job_id BIGINT NOT NULL REFERENCES river_job(id) ON DELETE CASCADE,
+    attempt SMALLINT NOT NULL,
+    line TEXT NOT NULL,
+    logged_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX job_logs_river_job_id_idx ON job_logs (river_job_id, id);
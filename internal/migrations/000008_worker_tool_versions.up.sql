@@ -0,0 +1,3 @@
+-- Workers report the versions of their encoding tools, so quality changes can be traced
+-- back to encoder upgrades.
+ALTER TABLE workers ADD COLUMN tool_versions JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,11 @@
+-- profile_stats accumulates the throughput of successful transcodes per profile, so
+-- POST /estimate can predict how long a source will take to encode and how large its
+-- outputs will be.  River only keeps completed jobs for a day, so the totals live here.
+CREATE TABLE profile_stats (
+    profile TEXT PRIMARY KEY,
+    jobs BIGINT NOT NULL DEFAULT 0,
+    source_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    encode_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    output_bytes BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,3 @@
+-- Workers that sign webhooks record their ed25519 public key, which the server serves
+-- at /.well-known/vt-webhook-keys so receivers can verify deliveries.
+ALTER TABLE workers ADD COLUMN webhook_public_key BYTEA;
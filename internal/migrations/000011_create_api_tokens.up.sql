@@ -0,0 +1,15 @@
+-- api_tokens holds the API keys created through the admin endpoints, which authenticate
+-- as the tenant they were created for alongside the keys in the tenants file.  Only a
+-- hash of each key is stored; the key itself is shown once, when it is created or
+-- rotated.
+CREATE TABLE api_tokens (
+    id UUID PRIMARY KEY,
+    tenant TEXT NOT NULL,
+    name TEXT NOT NULL DEFAULT '',
+    token_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    rotated_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX api_tokens_tenant_idx ON api_tokens (tenant);
@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ErrMountUnavailable is returned when a configured media mount is missing or not writable.
+var ErrMountUnavailable = errors.New("mount unavailable")
+
+// mountInfoPath is the kernel-provided list of mount points for the current process.
+const mountInfoPath = "/proc/self/mountinfo"
+
+// CheckMounts verifies that every path is an active mount point and is writable.
+func CheckMounts(paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	f, err := os.Open(mountInfoPath)
+	if err != nil {
+		return fmt.Errorf("%w: failed to read mount table: %v", ErrMountUnavailable, err)
+	}
+	defer f.Close()
+
+	mountPoints, err := parseMountInfo(f)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrMountUnavailable, err)
+	}
+
+	for _, path := range paths {
+		if err := checkMount(path, mountPoints); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkMount(path string, mountPoints map[string]bool) error {
+	cleanPath := filepath.Clean(path)
+	if !mountPoints[cleanPath] {
+		return fmt.Errorf("%w: %q is not mounted", ErrMountUnavailable, cleanPath)
+	}
+
+	// Verify writability by creating and removing a probe file.
+	probe, err := os.CreateTemp(cleanPath, ".vt-mount-check-*")
+	if err != nil {
+		return fmt.Errorf("%w: %q is not writable: %v", ErrMountUnavailable, cleanPath, err)
+	}
+	probeName := probe.Name()
+	probe.Close()
+	if err := os.Remove(probeName); err != nil {
+		return fmt.Errorf("%w: failed to remove probe file in %q: %v", ErrMountUnavailable, cleanPath, err)
+	}
+	return nil
+}
+
+// parseMountInfo returns the set of mount points listed in a mountinfo file.
+// See proc(5) for the format; the mount point is the fifth field.
+func parseMountInfo(r io.Reader) (map[string]bool, error) {
+	mountPoints := make(map[string]bool)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			continue
+		}
+		mountPoints[unescapeMountPath(fields[4])] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse mount table: %w", err)
+	}
+	return mountPoints, nil
+}
+
+// unescapeMountPath decodes the octal escapes (e.g. \040 for space) used in mountinfo paths.
+func unescapeMountPath(path string) string {
+	if !strings.Contains(path, `\`) {
+		return path
+	}
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		if path[i] == '\\' && i+3 < len(path) {
+			if v, err := strconv.ParseUint(path[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(path[i])
+	}
+	return b.String()
+}
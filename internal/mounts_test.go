@@ -0,0 +1,29 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestParseMountInfo(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	mountInfo := strings.Join([]string{
+		"22 1 0:21 / / rw,relatime - overlay overlay rw",
+		"23 22 0:22 / /proc rw,nosuid - proc proc rw",
+		`24 22 8:1 /srv/media /nas/my\040media rw,relatime - ext4 /dev/sda1 rw`,
+		"malformed",
+	}, "\n")
+
+	got, err := parseMountInfo(strings.NewReader(mountInfo))
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, map[string]bool{
+		"/":             true,
+		"/proc":         true,
+		"/nas/my media": true,
+	}, got)
+}
@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// OutputOwnership sets the owner and permissions of a job's output files once they
+// have been written, e.g. so users of a NAS share can modify them.  Nil fields are
+// left as the encoder created them.
+type OutputOwnership struct {
+	UID *int `json:"uid,omitempty"`
+	GID *int `json:"gid,omitempty"`
+	// Mode is the permission bits, e.g. 0o664.
+	Mode *fs.FileMode `json:"mode,omitempty"`
+}
+
+// MaxOutputID bounds output UIDs and GIDs to the 32-bit IDs Linux supports.
+const MaxOutputID = 1<<32 - 2
+
+// ParseFileMode parses permission bits written in octal, such as "664" or "0664".
+func ParseFileMode(s string) (fs.FileMode, error) {
+	mode, err := strconv.ParseUint(strings.TrimPrefix(s, "0o"), 8, 32)
+	if err != nil || mode > 0o777 {
+		return 0, fmt.Errorf("invalid file mode %q: must be octal permission bits between 000 and 777", s)
+	}
+	return fs.FileMode(mode), nil
+}
+
+// Or returns o with its unset fields taken from defaults.  Either may be nil.
+func (o *OutputOwnership) Or(defaults *OutputOwnership) *OutputOwnership {
+	if o == nil {
+		return defaults
+	}
+	if defaults == nil {
+		return o
+	}
+	merged := *o
+	if merged.UID == nil {
+		merged.UID = defaults.UID
+	}
+	if merged.GID == nil {
+		merged.GID = defaults.GID
+	}
+	if merged.Mode == nil {
+		merged.Mode = defaults.Mode
+	}
+	return &merged
+}
+
+// Apply sets the ownership and mode of each path that exists.  Changing the owner
+// usually requires the worker to run as root.
+func (o *OutputOwnership) Apply(paths []string) error {
+	if o == nil {
+		return nil
+	}
+	uid, gid := -1, -1
+	if o.UID != nil {
+		uid = *o.UID
+	}
+	if o.GID != nil {
+		gid = *o.GID
+	}
+	for _, path := range paths {
+		if uid != -1 || gid != -1 {
+			if err := os.Lchown(path, uid, gid); errors.Is(err, fs.ErrNotExist) {
+				continue
+			} else if err != nil {
+				return fmt.Errorf("failed to change output owner: %w", err)
+			}
+		}
+		if o.Mode != nil {
+			if err := os.Chmod(path, *o.Mode); errors.Is(err, fs.ErrNotExist) {
+				continue
+			} else if err != nil {
+				return fmt.Errorf("failed to change output mode: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// OutputPaths returns the files a finished job may have written.  Some of them, such
+// as the caption sidecar, only exist for some sources.
+func (args TranscodeJobArgs) OutputPaths() ([]string, error) {
+	paths := []string{args.DestinationPath, CaptionSidecarPath(args.DestinationPath)}
+	switch args.Profile {
+	case ProfileRenditions:
+		for _, rendition := range args.RenditionStatuses(100) {
+			paths = append(paths, rendition.DestinationPath)
+		}
+	case ProfileABR:
+		// The playlists and segments are named after the manifest.
+		base := strings.TrimSuffix(args.DestinationPath, filepath.Ext(args.DestinationPath))
+		written, err := filepath.Glob(globEscape(base) + "_*")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list segments: %w", err)
+		}
+		paths = append(paths, written...)
+	}
+	return paths, nil
+}
+
+// globEscape quotes the glob metacharacters in path.
+func globEscape(path string) string {
+	var b strings.Builder
+	for _, r := range path {
+		if strings.ContainsRune(`*?[\`, r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
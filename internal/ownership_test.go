@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestParseFileMode(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc     exam.Loc
+		input   string
+		want    fs.FileMode
+		wantErr bool
+	}{
+		{loc: exam.Here(), input: "664", want: 0o664},
+		{loc: exam.Here(), input: "0640", want: 0o640},
+		{loc: exam.Here(), input: "1777", wantErr: true},
+		{loc: exam.Here(), input: "68", wantErr: true},
+		{loc: exam.Here(), input: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		e.Run(tt.input, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			got, err := ParseFileMode(tt.input)
+			if tt.wantErr {
+				exam.NotNil(e, env, err)
+				return
+			}
+			exam.Nil(e, env, err).Log(err).Must()
+			exam.Equal(e, env, tt.want, got)
+		})
+	}
+}
+
+func TestOutputOwnership(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	defaults := &OutputOwnership{UID: ptr(1000), GID: ptr(100), Mode: ptr(fs.FileMode(0o644))}
+	job := &OutputOwnership{Mode: ptr(fs.FileMode(0o664))}
+	exam.Equal(e, env, &OutputOwnership{UID: ptr(1000), GID: ptr(100), Mode: ptr(fs.FileMode(0o664))}, job.Or(defaults))
+	exam.Equal(e, env, defaults, (*OutputOwnership)(nil).Or(defaults))
+
+	dir := t.TempDir()
+	base := filepath.Join(dir, "movie[1]")
+	for _, name := range []string{"movie[1].m3u8", "movie[1]_720p.m3u8", "movie[1]_720p_00000.ts", "other.ts"} {
+		err := os.WriteFile(filepath.Join(dir, name), nil, 0o600)
+		exam.Nil(e, env, err).Log(err).Must()
+	}
+	args := TranscodeJobArgs{DestinationPath: base + ".m3u8", Profile: ProfileABR}
+	paths, err := args.OutputPaths()
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, []string{base + ".m3u8", base + ".cc.srt", base + "_720p.m3u8", base + "_720p_00000.ts"}, paths)
+
+	err = job.Apply(paths)
+	exam.Nil(e, env, err).Log(err).Must()
+	info, err := os.Stat(base + "_720p_00000.ts")
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, fs.FileMode(0o664), info.Mode().Perm())
+	info, err = os.Stat(filepath.Join(dir, "other.ts"))
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, fs.FileMode(0o600), info.Mode().Perm())
+}
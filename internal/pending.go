@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// MinJobPriority and MaxJobPriority bound River job priorities; workers take jobs with
+// a lower number first.
+const (
+	MinJobPriority = 1
+	MaxJobPriority = 4
+)
+
+// JobPending reports whether no worker has started job yet.  A job waiting to be
+// retried has been started, even though it is pending again; a workflow step waiting
+// on its dependencies hasn't, so it can still be changed.
+func JobPending(job *rivertype.JobRow) bool {
+	switch job.State {
+	case rivertype.JobStateAvailable, rivertype.JobStatePending, rivertype.JobStateScheduled:
+		return job.Attempt == 0
+	default:
+		return false
+	}
+}
+
+// UpdatePendingJob replaces the encoded args of a pending job, and its priority unless
+// priority is zero.  previous is the encoded args the update was made from; it
+// reports false if a worker has started the job or its args have changed since.
+func UpdatePendingJob(ctx context.Context, pool *pgxpool.Pool, jobID int64, previous, args []byte, priority int) (bool, error) {
+	tag, err := pool.Exec(ctx, `
+		UPDATE river_job SET
+			args = $3::jsonb,
+			priority = coalesce(nullif($4::integer, 0), priority)
+		WHERE id = $1 AND args = $2::jsonb AND attempt = 0
+			AND state IN ('available', 'pending', 'scheduled')`, jobID, previous, args, priority)
+	if err != nil {
+		return false, fmt.Errorf("failed to update pending job: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+	"github.com/riverqueue/river/rivertype"
+)
+
+func TestJobPending(t *testing.T) {
+	tests := []struct {
+		loc     exam.Loc
+		name    string
+		state   rivertype.JobState
+		attempt int
+		want    bool
+	}{
+		{loc: exam.Here(), name: "available", state: rivertype.JobStateAvailable, want: true},
+		{loc: exam.Here(), name: "scheduled", state: rivertype.JobStateScheduled, want: true},
+		{loc: exam.Here(), name: "workflow step waiting on its dependencies", state: rivertype.JobStatePending, want: true},
+		{loc: exam.Here(), name: "retryable after a failed attempt", state: rivertype.JobStateRetryable, attempt: 1},
+		{loc: exam.Here(), name: "available again after a failed attempt", state: rivertype.JobStateAvailable, attempt: 1},
+		{loc: exam.Here(), name: "running", state: rivertype.JobStateRunning, attempt: 1},
+		{loc: exam.Here(), name: "completed", state: rivertype.JobStateCompleted, attempt: 1},
+		{loc: exam.Here(), name: "cancelled before it started", state: rivertype.JobStateCancelled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			e.Log("Running test at", tt.loc)
+			env := deep.NewEnv()
+			exam.Equal(e, env, tt.want, JobPending(&rivertype.JobRow{State: tt.state, Attempt: tt.attempt}))
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Default and absolute bounds for the quality chosen by content-adaptive encoding, on
+// the x264 and x265 CRF scale.
+const (
+	DefaultPerTitleMinCRF = 18
+	DefaultPerTitleMaxCRF = 28
+	MaxPerTitleCRF        = 51
+)
+
+const (
+	// perTitleSamples and perTitleSampleDuration control how much of the source the
+	// analysis pass encodes.
+	perTitleSamples        = 5
+	perTitleSampleDuration = 10 * time.Second
+	// perTitleSimpleKbps and perTitleComplexKbps are the analysis bitrates, for the fixed
+	// analysis encode, at or below which content gets the maximum CRF and at or above
+	// which it gets the minimum.  CRF is interpolated on a log scale in between.
+	perTitleSimpleKbps  = 300.0
+	perTitleComplexKbps = 3000.0
+)
+
+// perTitleAnalysisArgs are the fixed encoder settings of the analysis pass.  Its output
+// bitrate measures how hard the content is to compress.
+var perTitleAnalysisArgs = []string{
+	"-vf", "scale=-2:540",
+	"-c:v", "libx264",
+	"-preset", "ultrafast",
+	"-crf", "23",
+}
+
+// PerTitleOptions bound the quality chosen by content-adaptive encoding.
+type PerTitleOptions struct {
+	// MinCRF is the best quality complex content is encoded at.
+	MinCRF int `json:"minCrf"`
+	// MaxCRF is the lowest quality simple content is encoded at.
+	MaxCRF int `json:"maxCrf"`
+}
+
+// perTitleTranscoder measures the complexity of the source before running an inner
+// Transcoder, and picks its constant quality within the job's bounds: simple content
+// gets smaller files and complex content keeps its quality.
+type perTitleTranscoder struct {
+	inner Transcoder
+}
+
+// NewPerTitleTranscoder wraps inner so that jobs asking for content-adaptive encoding
+// have their CRF chosen by an analysis pass.
+func NewPerTitleTranscoder(inner Transcoder) Transcoder {
+	return &perTitleTranscoder{inner: inner}
+}
+
+func (t *perTitleTranscoder) Transcode(ctx context.Context, params TranscodeParams) error {
+	if params.Video == nil || params.Video.PerTitle == nil {
+		return t.inner.Transcode(ctx, params)
+	}
+	kbps, err := measureComplexity(ctx, params.Sandbox, params.SourcePath)
+	if err != nil {
+		return err
+	}
+	crf := perTitleCRF(kbps, *params.Video.PerTitle)
+	params.CRF = &crf
+	return t.inner.Transcode(ctx, params)
+}
+
+// measureComplexity encodes samples of path with the analysis settings and returns their
+// bitrate in kilobits per second.
+func measureComplexity(ctx context.Context, sandbox *Sandbox, path string) (float64, error) {
+	duration, err := getDuration(ctx, sandbox, path)
+	if err != nil {
+		return 0, err
+	}
+	sampleDuration := min(perTitleSampleDuration, duration)
+
+	var totalBytes int64
+	var totalSeconds float64
+	for _, start := range clipSampleStarts(duration, perTitleSamples, perTitleSampleDuration) {
+		args := []string{
+			"-ss", fmt.Sprintf("%.3f", start.Seconds()),
+			"-t", fmt.Sprintf("%.3f", sampleDuration.Seconds()),
+			"-i", path,
+			"-map", "0:v:0",
+		}
+		args = append(args, perTitleAnalysisArgs...)
+		args = append(args, "-f", "null", "-")
+		output, err := encoderCommand(ctx, sandbox, nil, "ffmpeg", args...).CombinedOutput()
+		if err != nil {
+			return 0, fmt.Errorf("%w: complexity analysis failed: %w: %s", ErrFFmpegFailed, err, output)
+		}
+		bytes, err := parseEncodedVideoSize(output)
+		if err != nil {
+			return 0, err
+		}
+		totalBytes += bytes
+		totalSeconds += sampleDuration.Seconds()
+	}
+	if totalSeconds == 0 {
+		return 0, fmt.Errorf("complexity analysis failed: source has no duration")
+	}
+	return float64(totalBytes) * 8 / 1000 / totalSeconds, nil
+}
+
+var videoSizeRegex = regexp.MustCompile(`video:\s*(\d+)(KiB|kB)`)
+
+// parseEncodedVideoSize returns the encoded video size in bytes from ffmpeg's final
+// statistics line.
+func parseEncodedVideoSize(output []byte) (int64, error) {
+	matches := videoSizeRegex.FindAllSubmatch(output, -1)
+	if matches == nil {
+		return 0, fmt.Errorf("complexity analysis failed: no video size in ffmpeg output")
+	}
+	kib, err := strconv.ParseInt(string(matches[len(matches)-1][1]), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("complexity analysis failed: %w", err)
+	}
+	return kib * 1024, nil
+}
+
+// perTitleCRF maps the analysis bitrate to a CRF within bounds.
+func perTitleCRF(kbps float64, bounds PerTitleOptions) int {
+	if kbps <= 0 {
+		return bounds.MaxCRF
+	}
+	complexity := (math.Log(kbps) - math.Log(perTitleSimpleKbps)) / (math.Log(perTitleComplexKbps) - math.Log(perTitleSimpleKbps))
+	complexity = max(0, min(1, complexity))
+	return bounds.MaxCRF - int(math.Round(complexity*float64(bounds.MaxCRF-bounds.MinCRF)))
+}
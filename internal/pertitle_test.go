@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestPerTitleCRF(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	bounds := PerTitleOptions{MinCRF: 18, MaxCRF: 28}
+	tests := []struct {
+		loc  exam.Loc
+		name string
+		kbps float64
+		want int
+	}{
+		{loc: exam.Here(), name: "No data", kbps: 0, want: 28},
+		{loc: exam.Here(), name: "Simple", kbps: 150, want: 28},
+		{loc: exam.Here(), name: "Middle", kbps: 948.68, want: 23},
+		{loc: exam.Here(), name: "Complex", kbps: 8000, want: 18},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.want, perTitleCRF(tt.kbps, bounds))
+		})
+	}
+}
+
+func TestParseEncodedVideoSize(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	output := []byte("frame=  240 fps=120 q=-1.0 Lsize=N/A time=00:00:10.00 bitrate=N/A speed=5x\n" +
+		"[out#0/null @ 0x1] video:1234KiB audio:0KiB subtitle:0KiB other streams:0KiB global headers:0KiB muxing overhead: unknown\n")
+	got, err := parseEncodedVideoSize(output)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, int64(1234*1024), got)
+
+	_, err = parseEncodedVideoSize([]byte("Conversion failed!"))
+	exam.NotNil(e, env, err)
+}
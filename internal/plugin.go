@@ -0,0 +1,262 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrPluginFailed is returned when a transcoder plugin exits unsuccessfully.
+var ErrPluginFailed = errors.New("transcoder plugin failed")
+
+// PluginProfilePrefix starts the name of every profile encoded by a plugin, so the
+// server accepts them without knowing which plugins the workers have.
+const PluginProfilePrefix = "x-"
+
+// pluginProfilePattern matches the names of plugin profiles.
+var pluginProfilePattern = regexp.MustCompile(`^x-[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// pluginNamePattern matches plugin names, which are reported as worker capabilities.
+var pluginNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// IsPlugin reports whether the profile is encoded by a transcoder plugin.
+func (p Profile) IsPlugin() bool {
+	return pluginProfilePattern.MatchString(string(p))
+}
+
+// Transcoder plugins are executables that encode one or more plugin profiles in a
+// separate process, so third-party backends don't need to be built into the worker.
+//
+// "PLUGIN describe" prints a pluginDescription as JSON on stdout.
+//
+// "PLUGIN transcode" reads a pluginRequest as JSON on stdin, encodes it, and exits 0 on
+// success.  While it runs it may print pluginMessages as JSON, one per line, on stdout.
+// Its stderr becomes part of the job's log and, if it fails, of the error.
+
+// pluginDescription is a plugin's answer to describe.
+type pluginDescription struct {
+	Name     string    `json:"name"`
+	Version  string    `json:"version"`
+	Profiles []Profile `json:"profiles"`
+}
+
+// pluginRequest is the job a plugin is asked to transcode.
+type pluginRequest struct {
+	Profile         Profile          `json:"profile"`
+	SourcePath      string           `json:"sourcePath"`
+	DestinationPath string           `json:"destinationPath"`
+	Audio           *AudioOptions    `json:"audio,omitempty"`
+	Subtitles       *SubtitleOptions `json:"subtitles,omitempty"`
+	Video           *VideoOptions    `json:"video,omitempty"`
+	Streams         *StreamSelection `json:"streams,omitempty"`
+	CRF             *int             `json:"crf,omitempty"`
+}
+
+// pluginMessage is a line of plugin output during transcode.
+type pluginMessage struct {
+	Progress *struct {
+		Percent     float64 `json:"percent"`
+		Speed       float64 `json:"speed"`
+		Frame       int64   `json:"frame"`
+		FPS         float64 `json:"fps"`
+		BitrateKbps float64 `json:"bitrateKbps"`
+		// PositionSeconds is how far into the source the plugin has got.
+		PositionSeconds float64 `json:"positionSeconds"`
+	} `json:"progress,omitempty"`
+	Log *string `json:"log,omitempty"`
+}
+
+// LoadPlugin asks the plugin at path to describe itself and registers it as the
+// backend of its profiles.  The returned check reports the plugin as a tool named after
+// it, which each of its profiles requires.
+func LoadPlugin(ctx context.Context, path string) (EncoderCheck, error) {
+	backend, check, err := describePlugin(ctx, path)
+	if err != nil {
+		return check, err
+	}
+	for _, profile := range backend.Profiles {
+		if existing, ok := transcoderBackend(profile); ok {
+			return EncoderCheck{Path: path}, fmt.Errorf("plugin %s profile %q is already encoded by %s", path, profile, existing.Name)
+		}
+	}
+	RegisterTranscoder(backend)
+	return check, nil
+}
+
+// ReloadPlugins replaces the backends of every plugin profile with the plugins at paths,
+// which are loaded as LoadPlugin loads them.  If any of them fails to load, the plugins
+// already registered are kept.  Transcoders made before the reload keep running the
+// plugin they were made with.
+func ReloadPlugins(ctx context.Context, paths []string) ([]EncoderCheck, error) {
+	backends := map[Profile]*TranscoderBackend{}
+	var checks []EncoderCheck
+	for _, path := range paths {
+		backend, check, err := describePlugin(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		for _, profile := range backend.Profiles {
+			if existing, ok := backends[profile]; ok {
+				return nil, fmt.Errorf("plugin %s profile %q is already encoded by %s", path, profile, existing.Name)
+			}
+			backends[profile] = &backend
+		}
+		checks = append(checks, check)
+	}
+
+	// Plugin profiles can't collide with built-in ones, which never start with the prefix
+	transcoderBackendsMu.Lock()
+	defer transcoderBackendsMu.Unlock()
+	for profile, backend := range transcoderBackends {
+		if !profile.IsPlugin() {
+			backends[profile] = backend
+		}
+	}
+	transcoderBackends = backends
+	return checks, nil
+}
+
+// describePlugin asks the plugin at path to describe itself and returns the backend
+// that runs it, without registering it.
+func describePlugin(ctx context.Context, path string) (TranscoderBackend, EncoderCheck, error) {
+	check := EncoderCheck{Path: path}
+	output, err := exec.CommandContext(ctx, path, "describe").Output()
+	if err != nil {
+		return TranscoderBackend{}, check, fmt.Errorf("failed to describe plugin %s: %w", path, err)
+	}
+	var desc pluginDescription
+	if err := json.Unmarshal(output, &desc); err != nil {
+		return TranscoderBackend{}, check, fmt.Errorf("failed to parse description of plugin %s: %w", path, err)
+	}
+	if !pluginNamePattern.MatchString(desc.Name) {
+		return TranscoderBackend{}, check, fmt.Errorf("plugin %s has invalid name %q", path, desc.Name)
+	}
+	if len(desc.Profiles) == 0 {
+		return TranscoderBackend{}, check, fmt.Errorf("plugin %s doesn't encode any profiles", path)
+	}
+	for _, profile := range desc.Profiles {
+		if !profile.IsPlugin() {
+			return TranscoderBackend{}, check, fmt.Errorf("plugin %s profile %q must start with %q", path, profile, PluginProfilePrefix)
+		}
+	}
+
+	check.Tool, check.Version = desc.Name, desc.Version
+	return TranscoderBackend{
+		Name:     desc.Name,
+		Profiles: desc.Profiles,
+		Tools:    []string{desc.Name},
+		New: func(profile Profile) Transcoder {
+			return &pluginTranscoder{path: path, profile: profile}
+		},
+	}, check, nil
+}
+
+// pluginTranscoder encodes a plugin profile by running the plugin.
+type pluginTranscoder struct {
+	path    string
+	profile Profile
+}
+
+func (t *pluginTranscoder) Transcode(ctx context.Context, params TranscodeParams) error {
+	request, err := json.Marshal(pluginRequest{
+		Profile:         t.profile,
+		SourcePath:      params.SourcePath,
+		DestinationPath: params.DestinationPath,
+		Audio:           params.Audio,
+		Subtitles:       params.Subtitles,
+		Video:           params.Video,
+		Streams:         params.Streams,
+		CRF:             params.CRF,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode plugin request: %w", err)
+	}
+
+	cmd := encoderCommand(ctx, params.Sandbox, params.Limits, t.path, "transcode")
+	cmd.Stdin = bytes.NewReader(request)
+	stdoutPipe, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("failed to create stdout pipe: %w", err)
+	}
+	stderrPipe, err := cmd.StderrPipe()
+	if err != nil {
+		return fmt.Errorf("failed to create stderr pipe: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start plugin: %w", err)
+	}
+
+	// stdout and stderr are read concurrently, so serialize the log lines from both
+	if params.LogCallback != nil {
+		var mu sync.Mutex
+		logCallback := params.LogCallback
+		params.LogCallback = func(line string) {
+			mu.Lock()
+			defer mu.Unlock()
+			logCallback(line)
+		}
+	}
+
+	var stderrBuf strings.Builder
+	stderrDone := make(chan struct{})
+	go func() {
+		defer close(stderrDone)
+		scanner := bufio.NewScanner(stderrPipe)
+		for scanner.Scan() {
+			stderrBuf.WriteString(scanner.Text())
+			stderrBuf.WriteString("\n")
+			if params.LogCallback != nil {
+				params.LogCallback(scanner.Text())
+			}
+		}
+	}()
+
+	scanner := bufio.NewScanner(stdoutPipe)
+	for scanner.Scan() {
+		handlePluginMessage(scanner.Bytes(), params)
+	}
+	<-stderrDone
+
+	if err := cmd.Wait(); err != nil {
+		stderrOutput := stderrBuf.String()
+		err = params.Limits.classifyExit(ctx, err, stderrOutput)
+		if stderrOutput != "" {
+			return fmt.Errorf("%w: %w: %s", ErrPluginFailed, err, stderrOutput)
+		}
+		return fmt.Errorf("%w: %w", ErrPluginFailed, err)
+	}
+	return nil
+}
+
+// handlePluginMessage passes a line of plugin output on to the job's callbacks.  Lines
+// that aren't messages are logged as they are.
+func handlePluginMessage(line []byte, params TranscodeParams) {
+	var message pluginMessage
+	if err := json.Unmarshal(line, &message); err != nil {
+		if params.LogCallback != nil {
+			params.LogCallback(string(line))
+		}
+		return
+	}
+	if message.Log != nil && params.LogCallback != nil {
+		params.LogCallback(*message.Log)
+	}
+	if message.Progress != nil && params.ProgressCallback != nil {
+		params.ProgressCallback(Progress{
+			Percent:     min(max(message.Progress.Percent, 0), 100),
+			Speed:       message.Progress.Speed,
+			Frame:       message.Progress.Frame,
+			FPS:         message.Progress.FPS,
+			BitrateKbps: message.Progress.BitrateKbps,
+			Position:    time.Duration(max(message.Progress.PositionSeconds, 0) * float64(time.Second)),
+		})
+	}
+}
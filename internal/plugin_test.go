@@ -0,0 +1,137 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestPlugin(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	dir := t.TempDir()
+	plugin := filepath.Join(dir, "fake")
+	script := `#!/bin/sh
+case "$1" in
+describe)
+	echo '{"name": "fake", "version": "1.2.3", "profiles": ["x-fake-test"]}'
+	;;
+transcode)
+	request=$(cat)
+	echo '{"log": "starting"}'
+	echo '{"progress": {"percent": 50, "speed": 2.5, "positionSeconds": 90}}'
+	echo "not json"
+	echo "$request" > "` + filepath.Join(dir, "request.json") + `"
+	echo "done" >&2
+	;;
+esac
+`
+	err := os.WriteFile(plugin, []byte(script), 0o755)
+	exam.Nil(e, env, err).Log(err).Must()
+
+	check, err := LoadPlugin(context.Background(), plugin)
+	exam.Nil(e, env, err).Log(err).Must()
+	defer delete(transcoderBackends, "x-fake-test")
+	exam.Equal(e, env, EncoderCheck{Tool: "fake", Path: plugin, Version: "1.2.3"}, check)
+	exam.Equal(e, env, []string{"fake"}, Profile("x-fake-test").RequiredTools())
+
+	_, err = LoadPlugin(context.Background(), plugin)
+	exam.NotNil(e, env, err)
+
+	var logs []string
+	var progress []Progress
+	err = NewTranscoder("x-fake-test").Transcode(context.Background(), TranscodeParams{
+		SourcePath:       "/in.mkv",
+		DestinationPath:  "/out.mkv",
+		ProgressCallback: func(p Progress) { progress = append(progress, p) },
+		LogCallback:      func(line string) { logs = append(logs, line) },
+	})
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, []Progress{{Percent: 50, Speed: 2.5, Position: 90 * time.Second}}, progress)
+	// stderr is read alongside stdout, so its lines may come at any point
+	slices.Sort(logs)
+	exam.Equal(e, env, []string{"done", "not json", "starting"}, logs)
+
+	request, err := os.ReadFile(filepath.Join(dir, "request.json"))
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, `{"profile":"x-fake-test","sourcePath":"/in.mkv","destinationPath":"/out.mkv"}`+"\n", string(request))
+}
+
+// writeDescribePlugin writes a plugin to dir that describes itself with description,
+// and returns its path.
+func writeDescribePlugin(e exam.E, env deep.Env, dir, name, description string) string {
+	path := filepath.Join(dir, name)
+	script := "#!/bin/sh\necho '" + description + "'\n"
+	exam.Nil(e, env, os.WriteFile(path, []byte(script), 0o755)).Must()
+	return path
+}
+
+func TestReloadPlugins(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	first := writeDescribePlugin(e, env, dir, "first", `{"name": "first", "version": "1.0", "profiles": ["x-reload-a", "x-reload-b"]}`)
+	second := writeDescribePlugin(e, env, dir, "second", `{"name": "second", "version": "2.0", "profiles": ["x-reload-b"]}`)
+	third := writeDescribePlugin(e, env, dir, "third", `{"name": "third", "version": "3.0", "profiles": ["x-reload-c"]}`)
+	invalid := writeDescribePlugin(e, env, dir, "invalid", `{"name": "invalid", "version": "1.0", "profiles": ["preview"]}`)
+	defer ReloadPlugins(ctx, nil)
+
+	_, err := LoadPlugin(ctx, first)
+	exam.Nil(e, env, err).Log(err).Must()
+
+	// Plugins that don't load, or that encode the same profile, leave the registry alone
+	for _, paths := range [][]string{{third, invalid}, {third, filepath.Join(dir, "missing")}, {second, third, first}} {
+		_, err := ReloadPlugins(ctx, paths)
+		exam.NotNil(e, env, err).Log(paths)
+		exam.Equal(e, env, []string{"first"}, Profile("x-reload-b").RequiredTools())
+		exam.Equal(e, env, false, Profile("x-reload-c").HasTranscoder())
+	}
+
+	checks, err := ReloadPlugins(ctx, []string{second, third})
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, []EncoderCheck{{Tool: "second", Path: second, Version: "2.0"}, {Tool: "third", Path: third, Version: "3.0"}}, checks)
+	exam.Equal(e, env, false, Profile("x-reload-a").HasTranscoder())
+	exam.Equal(e, env, []string{"second"}, Profile("x-reload-b").RequiredTools())
+	exam.Equal(e, env, []string{"third"}, Profile("x-reload-c").RequiredTools())
+	for _, profile := range Profiles {
+		exam.Equal(e, env, true, profile.HasTranscoder()).Log(profile)
+	}
+
+	// Without plugins, only the built-in profiles are left
+	checks, err = ReloadPlugins(ctx, nil)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, 0, len(checks))
+	exam.Equal(e, env, false, Profile("x-reload-b").HasTranscoder())
+	exam.Equal(e, env, len(Profiles), len(transcoderBackends))
+}
+
+func TestProfileIsPlugin(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc     exam.Loc
+		profile Profile
+		want    bool
+	}{
+		{loc: exam.Here(), profile: "x-gstreamer-h264", want: true},
+		{loc: exam.Here(), profile: "x-", want: false},
+		{loc: exam.Here(), profile: "x-Upper", want: false},
+		{loc: exam.Here(), profile: ProfilePreview, want: false},
+	}
+	for _, tt := range tests {
+		e.Run(string(tt.profile), func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.want, tt.profile.IsPlugin())
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// ErrMemoryLimitExceeded is returned when an encoder is killed or fails for exceeding its memory limit.
+var ErrMemoryLimitExceeded = errors.New("encoder exceeded its memory limit")
+
+// outOfMemoryMessages are encoder output fragments that indicate a failed allocation.
+var outOfMemoryMessages = []string{
+	"Cannot allocate memory",
+	"Out of memory",
+	"out of memory",
+	"std::bad_alloc",
+}
+
+// IOClass is an I/O scheduling class for encoder subprocesses, as understood by ionice(1).
+type IOClass string
+
+const (
+	IOClassDefault    IOClass = ""
+	IOClassRealtime   IOClass = "realtime"
+	IOClassBestEffort IOClass = "best-effort"
+	IOClassIdle       IOClass = "idle"
+)
+
+func (c IOClass) IsValid() bool {
+	switch c {
+	case IOClassDefault, IOClassRealtime, IOClassBestEffort, IOClassIdle:
+		return true
+	default:
+		return false
+	}
+}
+
+// ioniceClassNumbers maps each class to its ionice -c value.
+var ioniceClassNumbers = map[IOClass]string{
+	IOClassRealtime:   "1",
+	IOClassBestEffort: "2",
+	IOClassIdle:       "3",
+}
+
+// ProcessLimits controls the resources available to encoder subprocesses.
+// The zero value runs encoders without any limits.
+type ProcessLimits struct {
+	// Nice is the CPU scheduling niceness (-20 to 19); 0 leaves it unchanged.
+	Nice int
+	// IOClass is the I/O scheduling class; empty leaves it unchanged.
+	IOClass IOClass
+	// IOLevel is the priority within the realtime or best-effort class (0-7, lower is higher priority).
+	IOLevel int
+	// MemoryLimitBytes caps the encoder's address space; 0 means no limit.
+	MemoryLimitBytes int64
+}
+
+// wrapperArgs returns the command prefix that applies the limits, or nil if there are none.
+func (l *ProcessLimits) wrapperArgs() []string {
+	if l == nil {
+		return nil
+	}
+	var args []string
+	if classNumber, ok := ioniceClassNumbers[l.IOClass]; ok {
+		args = append(args, "ionice", "-c", classNumber)
+		if l.IOClass != IOClassIdle {
+			args = append(args, "-n", strconv.Itoa(l.IOLevel))
+		}
+	}
+	if l.Nice != 0 {
+		args = append(args, "nice", "-n", strconv.Itoa(l.Nice))
+	}
+	if l.MemoryLimitBytes > 0 {
+		args = append(args, "prlimit", "--as="+strconv.FormatInt(l.MemoryLimitBytes, 10))
+	}
+	return args
+}
+
+// classifyExit wraps err with ErrMemoryLimitExceeded if the encoder appears to have
+// failed because of the memory limit: either it was SIGKILLed (the kernel OOM killer)
+// without ctx being cancelled, or its output reports a failed allocation.
+func (l *ProcessLimits) classifyExit(ctx context.Context, err error, output string) error {
+	if l == nil || l.MemoryLimitBytes <= 0 || err == nil {
+		return err
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && ctx.Err() == nil {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() && status.Signal() == syscall.SIGKILL {
+			return fmt.Errorf("%w (%d bytes): %w", ErrMemoryLimitExceeded, l.MemoryLimitBytes, err)
+		}
+	}
+	for _, msg := range outOfMemoryMessages {
+		if strings.Contains(output, msg) {
+			return fmt.Errorf("%w (%d bytes): %w", ErrMemoryLimitExceeded, l.MemoryLimitBytes, err)
+		}
+	}
+	return err
+}
+
+// encoderCommand creates the command for an encoder subprocess, wrapped so that the
+// sandbox and process limits apply.  The wrappers exec the encoder, so cancelling ctx
+// still kills the encoder itself.  Either may be nil; limits usually are for probes.
+// The command is recorded without the wrappers, which only matter on the worker.
+func encoderCommand(ctx context.Context, sandbox *Sandbox, limits *ProcessLimits, name string, args ...string) *exec.Cmd {
+	recordCommand(ctx, append([]string{name}, args...))
+	argv := append(limits.wrapperArgs(), name)
+	argv = append(argv, args...)
+	return sandboxedCommand(ctx, sandbox, argv)
+}
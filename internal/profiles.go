@@ -1,19 +1,140 @@
 package internal
 
-import "errors"
+import (
+	"errors"
+	"slices"
+)
 
 type Profile string
 
 const ProfilePreview Profile = "preview"
 const ProfileFast1080p30 Profile = "fast1080p30"
 
+// ProfilePreviewClip is a 30 second 480p clip sampled from several points in the source.
+const ProfilePreviewClip Profile = "preview_clip"
+
+// ProfileRenditions encodes several H.264 renditions of the source in one pass.
+const ProfileRenditions Profile = "renditions"
+
+// ProfileABR packages an adaptive-bitrate ladder of H.264 renditions for HLS or DASH
+// streaming.
+const ProfileABR Profile = "abr"
+
+// ProfileAnimated is a short looping GIF or animated WebP thumbnail.
+const ProfileAnimated Profile = "animated"
+
+// ProfileArchive is a high-quality profile for long-term storage: 10-bit x265 at the
+// slow preset with all audio and subtitle tracks passed through untouched.
+const ProfileArchive Profile = "archive"
+
+// ProfileWebM is a VP9 and Opus profile in WebM for web delivery, encoded in two passes.
+const ProfileWebM Profile = "webm"
+
+// ProfileHDR re-encodes to 10-bit x265 while preserving HDR10 metadata, and Dolby Vision
+// and HDR10+ metadata where the encoder supports it.
+const ProfileHDR Profile = "hdr"
+
+// ProfileProResProxy and ProfileDNxHRLB produce intra-frame editing proxies in QuickTime.
+const ProfileProResProxy Profile = "prores_proxy"
+const ProfileDNxHRLB Profile = "dnxhr_lb"
+
+// Profiles lists every profile.
+var Profiles = []Profile{
+	ProfilePreview, ProfilePreviewClip, ProfileAnimated, ProfileRenditions, ProfileABR, ProfileFast1080p30,
+	ProfileArchive, ProfileWebM, ProfileHDR, ProfileProResProxy, ProfileDNxHRLB,
+}
+
 var ErrPanicInvalidProfile = errors.New("invalid profile")
 
-func (p Profile) IsValid() bool {
+// ToolVersions returns the versions of the tools the profile uses, from the versions
+// of every available tool.
+func (p Profile) ToolVersions(versions map[string]string) map[string]string {
+	used := make(map[string]string)
+	for _, tool := range p.RequiredTools() {
+		if version, ok := versions[tool]; ok {
+			used[tool] = version
+		}
+	}
+	return used
+}
+
+// MissingTools returns the tools the profile needs that aren't in available.
+func (p Profile) MissingTools(available []string) []string {
+	var missing []string
+	for _, tool := range p.RequiredTools() {
+		if !slices.Contains(available, tool) {
+			missing = append(missing, tool)
+		}
+	}
+	return missing
+}
+
+// SupportsAudioCodec reports whether the profile's container can hold audio in codec.
+func (p Profile) SupportsAudioCodec(codec AudioCodec) bool {
+	switch p {
+	case ProfileWebM:
+		return codec == AudioCodecOpus || codec == AudioCodecCopy
+	case ProfileABR:
+		// Codecs that both MPEG-TS and fragmented MP4 segments carry and players expect.
+		return codec == AudioCodecAAC || codec == AudioCodecAC3 || codec == AudioCodecEAC3
+	default:
+		return true
+	}
+}
+
+// SupportsGrainTune reports whether the profile's video encoder has a film grain tuning.
+func (p Profile) SupportsGrainTune() bool {
+	switch p {
+	case ProfileFast1080p30, ProfileArchive, ProfileHDR:
+		return true
+	default:
+		return false
+	}
+}
+
+// SupportsHandBrakeOptions reports whether the profile is encoded by HandBrake, so
+// HandBrakeOptions can be layered onto its preset.
+func (p Profile) SupportsHandBrakeOptions() bool {
+	_, ok := handbrakeProfileArgs[p]
+	return ok
+}
+
+// SupportsVideoSelection reports whether the profile can encode a video stream other
+// than the first.  HandBrake always encodes the first one.
+func (p Profile) SupportsVideoSelection() bool {
+	switch p {
+	case ProfilePreview, ProfileWebM, ProfileProResProxy, ProfileDNxHRLB:
+		return true
+	default:
+		return false
+	}
+}
+
+// SupportsParallelSegments reports whether the profile's output can be encoded as
+// segments and concatenated.  Preview clips and animations only cover a few seconds of
+// the source, and renditions don't have a single output to concatenate into.  Plugins
+// may write any kind of output, so they aren't split either.
+func (p Profile) SupportsParallelSegments() bool {
+	switch p {
+	case ProfilePreviewClip, ProfileAnimated, ProfileRenditions, ProfileABR:
+		return false
+	default:
+		return !p.IsPlugin()
+	}
+}
+
+// SupportsPerTitle reports whether the profile encodes at a constant quality that
+// content-adaptive encoding can choose.
+func (p Profile) SupportsPerTitle() bool {
 	switch p {
-	case ProfilePreview, ProfileFast1080p30:
+	case ProfileFast1080p30, ProfileArchive, ProfileHDR, ProfileRenditions:
 		return true
 	default:
 		return false
 	}
 }
+
+// IsValid reports whether p is a built-in profile or names a plugin profile.
+func (p Profile) IsValid() bool {
+	return slices.Contains(Profiles, p) || p.IsPlugin()
+}
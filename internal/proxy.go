@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"context"
+	"time"
+)
+
+// proxyTranscoder encodes an intra-frame editing proxy at the source resolution, so
+// editors can relink to the original media without conforming frame sizes.  Audio is
+// stored as uncompressed PCM by default, which every editing application reads directly.
+type proxyTranscoder struct {
+	// videoArgs select the proxy codec.
+	videoArgs []string
+}
+
+// proresProxyArgs encode ProRes 422 Proxy.
+var proresProxyArgs = []string{
+	"-c:v", "prores_ks",
+	"-profile:v", "proxy",
+	"-pix_fmt", "yuv422p10le",
+	"-vendor", "apl0",
+}
+
+// dnxhrLBArgs encode DNxHR LB.
+var dnxhrLBArgs = []string{
+	"-c:v", "dnxhd",
+	"-profile:v", "dnxhr_lb",
+	"-pix_fmt", "yuv422p",
+}
+
+func init() {
+	RegisterTranscoder(TranscoderBackend{
+		Name:     "proxy",
+		Profiles: []Profile{ProfileProResProxy, ProfileDNxHRLB},
+		Tools:    []string{ToolFFmpeg, ToolFFprobe},
+		New: func(profile Profile) Transcoder {
+			return &proxyTranscoder{videoArgs: proxyProfileArgs[profile]}
+		},
+	})
+}
+
+// proxyProfileArgs select the codec of each proxy profile.
+var proxyProfileArgs = map[Profile][]string{
+	ProfileProResProxy: proresProxyArgs,
+	ProfileDNxHRLB:     dnxhrLBArgs,
+}
+
+func (t *proxyTranscoder) Transcode(ctx context.Context, params TranscodeParams) error {
+	var totalDuration time.Duration
+	if params.ProgressCallback != nil {
+		var err error
+		totalDuration, err = getDuration(ctx, params.Sandbox, params.SourcePath)
+		if err != nil {
+			return err
+		}
+	}
+
+	forced, err := forcedSubtitleFor(ctx, params)
+	if err != nil {
+		return err
+	}
+	pulldown, err := detelecineFor(ctx, params)
+	if err != nil {
+		return err
+	}
+	streams := ffmpegStreams{
+		sourceFilter:  params.Video.ffmpegFilter(pulldown),
+		burnIn:        forced,
+		audioMaps:     []string{"-map", "0:a?"},
+		explicit:      true,
+		subtitles:     externalSubtitles(params),
+		subtitleCodec: "mov_text",
+		selection:     params.Streams,
+	}
+
+	args := append([]string{"-i", params.SourcePath}, streams.args(params.SourcePath, params.Audio)...)
+	args = append(args, t.videoArgs...)
+	if params.Audio != nil {
+		args = append(args, params.Audio.ffmpegArgs(AudioCodecAAC)...)
+	} else {
+		args = append(args, "-c:a", "pcm_s16le")
+	}
+	args = append(args,
+		"-f", "mov",
+		"-y",
+		params.DestinationPath,
+	)
+	return runFfmpeg(ctx, params.Sandbox, params.Limits, totalDuration, params.ProgressCallback, params.LogCallback, args...)
+}
@@ -0,0 +1,22 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// PurgeFollowUpJobs deletes the webhook deliveries and comparison of the transcode job
+// with the given UUID, including any still being retried, and returns how many there
+// were.  The comparison has the transcode's UUID, which is unique across comparisons,
+// so leaving it would stop the UUID from being submitted again with a comparison.
+func PurgeFollowUpJobs(ctx context.Context, tx pgx.Tx, id uuid.UUID) (int64, error) {
+	kinds := []string{WebhookJobArgs{}.Kind(), CompareJobArgs{}.Kind()}
+	tag, err := tx.Exec(ctx, "DELETE FROM river_job WHERE kind = ANY($1) AND args->>'uuid' = $2", kinds, id.String())
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete follow-up jobs: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
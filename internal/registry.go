@@ -0,0 +1,82 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+var ErrPanicDuplicateTranscoder = errors.New("profile already has a transcoder")
+
+// TranscoderBackend describes a transcoder implementation and the profiles it encodes.
+// Implementations register themselves from an init function, so adding a backend
+// doesn't touch NewTranscoder.
+type TranscoderBackend struct {
+	// Name identifies the backend in errors, e.g. "handbrake".
+	Name string
+	// Profiles are the profiles the backend encodes.
+	Profiles []Profile
+	// Tools are the external programs the backend needs.  Every backend probes its
+	// source with ffprobe and may analyse or remux it with ffmpeg, so both belong here.
+	Tools []string
+	// New returns a transcoder for one of Profiles.
+	New func(profile Profile) Transcoder
+}
+
+var (
+	// transcoderBackendsMu guards transcoderBackends, whose plugin profiles change
+	// when ReloadPlugins runs.
+	transcoderBackendsMu sync.RWMutex
+	// transcoderBackends maps each registered profile to the backend that encodes it.
+	transcoderBackends = map[Profile]*TranscoderBackend{}
+)
+
+// RegisterTranscoder makes a backend available to NewTranscoder.  It panics if one
+// of the backend's profiles already has a transcoder.
+func RegisterTranscoder(backend TranscoderBackend) {
+	transcoderBackendsMu.Lock()
+	defer transcoderBackendsMu.Unlock()
+	for _, profile := range backend.Profiles {
+		if existing, ok := transcoderBackends[profile]; ok {
+			panic(fmt.Errorf("%w: %q is encoded by %s, not %s", ErrPanicDuplicateTranscoder, profile, existing.Name, backend.Name))
+		}
+	}
+	for _, profile := range backend.Profiles {
+		transcoderBackends[profile] = &backend
+	}
+}
+
+// NewTranscoder returns a transcoder for the profile from its registered backend.  It
+// panics if no backend encodes the profile.
+func NewTranscoder(profile Profile) Transcoder {
+	backend, ok := transcoderBackend(profile)
+	if !ok {
+		panic(fmt.Errorf("%w: %q", ErrPanicInvalidProfile, profile))
+	}
+	return backend.New(profile)
+}
+
+// HasTranscoder reports whether a backend encodes the profile.
+func (p Profile) HasTranscoder() bool {
+	_, ok := transcoderBackend(p)
+	return ok
+}
+
+// RequiredTools returns the external programs needed to encode the profile, or nil if
+// no backend encodes it.
+func (p Profile) RequiredTools() []string {
+	backend, ok := transcoderBackend(p)
+	if !ok {
+		return nil
+	}
+	return slices.Clone(backend.Tools)
+}
+
+// transcoderBackend returns the backend that encodes the profile, if any.
+func transcoderBackend(profile Profile) (*TranscoderBackend, bool) {
+	transcoderBackendsMu.RLock()
+	defer transcoderBackendsMu.RUnlock()
+	backend, ok := transcoderBackends[profile]
+	return backend, ok
+}
@@ -0,0 +1,38 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+	"github.com/krelinga/go-libs/match"
+)
+
+func TestTranscoderRegistry(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	for _, profile := range Profiles {
+		e.Run(string(profile), func(e exam.E) {
+			exam.NotNil(e, env, NewTranscoder(profile))
+			exam.NotNil(e, env, profile.RequiredTools())
+		})
+	}
+
+	e.Run("unknown profile", func(e exam.E) {
+		exam.PanicWith(e, env, match.As[error](match.ErrorIs(ErrPanicInvalidProfile)), func() {
+			NewTranscoder("unknown")
+		})
+		exam.Nil(e, env, Profile("unknown").RequiredTools())
+	})
+
+	e.Run("duplicate profile", func(e exam.E) {
+		exam.PanicWith(e, env, match.As[error](match.ErrorIs(ErrPanicDuplicateTranscoder)), func() {
+			RegisterTranscoder(TranscoderBackend{
+				Name:     "duplicate",
+				Profiles: []Profile{ProfilePreview},
+				New:      func(Profile) Transcoder { return &ffmpegTranscoder{} },
+			})
+		})
+	})
+}
@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// OnReload calls reload in a background goroutine each time the process receives
+// SIGHUP, until ctx is done.  reload typically re-reads configuration with one of the
+// New*ConfigFromEnv functions, which panic on invalid settings; such a panic is logged
+// and the reload is skipped, so the previous settings stay in effect.
+func OnReload(ctx context.Context, reload func()) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(signals)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-signals:
+				log.Println("SIGHUP received, reloading configuration...")
+				if safeReload(reload) {
+					log.Println("Configuration reloaded")
+				}
+			}
+		}
+	}()
+}
+
+func safeReload(reload func()) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Warn("failed to reload configuration, keeping previous settings", "error", r)
+			ok = false
+		}
+	}()
+	reload()
+	return true
+}
@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"context"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestSafeReload(t *testing.T) {
+	tests := []struct {
+		loc    exam.Loc
+		name   string
+		reload func()
+		want   bool
+	}{
+		{loc: exam.Here(), name: "reloaded", reload: func() {}, want: true},
+		{loc: exam.Here(), name: "invalid settings", reload: func() { panic(ErrPanicEnvInvalid) }},
+		{loc: exam.Here(), name: "panic with a string", reload: func() { panic("bad config") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			e.Log("Running test at", tt.loc)
+			env := deep.NewEnv()
+			exam.Equal(e, env, tt.want, safeReload(tt.reload))
+		})
+	}
+}
+
+func TestOnReload(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reloads := make(chan int, 2)
+	count := 0
+	OnReload(ctx, func() {
+		count++
+		reloads <- count
+		if count == 1 {
+			panic("bad config")
+		}
+	})
+
+	// A reload that panics doesn't stop the next SIGHUP from reloading
+	for want := 1; want <= 2; want++ {
+		exam.Nil(e, env, syscall.Kill(syscall.Getpid(), syscall.SIGHUP)).Must()
+		select {
+		case got := <-reloads:
+			exam.Equal(e, env, want, got)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("reload %d didn't run after SIGHUP", want)
+		}
+	}
+}
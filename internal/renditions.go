@@ -0,0 +1,168 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// Bounds for the renditions of a single job.
+const (
+	MaxRenditions             = 8
+	MinRenditionHeight        = 144
+	MaxRenditionHeight        = 4320
+	MinRenditionBitrateKbps   = 100
+	MaxRenditionBitrateKbps   = 100000
+	defaultRenditionCRF       = 21
+	defaultRenditionAudioKbps = "128k"
+)
+
+// Rendition is one output of a multi-rendition job.
+type Rendition struct {
+	// Name identifies the rendition and is appended to the destination file name.
+	Name string `json:"name"`
+	// Height is the output height in pixels; the width follows the source aspect ratio.
+	Height int `json:"height"`
+	// VideoBitrateKbps caps the video bitrate; nil encodes at constant quality.
+	VideoBitrateKbps *int `json:"videoBitrateKbps,omitempty"`
+}
+
+// RenditionStatus is the progress and result of one rendition.
+type RenditionStatus struct {
+	Name            string  `json:"name"`
+	DestinationPath string  `json:"destinationPath"`
+	Progress        float64 `json:"progress"`
+	// OutputSizeBytes is the size of the finished output file, if the job succeeded.
+	OutputSizeBytes *int64 `json:"outputSizeBytes,omitempty"`
+	// OutputDurationSeconds is the duration of the finished output file, if the job succeeded.
+	OutputDurationSeconds *float64 `json:"outputDurationSeconds,omitempty"`
+}
+
+// RestRenditionStatuses converts rendition statuses to their API representation, for
+// job statuses and webhook payloads alike.
+func RestRenditionStatuses(statuses []RenditionStatus) []vtrest.RenditionStatus {
+	var rest []vtrest.RenditionStatus
+	for _, status := range statuses {
+		rest = append(rest, vtrest.RenditionStatus{
+			Name:                  status.Name,
+			DestinationPath:       status.DestinationPath,
+			Progress:              status.Progress,
+			OutputSizeBytes:       status.OutputSizeBytes,
+			OutputDurationSeconds: status.OutputDurationSeconds,
+		})
+	}
+	return rest
+}
+
+// RenditionPath returns where the rendition called name of a job writing destination
+// is stored: movie.mp4 becomes movie_720p.mp4 for a rendition called 720p.
+func RenditionPath(destination, name string) string {
+	ext := filepath.Ext(destination)
+	return strings.TrimSuffix(destination, ext) + "_" + name + ext
+}
+
+// RenditionStatuses returns the status of each of the job's renditions at the given
+// progress.  The renditions are encoded together, so they all progress at the same rate.
+// The renditions of an HLS ladder are their variant playlists, and those of a DASH
+// ladder are representations in the manifest.
+func (args TranscodeJobArgs) RenditionStatuses(progress float64) []RenditionStatus {
+	renditions := args.Renditions
+	if args.Profile == ProfileABR {
+		renditions = abrLadder(renditions)
+	}
+	var statuses []RenditionStatus
+	for _, rendition := range renditions {
+		destination := RenditionPath(args.DestinationPath, rendition.Name)
+		if args.Profile == ProfileABR && isDASH(args.DestinationPath) {
+			destination = args.DestinationPath
+		}
+		statuses = append(statuses, RenditionStatus{
+			Name:            rendition.Name,
+			DestinationPath: destination,
+			Progress:        progress,
+		})
+	}
+	return statuses
+}
+
+// renditionTranscoder decodes the source once and encodes each rendition from it, as
+// H.264 and AAC.
+type renditionTranscoder struct{}
+
+func init() {
+	RegisterTranscoder(TranscoderBackend{
+		Name:     "renditions",
+		Profiles: []Profile{ProfileRenditions},
+		Tools:    []string{ToolFFmpeg, ToolFFprobe},
+		New:      func(Profile) Transcoder { return &renditionTranscoder{} },
+	})
+}
+
+func (t *renditionTranscoder) Transcode(ctx context.Context, params TranscodeParams) error {
+	if len(params.Renditions) == 0 {
+		return fmt.Errorf("the renditions profile needs at least one rendition")
+	}
+	totalDuration, err := getDuration(ctx, params.Sandbox, params.SourcePath)
+	if err != nil {
+		return err
+	}
+	return runFfmpeg(ctx, params.Sandbox, params.Limits, totalDuration, params.ProgressCallback, params.LogCallback,
+		renditionArgs(params.SourcePath, params.DestinationPath, params.Renditions, params.Audio, params.CRF)...)
+}
+
+// renditionFilter returns the filtergraph that decodes the first video stream once and
+// scales it to each rendition, labelled [v0], [v1], and so on.
+func renditionFilter(renditions []Rendition) string {
+	var graph strings.Builder
+	fmt.Fprintf(&graph, "[0:v:0]split=%d", len(renditions))
+	for i := range renditions {
+		fmt.Fprintf(&graph, "[s%d]", i)
+	}
+	for i, rendition := range renditions {
+		fmt.Fprintf(&graph, ";[s%d]scale=-2:%d[v%d]", i, rendition.Height, i)
+	}
+	return graph.String()
+}
+
+// renditionArgs returns the ffmpeg arguments that encode renditions of source alongside
+// destination.  audio and crf may be nil.
+func renditionArgs(source, destination string, renditions []Rendition, audio *AudioOptions, crf *int) []string {
+	quality := defaultRenditionCRF
+	if crf != nil {
+		quality = *crf
+	}
+
+	args := []string{"-i", source, "-filter_complex", renditionFilter(renditions)}
+
+	audioMaps := []string{"-map", "0:a:0?"}
+	audioArgs := []string{"-c:a", "aac", "-b:a", defaultRenditionAudioKbps}
+	if audio != nil {
+		if audio.StereoTrack {
+			audioMaps = []string{"-map", "0:a:0", "-map", "0:a:0"}
+		}
+		audioArgs = audio.ffmpegArgs(AudioCodecAAC)
+	}
+
+	for i, rendition := range renditions {
+		args = append(args, "-map", fmt.Sprintf("[v%d]", i))
+		args = append(args, audioMaps...)
+		args = append(args, "-c:v", "libx264", "-preset", "medium", "-pix_fmt", "yuv420p")
+		if rendition.VideoBitrateKbps != nil {
+			bitrate := *rendition.VideoBitrateKbps
+			args = append(args,
+				"-b:v", strconv.Itoa(bitrate)+"k",
+				"-maxrate", strconv.Itoa(bitrate)+"k",
+				"-bufsize", strconv.Itoa(2*bitrate)+"k",
+			)
+		} else {
+			args = append(args, "-crf", strconv.Itoa(quality))
+		}
+		args = append(args, audioArgs...)
+		args = append(args, "-movflags", "+faststart", "-y", RenditionPath(destination, rendition.Name))
+	}
+	return args
+}
@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestRenditionArgs(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	bitrate := 3000
+	renditions := []Rendition{
+		{Name: "1080p", Height: 1080},
+		{Name: "720p", Height: 720, VideoBitrateKbps: &bitrate},
+	}
+	want := []string{
+		"-i", "/media/movie.mkv",
+		"-filter_complex", "[0:v:0]split=2[s0][s1];[s0]scale=-2:1080[v0];[s1]scale=-2:720[v1]",
+		"-map", "[v0]", "-map", "0:a:0?",
+		"-c:v", "libx264", "-preset", "medium", "-pix_fmt", "yuv420p", "-crf", "21",
+		"-c:a", "aac", "-b:a", "128k",
+		"-movflags", "+faststart", "-y", "/out/movie_1080p.mp4",
+		"-map", "[v1]", "-map", "0:a:0?",
+		"-c:v", "libx264", "-preset", "medium", "-pix_fmt", "yuv420p", "-b:v", "3000k", "-maxrate", "3000k", "-bufsize", "6000k",
+		"-c:a", "aac", "-b:a", "128k",
+		"-movflags", "+faststart", "-y", "/out/movie_720p.mp4",
+	}
+	exam.Equal(e, env, want, renditionArgs("/media/movie.mkv", "/out/movie.mp4", renditions, nil, nil))
+}
+
+func TestRenditionStatuses(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	args := TranscodeJobArgs{
+		DestinationPath: "/out/movie.mp4",
+		Renditions:      []Rendition{{Name: "480p", Height: 480}},
+	}
+	want := []RenditionStatus{{Name: "480p", DestinationPath: "/out/movie_480p.mp4", Progress: 42}}
+	exam.Equal(e, env, want, args.RenditionStatuses(42))
+	exam.Nil(e, env, TranscodeJobArgs{}.RenditionStatuses(42))
+}
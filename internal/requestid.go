@@ -0,0 +1,38 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// RequestIDHeader is the HTTP header carrying the request ID of an API call, and of the
+// webhooks sent on behalf of jobs it created.
+const RequestIDHeader = "X-Request-ID"
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context carrying the given request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, or "" if there is none.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// JobMetadata is stored as River job metadata on transcode jobs.
+type JobMetadata struct {
+	// RequestID is the ID of the API request that created the job.
+	RequestID string `json:"requestId,omitempty"`
+	// SoftCancel is set by RequestSoftCancel while the job is running.
+	SoftCancel bool `json:"softCancel,omitempty"`
+}
+
+// ParseJobMetadata decodes River job metadata, ignoring anything it doesn't recognise.
+func ParseJobMetadata(data []byte) JobMetadata {
+	var metadata JobMetadata
+	_ = json.Unmarshal(data, &metadata)
+	return metadata
+}
@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// HashSource returns the hex SHA-256 of the file at path.
+func HashSource(ctx context.Context, path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open source: %w", err)
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, contextReader{ctx: ctx, r: file}); err != nil {
+		return "", fmt.Errorf("failed to hash source: %w", err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// contextReader stops reading once ctx is done, so hashing a large source can be cancelled.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
+
+// ReuseKey identifies the output of encoding a source with the given content hash as
+// args describe: two jobs with the same key write the same files.
+func (args TranscodeJobArgs) ReuseKey(sourceSHA256 string) (string, error) {
+	encoded, err := json.Marshal(struct {
+		SourceSHA256    string            `json:"sourceSha256"`
+		DestinationPath string            `json:"destinationPath"`
+		Profile         Profile           `json:"profile"`
+		Audio           *AudioOptions     `json:"audio,omitempty"`
+		Subtitles       *SubtitleOptions  `json:"subtitles,omitempty"`
+		Video           *VideoOptions     `json:"video,omitempty"`
+		Streams         *StreamSelection  `json:"streams,omitempty"`
+		Animation       *AnimationOptions `json:"animation,omitempty"`
+		HandBrake       *HandBrakeOptions `json:"handbrake,omitempty"`
+		Chapters        *ChapterOptions   `json:"chapters,omitempty"`
+		Renditions      []Rendition       `json:"renditions,omitempty"`
+	}{sourceSHA256, args.DestinationPath, args.Profile, args.Audio, args.Subtitles, args.Video, args.Streams, args.Animation, args.HandBrake, args.Chapters, args.Renditions})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode reuse key: %w", err)
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ReusableJob is a completed transcode job whose outputs another job can reuse.
+type ReusableJob struct {
+	UUID   uuid.UUID
+	Status TranscodeJobStatus
+}
+
+// FindReusableJob returns the most recently completed transcode job of the same tenant,
+// other than the one running with args as jobID, that recorded the same reuse key and
+// whose outputs are still as it left them.  It returns nil if there is none.
+func FindReusableJob(ctx context.Context, pool *pgxpool.Pool, jobID int64, args TranscodeJobArgs, reuseKey string) (*ReusableJob, error) {
+	var id uuid.UUID
+	var output []byte
+	err := pool.QueryRow(ctx, `
+		SELECT (args->>'uuid')::uuid, metadata->'output'
+		FROM river_job
+		WHERE kind = $1 AND state = 'completed' AND id <> $2 AND metadata->'output'->>'reuseKey' = $3
+			AND coalesce(args->>'tenant', '') = $4
+		ORDER BY finalized_at DESC
+		LIMIT 1`, TranscodeJobArgs{}.Kind(), jobID, reuseKey, args.Tenant).Scan(&id, &output)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to look up completed jobs: %w", err)
+	}
+	job := &ReusableJob{UUID: id}
+	if err := json.Unmarshal(output, &job.Status); err != nil {
+		return nil, fmt.Errorf("failed to decode status of job %s: %w", id, err)
+	}
+	if !job.Status.outputsIntact(args.DestinationPath) {
+		return nil, nil
+	}
+	return job, nil
+}
+
+// outputsIntact reports whether the outputs reported by a completed job writing
+// destination still exist with the sizes they were written with.
+func (s TranscodeJobStatus) outputsIntact(destination string) bool {
+	type output struct {
+		path string
+		size *int64
+	}
+	var outputs []output
+	if s.OutputSizeBytes != nil {
+		outputs = append(outputs, output{destination, s.OutputSizeBytes})
+	}
+	for _, rendition := range s.Renditions {
+		outputs = append(outputs, output{rendition.DestinationPath, rendition.OutputSizeBytes})
+	}
+	if len(outputs) == 0 {
+		return false
+	}
+	for _, o := range outputs {
+		info, err := os.Stat(o.path)
+		if err != nil || (o.size != nil && info.Size() != *o.size) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestHashSource(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	path := filepath.Join(t.TempDir(), "movie.mkv")
+	err := os.WriteFile(path, []byte("test"), 0o644)
+	exam.Nil(e, env, err).Log(err).Must()
+
+	hash, err := HashSource(context.Background(), path)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", hash)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = HashSource(ctx, path)
+	exam.NotNil(e, env, err)
+}
+
+func TestReuseKey(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	args := TranscodeJobArgs{SourcePath: "/in/a.mkv", DestinationPath: "/out/a.mkv", Profile: ProfileArchive}
+	key, err := args.ReuseKey("abc")
+	exam.Nil(e, env, err).Log(err).Must()
+
+	// The source path, labels, and webhooks don't change the outputs.
+	same := args
+	same.SourcePath = "/in/copy-of-a.mkv"
+	same.Labels = map[string]string{"show": "firefly"}
+	sameKey, err := same.ReuseKey("abc")
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, key, sameKey)
+
+	differentSource, err := args.ReuseKey("abd")
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, false, key == differentSource)
+
+	different := args
+	different.Audio = &AudioOptions{Codec: AudioCodecAAC}
+	differentKey, err := different.ReuseKey("abc")
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, false, key == differentKey)
+}
+
+func TestOutputsIntact(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	destination := filepath.Join(t.TempDir(), "out.mkv")
+	err := os.WriteFile(destination, []byte("output"), 0o644)
+	exam.Nil(e, env, err).Log(err).Must()
+
+	exam.Equal(e, env, true, TranscodeJobStatus{OutputSizeBytes: ptr(int64(6))}.outputsIntact(destination))
+	exam.Equal(e, env, false, TranscodeJobStatus{OutputSizeBytes: ptr(int64(7))}.outputsIntact(destination))
+	exam.Equal(e, env, false, TranscodeJobStatus{OutputSizeBytes: ptr(int64(6))}.outputsIntact(destination+".missing"))
+	exam.Equal(e, env, false, TranscodeJobStatus{}.outputsIntact(destination))
+}
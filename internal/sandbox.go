@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+	"time"
+)
+
+// Sandbox restricts what encoder subprocesses can do, since they parse untrusted media.
+type Sandbox struct {
+	// UID and GID run the encoders as another user and group; 0 leaves them unchanged.
+	// Changing them requires the worker to run as root.
+	UID, GID uint32
+	// Bubblewrap runs the encoders under bwrap(1) in fresh namespaces without network
+	// access, with the whole filesystem read-only except WritablePaths.
+	Bubblewrap bool
+	// WritablePaths are the directories encoders may write to under Bubblewrap,
+	// normally the destination mounts.
+	WritablePaths []string
+	// SeccompPath is a compiled seccomp BPF program that bwrap installs for the encoders.
+	// Requires Bubblewrap; empty installs no filter.
+	SeccompPath string
+}
+
+// Check reports whether s can be set up on this host.  A nil s, which runs encoders
+// unsandboxed, always can.
+func (s *Sandbox) Check() error {
+	if s != nil && (s.UID != 0 || s.GID != 0) && os.Geteuid() != 0 {
+		return fmt.Errorf("running encoders as uid %d, gid %d requires the worker to run as root", s.UID, s.GID)
+	}
+	if s != nil && s.Bubblewrap {
+		if _, err := exec.LookPath("bwrap"); err != nil {
+			return fmt.Errorf("sandbox needs bubblewrap: %w", err)
+		}
+		for _, path := range s.WritablePaths {
+			if _, err := os.Stat(path); err != nil {
+				return fmt.Errorf("sandbox writable path: %w", err)
+			}
+		}
+		if s.SeccompPath != "" {
+			if _, err := os.Stat(s.SeccompPath); err != nil {
+				return fmt.Errorf("sandbox seccomp filter: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// wrapperArgs returns the command prefix that enters the sandbox, or nil if there is none.
+func (s *Sandbox) wrapperArgs() []string {
+	if s == nil || !s.Bubblewrap {
+		return nil
+	}
+	args := []string{
+		"bwrap",
+		"--ro-bind", "/", "/",
+		"--dev", "/dev",
+		"--proc", "/proc",
+		"--tmpfs", "/tmp",
+		"--unshare-all",
+		"--die-with-parent",
+		"--new-session",
+	}
+	for _, path := range s.WritablePaths {
+		args = append(args, "--bind", path, path)
+	}
+	if s.SeccompPath != "" {
+		// The filter is the first of cmd.ExtraFiles, which the child sees as fd 3.
+		args = append(args, "--seccomp", "3")
+	}
+	return append(args, "--")
+}
+
+// extraFiles returns how many of a command's ExtraFiles apply adds, so files passed
+// after them can be found: the first file after them is fd 3 + extraFiles in the child.
+func (s *Sandbox) extraFiles() int {
+	if s != nil && s.Bubblewrap && s.SeccompPath != "" {
+		return 1
+	}
+	return 0
+}
+
+// apply sets up cmd to run in the sandbox.  cmd must have been created with the
+// prefix from wrapperArgs.
+func (s *Sandbox) apply(cmd *exec.Cmd) error {
+	if s == nil {
+		return nil
+	}
+	if s.UID != 0 || s.GID != 0 {
+		cmd.SysProcAttr = &syscall.SysProcAttr{
+			Credential: &syscall.Credential{Uid: s.UID, Gid: s.GID},
+		}
+	}
+	if s.Bubblewrap && s.SeccompPath != "" {
+		// Closed by its finalizer once the command no longer references it; the child
+		// has its own copy from the moment it starts.
+		filter, err := os.Open(s.SeccompPath)
+		if err != nil {
+			return fmt.Errorf("failed to open seccomp filter: %w", err)
+		}
+		cmd.ExtraFiles = append([]*os.File{filter}, cmd.ExtraFiles...)
+	}
+	return nil
+}
+
+// encoderStopTimeout is how long a gracefully stopped encoder has to exit after SIGTERM
+// before it is killed.
+const encoderStopTimeout = 10 * time.Second
+
+// ErrGracefulStop is wrapped by the cause of a cancelled transcode when its encoder
+// should finish writing the output it has so far, rather than being killed.
+var ErrGracefulStop = errors.New("encoder stopped gracefully")
+
+// sandboxedCommand creates a command that runs in sandbox, which may be nil.  If the
+// sandbox can't be applied, the command fails when it starts.
+func sandboxedCommand(ctx context.Context, sandbox *Sandbox, argv []string) *exec.Cmd {
+	argv = append(sandbox.wrapperArgs(), argv...)
+	cmd := exec.CommandContext(ctx, argv[0], argv[1:]...)
+	// Let encoders stopped gracefully exit cleanly, as ffmpeg and HandBrake finalize
+	// their output on SIGTERM, and kill them otherwise
+	cmd.Cancel = func() error {
+		if errors.Is(context.Cause(ctx), ErrGracefulStop) {
+			return cmd.Process.Signal(syscall.SIGTERM)
+		}
+		return cmd.Process.Kill()
+	}
+	cmd.WaitDelay = encoderStopTimeout
+	if err := sandbox.apply(cmd); err != nil {
+		cmd.Err = err
+	}
+	return cmd
+}
@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestSandboxWrapperArgs(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	base := []string{
+		"bwrap", "--ro-bind", "/", "/", "--dev", "/dev", "--proc", "/proc", "--tmpfs", "/tmp",
+		"--unshare-all", "--die-with-parent", "--new-session",
+	}
+	tests := []struct {
+		loc     exam.Loc
+		name    string
+		sandbox *Sandbox
+		want    []string
+	}{
+		{loc: exam.Here(), name: "none", sandbox: nil, want: nil},
+		{loc: exam.Here(), name: "user only", sandbox: &Sandbox{UID: 1000, GID: 1000}, want: nil},
+		{
+			loc:     exam.Here(),
+			name:    "bubblewrap",
+			sandbox: &Sandbox{Bubblewrap: true, WritablePaths: []string{"/media/out"}},
+			want:    append(base[:len(base):len(base)], "--bind", "/media/out", "/media/out", "--"),
+		},
+		{
+			loc:     exam.Here(),
+			name:    "seccomp",
+			sandbox: &Sandbox{Bubblewrap: true, SeccompPath: "/etc/vt/encoders.bpf"},
+			want:    append(base[:len(base):len(base)], "--seccomp", "3", "--"),
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.want, tt.sandbox.wrapperArgs())
+		})
+	}
+}
+
+func TestSandboxedCommandCancel(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc   exam.Loc
+		name  string
+		cause error
+		want  string
+	}{
+		{
+			loc:   exam.Here(),
+			name:  "graceful stop",
+			cause: fmt.Errorf("soft cancel: %w", ErrGracefulStop),
+			want:  "stopped\n",
+		},
+		{
+			loc:   exam.Here(),
+			name:  "hard cancel",
+			cause: errors.New("cancelled"),
+			want:  "",
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+
+			ctx, cancel := context.WithCancelCause(context.Background())
+			cmd := sandboxedCommand(ctx, nil, []string{"sh", "-c", `trap 'echo stopped; exit 0' TERM; echo started; while :; do sleep 0.1; done`})
+			stdout, err := cmd.StdoutPipe()
+			exam.Nil(e, env, err).Log(err).Must()
+			exam.Nil(e, env, cmd.Start()).Must()
+			started := make([]byte, len("started\n"))
+			_, err = stdout.Read(started)
+			exam.Nil(e, env, err).Log(err).Must()
+
+			cancel(tt.cause)
+			var rest []byte
+			buf := make([]byte, 64)
+			for {
+				n, err := stdout.Read(buf)
+				rest = append(rest, buf[:n]...)
+				if err != nil {
+					break
+				}
+			}
+			_ = cmd.Wait()
+			exam.Equal(e, env, tt.want, string(rest))
+		})
+	}
+}
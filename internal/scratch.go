@@ -0,0 +1,114 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrScratchQuotaExceeded is returned when a job's intermediate files push the scratch
+// directory over its quota.
+var ErrScratchQuotaExceeded = errors.New("scratch directory quota exceeded")
+
+// ScratchDir is where a worker keeps the intermediate files of its jobs, such as
+// encoded segments and two-pass logs.  Each job gets a subdirectory named after its
+// UUID, which is kept across retries so they can resume, and removed once the job is
+// done.
+type ScratchDir struct {
+	Path string
+	// QuotaBytes caps the total size of the directory; 0 means no quota.
+	QuotaBytes int64
+}
+
+// JobDir creates and returns the scratch directory of the job with the given UUID.
+func (s *ScratchDir) JobDir(id uuid.UUID) (string, error) {
+	dir := filepath.Join(s.Path, id.String())
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create scratch directory: %w", err)
+	}
+	return dir, nil
+}
+
+// Usage returns the total size of the files in the directory.
+func (s *ScratchDir) Usage() (int64, error) {
+	var total int64
+	err := filepath.WalkDir(s.Path, func(path string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil // Removed during the walk
+		} else if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			total += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to measure scratch directory: %w", err)
+	}
+	return total, nil
+}
+
+// CheckQuota returns an error wrapping ErrScratchQuotaExceeded if the directory is
+// over its quota.
+func (s *ScratchDir) CheckQuota() error {
+	if s.QuotaBytes <= 0 {
+		return nil
+	}
+	usage, err := s.Usage()
+	if err != nil {
+		return err
+	}
+	if usage > s.QuotaBytes {
+		return fmt.Errorf("%w: %d of %d bytes used", ErrScratchQuotaExceeded, usage, s.QuotaBytes)
+	}
+	return nil
+}
+
+// Clean removes what crashed jobs left behind: entries that aren't job directories,
+// and the directories of jobs that won't run again.  Jobs still waiting for a retry
+// keep their directories so they can resume.
+func (s *ScratchDir) Clean(ctx context.Context, pool *pgxpool.Pool) error {
+	if err := os.MkdirAll(s.Path, 0o755); err != nil {
+		return fmt.Errorf("failed to create scratch directory: %w", err)
+	}
+	entries, err := os.ReadDir(s.Path)
+	if err != nil {
+		return fmt.Errorf("failed to list scratch directory: %w", err)
+	}
+	for _, entry := range entries {
+		if id, err := uuid.Parse(entry.Name()); err == nil && entry.IsDir() {
+			var pending bool
+			err := pool.QueryRow(ctx, `
+				SELECT EXISTS (
+					SELECT 1 FROM river_job
+					WHERE kind = $1 AND args->>'uuid' = $2
+						AND state IN ('available', 'pending', 'retryable', 'running', 'scheduled')
+				)`, TranscodeJobArgs{}.Kind(), id.String()).Scan(&pending)
+			if err != nil {
+				return fmt.Errorf("failed to look up job %s: %w", id, err)
+			}
+			if pending {
+				continue
+			}
+		}
+		log.Printf("Removing leftover scratch entry %s", entry.Name())
+		if err := os.RemoveAll(filepath.Join(s.Path, entry.Name())); err != nil {
+			return fmt.Errorf("failed to remove leftover scratch entry: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestScratchDirQuota(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	scratch := &ScratchDir{Path: t.TempDir(), QuotaBytes: 10}
+	dir, err := scratch.JobDir(uuid.MustParse("550e8400-e29b-41d4-a716-446655440000"))
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, filepath.Join(scratch.Path, "550e8400-e29b-41d4-a716-446655440000"), dir)
+
+	err = os.WriteFile(filepath.Join(dir, "a"), make([]byte, 6), 0o644)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Nil(e, env, scratch.CheckQuota())
+
+	err = os.MkdirAll(filepath.Join(dir, "segments"), 0o755)
+	exam.Nil(e, env, err).Log(err).Must()
+	err = os.WriteFile(filepath.Join(dir, "segments", "b"), make([]byte, 6), 0o644)
+	exam.Nil(e, env, err).Log(err).Must()
+	usage, err := scratch.Usage()
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, int64(12), usage)
+	exam.Equal(e, env, true, errors.Is(scratch.CheckQuota(), ErrScratchQuotaExceeded))
+
+	code, permanent := ClassifyFailure(scratch.CheckQuota())
+	exam.Equal(e, env, ErrorCodeDiskFull, *code)
+	exam.Equal(e, env, false, permanent)
+}
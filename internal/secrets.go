@@ -0,0 +1,253 @@
+package internal
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// ErrSecretsKeyUnknown is returned when opening a secret sealed with a key that isn't
+// configured.
+var ErrSecretsKeyUnknown = errors.New("secret was sealed with a key that isn't configured")
+
+// secretsKeySize is the size of the AES-256 keys that secrets and data keys are
+// encrypted with.
+const secretsKeySize = 32
+
+// SealedSecret is a secret encrypted with a data key of its own, which is stored
+// alongside it encrypted by a key-encryption key.
+type SealedSecret struct {
+	// KeyID identifies the key-encryption key that WrappedKey was encrypted with.
+	KeyID      string `json:"keyId"`
+	WrappedKey []byte `json:"wrappedKey"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// KeyWrapper encrypts and decrypts the data keys of sealed secrets.  LocalKeyWrapper
+// uses keys from the configuration; a key management service can be used instead by
+// implementing it.
+type KeyWrapper interface {
+	// WrapKey encrypts key, and returns the ID of the key-encryption key it used.
+	WrapKey(ctx context.Context, key []byte) (keyID string, wrapped []byte, err error)
+	// UnwrapKey decrypts a key encrypted by the key-encryption key with ID keyID.
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// LocalKeyWrapper wraps data keys with AES-256-GCM keys held in memory.
+type LocalKeyWrapper struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+// NewLocalKeyWrapper parses keys, each 32 base64-encoded bytes.  The first key wraps
+// new data keys, and every key can unwrap them, so keys can be rotated by adding a new
+// key at the front and removing the old one once no jobs sealed with it remain.
+func NewLocalKeyWrapper(keys []string) (*LocalKeyWrapper, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no secrets keys are configured")
+	}
+	wrapper := &LocalKeyWrapper{keys: make(map[string]cipher.AEAD, len(keys))}
+	for i, encoded := range keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("secrets key %d is not base64-encoded: %w", i+1, err)
+		}
+		if len(key) != secretsKeySize {
+			return nil, fmt.Errorf("secrets key %d must be %d bytes, not %d", i+1, secretsKeySize, len(key))
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(key)
+		id := hex.EncodeToString(sum[:8])
+		if i == 0 {
+			wrapper.primary = id
+		}
+		wrapper.keys[id] = aead
+	}
+	return wrapper, nil
+}
+
+// SecretsKeyWrapper returns a LocalKeyWrapper for keys, or nil if there are none, in
+// which case secrets aren't sealed.
+func SecretsKeyWrapper(keys []string) (KeyWrapper, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	return NewLocalKeyWrapper(keys)
+}
+
+// WrapKey encrypts key with the first configured key.
+func (w *LocalKeyWrapper) WrapKey(_ context.Context, key []byte) (string, []byte, error) {
+	wrapped, err := sealAEAD(w.keys[w.primary], key)
+	if err != nil {
+		return "", nil, err
+	}
+	return w.primary, wrapped, nil
+}
+
+// UnwrapKey decrypts key with the configured key whose ID is keyID.
+func (w *LocalKeyWrapper) UnwrapKey(_ context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := w.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrSecretsKeyUnknown, keyID)
+	}
+	return openAEAD(aead, wrapped)
+}
+
+// SealSecret encrypts plaintext with a new data key wrapped by wrapper.
+func SealSecret(ctx context.Context, wrapper KeyWrapper, plaintext []byte) (*SealedSecret, error) {
+	dataKey := make([]byte, secretsKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := sealAEAD(aead, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	keyID, wrapped, err := wrapper.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+	return &SealedSecret{KeyID: keyID, WrappedKey: wrapped, Ciphertext: ciphertext}, nil
+}
+
+// OpenSecret decrypts a secret sealed by SealSecret.  wrapper may be nil if no secrets
+// keys are configured, in which case opening fails with ErrSecretsKeyUnknown.
+func OpenSecret(ctx context.Context, wrapper KeyWrapper, sealed *SealedSecret) ([]byte, error) {
+	if wrapper == nil {
+		return nil, fmt.Errorf("%w: %s", ErrSecretsKeyUnknown, sealed.KeyID)
+	}
+	dataKey, err := wrapper.UnwrapKey(ctx, sealed.KeyID, sealed.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return openAEAD(aead, sealed.Ciphertext)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return aead, nil
+}
+
+// sealAEAD encrypts plaintext with aead, prefixed by a random nonce.
+func sealAEAD(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// openAEAD decrypts the output of sealAEAD.
+func openAEAD(aead cipher.AEAD, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("sealed secret is truncated")
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secret: %w", err)
+	}
+	return plaintext, nil
+}
+
+// sealToken replaces *token with its sealed form, unless it is empty or wrapper is nil.
+func sealToken(ctx context.Context, wrapper KeyWrapper, token *[]byte, sealed **SealedSecret) error {
+	if wrapper == nil || len(*token) == 0 {
+		return nil
+	}
+	secret, err := SealSecret(ctx, wrapper, *token)
+	if err != nil {
+		return err
+	}
+	*token, *sealed = nil, secret
+	return nil
+}
+
+// openToken replaces *sealed, if set, with the token it holds.
+func openToken(ctx context.Context, wrapper KeyWrapper, token *[]byte, sealed **SealedSecret) error {
+	if *sealed == nil {
+		return nil
+	}
+	plaintext, err := OpenSecret(ctx, wrapper, *sealed)
+	if err != nil {
+		return err
+	}
+	*token, *sealed = plaintext, nil
+	return nil
+}
+
+// SealSecrets seals the job's webhook tokens, so they aren't stored in plaintext.  It
+// does nothing if wrapper is nil.
+func (args *TranscodeJobArgs) SealSecrets(ctx context.Context, wrapper KeyWrapper) error {
+	if err := sealToken(ctx, wrapper, &args.WebhookToken, &args.SealedWebhookToken); err != nil {
+		return fmt.Errorf("failed to seal webhook token: %w", err)
+	}
+	for i := range args.Webhooks {
+		if err := sealToken(ctx, wrapper, &args.Webhooks[i].Token, &args.Webhooks[i].SealedToken); err != nil {
+			return fmt.Errorf("failed to seal webhook token: %w", err)
+		}
+	}
+	return nil
+}
+
+// OpenSecrets restores the webhook tokens of the job and its webhook targets if they
+// were sealed.
+func (args *TranscodeJobArgs) OpenSecrets(ctx context.Context, wrapper KeyWrapper) error {
+	if err := openToken(ctx, wrapper, &args.WebhookToken, &args.SealedWebhookToken); err != nil {
+		return fmt.Errorf("failed to open webhook token: %w", err)
+	}
+	for i := range args.Webhooks {
+		if err := openToken(ctx, wrapper, &args.Webhooks[i].Token, &args.Webhooks[i].SealedToken); err != nil {
+			return fmt.Errorf("failed to open webhook token: %w", err)
+		}
+	}
+	return nil
+}
+
+// SealSecrets seals the step's webhook token.  It does nothing if wrapper is nil.
+func (args *WorkflowStepJobArgs) SealSecrets(ctx context.Context, wrapper KeyWrapper) error {
+	if err := sealToken(ctx, wrapper, &args.Token, &args.SealedToken); err != nil {
+		return fmt.Errorf("failed to seal webhook token: %w", err)
+	}
+	return nil
+}
+
+// OpenSecrets restores the step's webhook token if it was sealed.
+func (args *WorkflowStepJobArgs) OpenSecrets(ctx context.Context, wrapper KeyWrapper) error {
+	if err := openToken(ctx, wrapper, &args.Token, &args.SealedToken); err != nil {
+		return fmt.Errorf("failed to open webhook token: %w", err)
+	}
+	return nil
+}
+
+// OpenSecrets restores the webhook's token if it was sealed.
+func (args *WebhookJobArgs) OpenSecrets(ctx context.Context, wrapper KeyWrapper) error {
+	if err := openToken(ctx, wrapper, &args.Token, &args.SealedToken); err != nil {
+		return fmt.Errorf("failed to open webhook token: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package internal
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestNewLocalKeyWrapper(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	key := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+	tests := []struct {
+		loc     exam.Loc
+		name    string
+		keys    []string
+		wantErr bool
+	}{
+		{loc: exam.Here(), name: "Valid", keys: []string{key}},
+		{loc: exam.Here(), name: "No keys", wantErr: true},
+		{loc: exam.Here(), name: "Not base64", keys: []string{"not base64!"}, wantErr: true},
+		{loc: exam.Here(), name: "Too short", keys: []string{base64.StdEncoding.EncodeToString([]byte("short"))}, wantErr: true},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			_, err := NewLocalKeyWrapper(tt.keys)
+			exam.Equal(e, env, tt.wantErr, err != nil).Log(err)
+		})
+	}
+}
+
+func TestSealSecret(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+	ctx := context.Background()
+
+	oldKey := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("o", 32)))
+	newKey := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("n", 32)))
+	old, err := NewLocalKeyWrapper([]string{oldKey})
+	exam.Nil(e, env, err).Log(err).Must()
+	rotated, err := NewLocalKeyWrapper([]string{newKey, oldKey})
+	exam.Nil(e, env, err).Log(err).Must()
+	other, err := NewLocalKeyWrapper([]string{newKey})
+	exam.Nil(e, env, err).Log(err).Must()
+
+	sealed, err := SealSecret(ctx, old, []byte("hunter2"))
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, false, strings.Contains(string(sealed.Ciphertext), "hunter2"))
+
+	opened, err := OpenSecret(ctx, rotated, sealed)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, "hunter2", string(opened))
+
+	_, err = OpenSecret(ctx, other, sealed)
+	exam.Equal(e, env, true, errors.Is(err, ErrSecretsKeyUnknown)).Log(err)
+	_, err = OpenSecret(ctx, nil, sealed)
+	exam.Equal(e, env, true, errors.Is(err, ErrSecretsKeyUnknown)).Log(err)
+
+	sealed.Ciphertext[len(sealed.Ciphertext)-1] ^= 1
+	_, err = OpenSecret(ctx, old, sealed)
+	exam.NotNil(e, env, err)
+}
+
+func TestSealSecrets(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+	ctx := context.Background()
+
+	wrapper, err := NewLocalKeyWrapper([]string{base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))})
+	exam.Nil(e, env, err).Log(err).Must()
+
+	uri := "https://example.com/hook"
+	args := TranscodeJobArgs{
+		WebhookURI:   &uri,
+		WebhookToken: []byte("token-1"),
+		Webhooks:     []WebhookTarget{{URI: uri, Token: []byte("token-2")}, {URI: uri}},
+	}
+	err = args.SealSecrets(ctx, nil)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, "token-1", string(args.WebhookToken))
+	exam.Equal(e, env, (*SealedSecret)(nil), args.SealedWebhookToken)
+
+	err = args.SealSecrets(ctx, wrapper)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, 0, len(args.WebhookToken))
+	exam.Equal(e, env, 0, len(args.Webhooks[0].Token))
+	exam.NotNil(e, env, args.SealedWebhookToken)
+	exam.NotNil(e, env, args.Webhooks[0].SealedToken)
+	exam.Equal(e, env, (*SealedSecret)(nil), args.Webhooks[1].SealedToken)
+
+	// Webhooks built from the job carry the sealed tokens until the worker opens them
+	status := &TranscodeJobStatus{}
+	webhooks := CompletionWebhooks(args, status, "")
+	exam.Equal(e, env, 3, len(webhooks)).Must()
+	var tokens []string
+	for i := range webhooks {
+		exam.Equal(e, env, 0, len(webhooks[i].Token))
+		err := webhooks[i].OpenSecrets(ctx, wrapper)
+		exam.Nil(e, env, err).Log(err).Must()
+		tokens = append(tokens, string(webhooks[i].Token))
+	}
+	exam.Equal(e, env, []string{"token-1", "token-2", ""}, tokens)
+
+	// Cloning the job opens its tokens again
+	err = args.OpenSecrets(ctx, wrapper)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, "token-1", string(args.WebhookToken))
+	exam.Equal(e, env, "token-2", string(args.Webhooks[0].Token))
+	exam.Equal(e, env, (*SealedSecret)(nil), args.SealedWebhookToken)
+	exam.Equal(e, env, (*SealedSecret)(nil), args.Webhooks[0].SealedToken)
+}
@@ -0,0 +1,277 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// MaxParallelSegments bounds how many segments a single job may encode concurrently.
+const MaxParallelSegments = 16
+
+// minSegmentDuration is the shortest segment worth splitting off; shorter sources are
+// transcoded in one piece because the split and concat overhead outweighs the gain.
+const minSegmentDuration = 2 * time.Minute
+
+// maxSegmentDuration is the longest segment, and so the most encoding a crash can lose.
+const maxSegmentDuration = 10 * time.Minute
+
+// splitManifestName is written to the segment directory once the source has been split.
+// It identifies the source so a retry only reuses segments cut from the same file.
+const splitManifestName = "split.done"
+
+// doneSuffix marks an encoded segment as complete.
+const doneSuffix = ".done"
+
+// segmentedTranscoder splits the source into keyframe-aligned segments, transcodes
+// them with an inner Transcoder, up to parallel at a time, and concatenates the results.
+//
+// Segments are kept in the job's scratch directory, or if it has none in a directory
+// next to the destination that is derived from the destination path, so when a worker
+// dies mid-job the retry resumes from the segments already encoded instead of starting
+// over.  Segments are at most maxSegmentDuration long, so even a job encoding one at a
+// time checkpoints regularly.  The directory is removed once the output has been
+// assembled.  Sources too short to split are encoded in one piece and start over.
+type segmentedTranscoder struct {
+	inner    Transcoder
+	parallel int
+}
+
+// NewSegmentedTranscoder wraps inner so that each job is encoded as segments, up to
+// parallel of them at a time, and resumes from the segments already encoded when
+// retried.  A parallel of less than 1 encodes one at a time.
+func NewSegmentedTranscoder(inner Transcoder, parallel int) Transcoder {
+	return &segmentedTranscoder{inner: inner, parallel: min(max(parallel, 1), MaxParallelSegments)}
+}
+
+func (t *segmentedTranscoder) Transcode(ctx context.Context, params TranscodeParams) error {
+	totalDuration, err := getDuration(ctx, params.Sandbox, params.SourcePath)
+	if err != nil {
+		return err
+	}
+	if totalDuration < 2*minSegmentDuration {
+		return t.inner.Transcode(ctx, params)
+	}
+	segmentDuration := min(max(totalDuration/time.Duration(t.parallel), minSegmentDuration), maxSegmentDuration)
+
+	workDir := segmentDir(params)
+	sources, err := prepareSegments(ctx, params.Sandbox, params.SourcePath, workDir, segmentDuration)
+	if err != nil {
+		return err
+	}
+
+	// Weight each segment's progress by its share of the total duration.
+	durations := make([]time.Duration, len(sources))
+	for i, source := range sources {
+		if durations[i], err = getDuration(ctx, params.Sandbox, source); err != nil {
+			return err
+		}
+	}
+
+	var mu sync.Mutex
+	segmentProgress := make([]Progress, len(sources))
+	report := func(i int, progress Progress) {
+		if params.ProgressCallback == nil {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		segmentProgress[i] = progress
+		params.ProgressCallback(combineSegmentProgress(segmentProgress, durations, totalDuration))
+	}
+
+	outputs := make([]string, len(sources))
+	ext := filepath.Ext(params.DestinationPath)
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(t.parallel)
+	for i, source := range sources {
+		outputs[i] = filepath.Join(workDir, fmt.Sprintf("out%03d%s", i, ext))
+		if _, err := os.Stat(outputs[i] + doneSuffix); err == nil {
+			// Encoded by a previous attempt.
+			report(i, Progress{Percent: 100})
+			continue
+		}
+		g.Go(func() error {
+			// Segments waiting their turn don't start once another has failed
+			if err := gctx.Err(); err != nil {
+				return err
+			}
+			segmentParams := params
+			segmentParams.Subtitles = segmentSubtitles(params.Subtitles)
+			segmentParams.SourcePath = source
+			segmentParams.DestinationPath = outputs[i]
+			segmentParams.ProgressCallback = func(progress Progress) { report(i, progress) }
+			err := t.inner.Transcode(gctx, segmentParams)
+			if err != nil {
+				return fmt.Errorf("segment %d: %w", i, err)
+			}
+			if err := os.WriteFile(outputs[i]+doneSuffix, nil, 0o644); err != nil {
+				return fmt.Errorf("failed to mark segment %d done: %w", i, err)
+			}
+			report(i, Progress{Percent: 100})
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	if err := concatSegments(ctx, params.Sandbox, workDir, outputs, params.DestinationPath, externalSubtitles(params)); err != nil {
+		return err
+	}
+	return os.RemoveAll(workDir)
+}
+
+// combineSegmentProgress returns the progress of a job from that of its segments, whose
+// durations add up to total.  The position is the end of the segments encoded from the
+// start of the source, plus how far into the next one the encoder has got, since a
+// retry resumes from the first segment not yet encoded.
+func combineSegmentProgress(segments []Progress, durations []time.Duration, total time.Duration) Progress {
+	var combined Progress
+	contiguous := true
+	for i, p := range segments {
+		combined.Percent += p.Percent * float64(durations[i]) / float64(total)
+		combined.Speed += p.Speed
+		combined.Frame += p.Frame
+		combined.FPS += p.FPS
+		if !contiguous {
+			continue
+		}
+		if p.Percent >= 100 {
+			combined.Position += durations[i]
+		} else {
+			combined.Position += p.Position
+			contiguous = false
+		}
+	}
+	return combined
+}
+
+// segmentDir returns the directory holding the segments of a job.
+func segmentDir(params TranscodeParams) string {
+	if params.ScratchDir != "" {
+		return filepath.Join(params.ScratchDir, "segments")
+	}
+	return filepath.Join(filepath.Dir(params.DestinationPath), "."+filepath.Base(params.DestinationPath)+".vt-segments")
+}
+
+// prepareSegments returns the split segments of source in workDir, reusing a previous
+// split of the same source file if there is one.
+func prepareSegments(ctx context.Context, sandbox *Sandbox, source, workDir string, segmentDuration time.Duration) ([]string, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat source: %w", err)
+	}
+	manifest := fmt.Sprintf("%s\n%d\n%d\n%s\n", source, info.Size(), info.ModTime().UnixNano(), segmentDuration)
+	manifestPath := filepath.Join(workDir, splitManifestName)
+
+	if existing, err := os.ReadFile(manifestPath); err == nil && string(existing) == manifest {
+		return listSegments(workDir)
+	}
+
+	// Missing, partial, or stale; start from a clean directory.
+	if err := os.RemoveAll(workDir); err != nil {
+		return nil, fmt.Errorf("failed to clear segment directory: %w", err)
+	}
+	if err := os.MkdirAll(workDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create segment directory: %w", err)
+	}
+	sources, err := splitSource(ctx, sandbox, source, workDir, segmentDuration)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(manifestPath, []byte(manifest), 0o644); err != nil {
+		return nil, fmt.Errorf("failed to write split manifest: %w", err)
+	}
+	return sources, nil
+}
+
+// splitSource stream-copies the source into segments of roughly the given duration.  The
+// segment muxer only cuts on keyframes, so each piece decodes independently.
+func splitSource(ctx context.Context, sandbox *Sandbox, source, workDir string, segmentDuration time.Duration) ([]string, error) {
+	cmd := encoderCommand(ctx, sandbox, nil, "ffmpeg",
+		"-i", source,
+		"-map", "0",
+		"-c", "copy",
+		"-f", "segment",
+		"-segment_format", "matroska",
+		"-segment_time", fmt.Sprintf("%.3f", segmentDuration.Seconds()),
+		"-reset_timestamps", "1",
+		"-y",
+		filepath.Join(workDir, "src%03d.mkv"),
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("failed to split source: %w: %s", err, output)
+	}
+	return listSegments(workDir)
+}
+
+// listSegments returns the split source segments in workDir, in order.
+func listSegments(workDir string) ([]string, error) {
+	sources, err := filepath.Glob(filepath.Join(workDir, "src*.mkv"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list segments: %w", err)
+	}
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("failed to split source: no segments produced")
+	}
+	sort.Strings(sources)
+	return sources, nil
+}
+
+// segmentSubtitles returns the subtitle options for encoding a single segment.  Sidecar
+// subtitles are timed against the whole source, so they are muxed in by concatSegments.
+func segmentSubtitles(subtitles *SubtitleOptions) *SubtitleOptions {
+	if subtitles == nil {
+		return nil
+	}
+	segment := *subtitles
+	segment.External = nil
+	return &segment
+}
+
+// concatSegments joins the encoded segments into destination without re-encoding,
+// muxing in the sidecar subtitles.
+func concatSegments(ctx context.Context, sandbox *Sandbox, workDir string, segments []string, destination string, external []ExternalSubtitle) error {
+	var list strings.Builder
+	for _, segment := range segments {
+		fmt.Fprintf(&list, "file '%s'\n", strings.ReplaceAll(segment, "'", `'\''`))
+	}
+	listPath := filepath.Join(workDir, "concat.txt")
+	if err := os.WriteFile(listPath, []byte(list.String()), 0o644); err != nil {
+		return fmt.Errorf("failed to write concat list: %w", err)
+	}
+
+	args := []string{"-f", "concat", "-safe", "0", "-i", listPath}
+	for _, sub := range external {
+		args = append(args, "-i", sub.Path)
+	}
+	args = append(args, "-map", "0", "-c", "copy")
+	if len(external) > 0 {
+		// The sidecar tracks follow any subtitle tracks already in the segments.
+		existing, err := countSubtitleStreams(ctx, sandbox, segments[0])
+		if err != nil {
+			return err
+		}
+		for i, sub := range external {
+			args = append(args, "-map", fmt.Sprintf("%d:s:0", i+1))
+			if sub.Language != "" {
+				args = append(args, fmt.Sprintf("-metadata:s:s:%d", existing+i), "language="+sub.Language)
+			}
+		}
+		args = append(args, "-c:s", subtitleCodecFor(destination))
+	}
+	args = append(args, "-y", destination)
+	cmd := encoderCommand(ctx, sandbox, nil, "ffmpeg", args...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to concatenate segments: %w: %s", err, output)
+	}
+	return nil
+}
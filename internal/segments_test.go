@@ -0,0 +1,189 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestPrepareSegmentsReusesSplit(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	dir := t.TempDir()
+	source := filepath.Join(dir, "movie.mkv")
+	exam.Nil(e, env, os.WriteFile(source, []byte("source"), 0o644)).Must()
+	info, err := os.Stat(source)
+	exam.Nil(e, env, err).Log(err).Must()
+
+	// Simulate a split left behind by an interrupted attempt.
+	workDir := segmentDir(TranscodeParams{DestinationPath: filepath.Join(dir, "out", "movie.mp4")})
+	exam.Equal(e, env, filepath.Join(dir, "out", ".movie.mp4.vt-segments"), workDir)
+	exam.Equal(e, env, filepath.Join(dir, "scratch", "segments"), segmentDir(TranscodeParams{
+		DestinationPath: filepath.Join(dir, "out", "movie.mp4"),
+		ScratchDir:      filepath.Join(dir, "scratch"),
+	}))
+	exam.Nil(e, env, os.MkdirAll(workDir, 0o755)).Must()
+	for _, name := range []string{"src001.mkv", "src000.mkv"} {
+		exam.Nil(e, env, os.WriteFile(filepath.Join(workDir, name), nil, 0o644)).Must()
+	}
+	manifest := []byte(source + "\n6\n" + strconv.FormatInt(info.ModTime().UnixNano(), 10) + "\n5m0s\n")
+	exam.Nil(e, env, os.WriteFile(filepath.Join(workDir, splitManifestName), manifest, 0o644)).Must()
+
+	got, err := prepareSegments(context.Background(), nil, source, workDir, 5*time.Minute)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, []string{
+		filepath.Join(workDir, "src000.mkv"),
+		filepath.Join(workDir, "src001.mkv"),
+	}, got)
+}
+
+// recordingTranscoder records the params of each Transcode call, failing the one whose
+// source is fail after encoding half of it.
+type recordingTranscoder struct {
+	calls []TranscodeParams
+	fail  string
+}
+
+func (t *recordingTranscoder) Transcode(ctx context.Context, params TranscodeParams) error {
+	t.calls = append(t.calls, params)
+	if params.SourcePath == t.fail {
+		params.ProgressCallback(Progress{Percent: 50, Position: 5 * time.Minute})
+		return errors.New("worker died")
+	}
+	return nil
+}
+
+// fakeSegmentTools puts ffprobe and ffmpeg on the PATH that report 30 minute sources
+// split into three 10 minute segments, and returns the file ffmpeg logs its arguments
+// to.
+func fakeSegmentTools(e exam.E, env deep.Env) string {
+	dir := e.TempDir()
+	calls := filepath.Join(dir, "ffmpeg.log")
+	tools := map[string]string{
+		"ffprobe": `#!/bin/sh
+for arg; do path=$arg; done
+case "$path" in
+*/src*.mkv) echo 600 ;;
+*) echo 1800 ;;
+esac
+`,
+		"ffmpeg": `#!/bin/sh
+echo "$*" >> "` + calls + `"
+for arg; do path=$arg; done
+case "$*" in
+*"-f segment"*)
+	dir=$(dirname "$path")
+	touch "$dir/src000.mkv" "$dir/src001.mkv" "$dir/src002.mkv"
+	;;
+*) touch "$path" ;;
+esac
+`,
+	}
+	for name, script := range tools {
+		exam.Nil(e, env, os.WriteFile(filepath.Join(dir, name), []byte(script), 0o755)).Must()
+	}
+	exam.SetEnv(e, "PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return calls
+}
+
+func TestSegmentedTranscodeResumesFromCheckpoint(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+	calls := fakeSegmentTools(e, env)
+
+	dir := t.TempDir()
+	var progress []Progress
+	params := TranscodeParams{
+		SourcePath:       filepath.Join(dir, "movie.mkv"),
+		DestinationPath:  filepath.Join(dir, "movie.mp4"),
+		ProgressCallback: func(p Progress) { progress = append(progress, p) },
+	}
+	exam.Nil(e, env, os.WriteFile(params.SourcePath, []byte("source"), 0o644)).Must()
+	workDir := segmentDir(params)
+	segment := func(i int) string { return filepath.Join(workDir, fmt.Sprintf("src%03d.mkv", i)) }
+
+	// Even without parallel segments, the worker dies with the first segment checkpointed
+	inner := &recordingTranscoder{fail: segment(1)}
+	err := NewSegmentedTranscoder(inner, 1).Transcode(context.Background(), params)
+	exam.NotNil(e, env, err)
+	exam.Equal(e, env, []string{segment(0), segment(1)}, sourcePaths(inner.calls))
+	exam.Equal(e, env, 15*time.Minute, progress[len(progress)-1].Position)
+
+	// The retry starts from the checkpoint, without splitting the source again
+	progress = nil
+	inner = &recordingTranscoder{}
+	exam.Nil(e, env, NewSegmentedTranscoder(inner, 1).Transcode(context.Background(), params)).Must()
+	exam.Equal(e, env, []string{segment(1), segment(2)}, sourcePaths(inner.calls))
+	exam.Equal(e, env, 10*time.Minute, progress[0].Position)
+	log, err := os.ReadFile(calls)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, 1, strings.Count(string(log), "-f segment"))
+	exam.Equal(e, env, 1, strings.Count(string(log), "-f concat"))
+
+	_, err = os.Stat(params.DestinationPath)
+	exam.Nil(e, env, err).Log(err)
+	_, err = os.Stat(workDir)
+	exam.Equal(e, env, true, os.IsNotExist(err))
+}
+
+// sourcePaths returns the source of each call.
+func sourcePaths(calls []TranscodeParams) []string {
+	var paths []string
+	for _, call := range calls {
+		paths = append(paths, call.SourcePath)
+	}
+	return paths
+}
+
+func TestCombineSegmentProgress(t *testing.T) {
+	durations := []time.Duration{10 * time.Minute, 10 * time.Minute, 5 * time.Minute}
+	tests := []struct {
+		loc      exam.Loc
+		name     string
+		segments []Progress
+		want     Progress
+	}{
+		{
+			loc:      exam.Here(),
+			name:     "first segment running",
+			segments: []Progress{{Percent: 50, Speed: 2, Position: 5 * time.Minute}, {}, {}},
+			want:     Progress{Percent: 20, Speed: 2, Position: 5 * time.Minute},
+		},
+		{
+			loc:      exam.Here(),
+			name:     "position after the encoded segments",
+			segments: []Progress{{Percent: 100}, {Percent: 10, Position: time.Minute}, {}},
+			want:     Progress{Percent: 44, Position: 11 * time.Minute},
+		},
+		{
+			loc:      exam.Here(),
+			name:     "later segments don't move the position",
+			segments: []Progress{{Percent: 100}, {Percent: 50, Position: 5 * time.Minute}, {Percent: 100}},
+			want:     Progress{Percent: 80, Position: 15 * time.Minute},
+		},
+		{
+			loc:      exam.Here(),
+			name:     "every segment encoded",
+			segments: []Progress{{Percent: 100}, {Percent: 100}, {Percent: 100}},
+			want:     Progress{Percent: 100, Position: 25 * time.Minute},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			e.Log("Running test at", tt.loc)
+			env := deep.NewEnv()
+			exam.Equal(e, env, tt.want, combineSegmentProgress(tt.segments, durations, 25*time.Minute))
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// WebhookKeyIDHeader names the key that signed a webhook delivery.
+	WebhookKeyIDHeader = "X-VT-Key-ID"
+	// WebhookTimestampHeader is when a webhook delivery was signed, in Unix seconds.
+	WebhookTimestampHeader = "X-VT-Timestamp"
+	// WebhookSignatureHeader is the base64 ed25519 signature of a webhook delivery,
+	// made over WebhookSignedContent.
+	WebhookSignatureHeader = "X-VT-Signature"
+)
+
+// WebhookSignedContent returns what a webhook delivery's signature covers: the
+// timestamp header, a '.', and the body, so a captured delivery can't be replayed
+// with a later timestamp.
+func WebhookSignedContent(timestamp string, body []byte) []byte {
+	return append([]byte(timestamp+"."), body...)
+}
+
+// WebhookKeyID identifies an ed25519 public key: its SHA-256, truncated and base64url encoded.
+func WebhookKeyID(key ed25519.PublicKey) string {
+	sum := sha256.Sum256(key)
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
+// WebhookSigner signs webhook deliveries with an ed25519 key.
+type WebhookSigner struct {
+	key ed25519.PrivateKey
+	id  string
+}
+
+// LoadWebhookSigner reads a PEM-encoded PKCS #8 ed25519 private key, as written by
+// "openssl genpkey -algorithm ed25519".
+func LoadWebhookSigner(path string) (*WebhookSigner, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook signing key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("webhook signing key %s is not PEM-encoded", path)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse webhook signing key: %w", err)
+	}
+	key, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("webhook signing key must be an ed25519 key")
+	}
+	return NewWebhookSigner(key), nil
+}
+
+// NewWebhookSigner returns a signer using key.
+func NewWebhookSigner(key ed25519.PrivateKey) *WebhookSigner {
+	return &WebhookSigner{key: key, id: WebhookKeyID(key.Public().(ed25519.PublicKey))}
+}
+
+// PublicKey returns the key receivers verify signatures with.  It is nil for a nil signer.
+func (s *WebhookSigner) PublicKey() ed25519.PublicKey {
+	if s == nil {
+		return nil
+	}
+	return s.key.Public().(ed25519.PublicKey)
+}
+
+// Sign sets the signature headers of a webhook request with the given body.  A nil
+// signer leaves the request unsigned.
+func (s *WebhookSigner) Sign(req *http.Request, body []byte, now time.Time) {
+	if s == nil {
+		return
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	signature := ed25519.Sign(s.key, WebhookSignedContent(timestamp, body))
+	req.Header.Set(WebhookKeyIDHeader, s.id)
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, base64.StdEncoding.EncodeToString(signature))
+}
+
+// ListWebhookKeys returns the distinct public keys of the workers listed in the
+// workers table, which includes workers that died within the last day, so deliveries
+// they signed can still be verified.
+func ListWebhookKeys(ctx context.Context, pool *pgxpool.Pool) ([]ed25519.PublicKey, error) {
+	rows, err := pool.Query(ctx, `
+		SELECT DISTINCT webhook_public_key FROM workers
+		WHERE webhook_public_key IS NOT NULL
+		ORDER BY webhook_public_key`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook keys: %w", err)
+	}
+	defer rows.Close()
+	var keys []ed25519.PublicKey
+	for rows.Next() {
+		var key []byte
+		if err := rows.Scan(&key); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook key: %w", err)
+		}
+		if len(key) == ed25519.PublicKeySize {
+			keys = append(keys, key)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read webhook keys: %w", err)
+	}
+	return keys, nil
+}
@@ -0,0 +1,67 @@
+package internal
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestWebhookSigner(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	exam.Nil(e, env, err).Log(err).Must()
+	writeKey := func(key any) string {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		exam.Nil(e, env, err).Log(err).Must()
+		path := filepath.Join(t.TempDir(), "key.pem")
+		err = os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600)
+		exam.Nil(e, env, err).Log(err).Must()
+		return path
+	}
+
+	e.Run("sign", func(e exam.E) {
+		signer, err := LoadWebhookSigner(writeKey(private))
+		exam.Nil(e, env, err).Log(err).Must()
+		exam.Equal(e, env, public, signer.PublicKey())
+
+		body := []byte(`{"uuid":"x"}`)
+		req, err := http.NewRequest(http.MethodPost, "http://example.com", nil)
+		exam.Nil(e, env, err).Log(err).Must()
+		signer.Sign(req, body, time.Unix(1700000000, 0))
+		exam.Equal(e, env, WebhookKeyID(public), req.Header.Get(WebhookKeyIDHeader))
+		exam.Equal(e, env, "1700000000", req.Header.Get(WebhookTimestampHeader))
+		signature, err := base64.StdEncoding.DecodeString(req.Header.Get(WebhookSignatureHeader))
+		exam.Nil(e, env, err).Log(err).Must()
+		exam.Equal(e, env, true, ed25519.Verify(public, WebhookSignedContent("1700000000", body), signature))
+	})
+
+	e.Run("nil signer", func(e exam.E) {
+		var signer *WebhookSigner
+		req, err := http.NewRequest(http.MethodPost, "http://example.com", nil)
+		exam.Nil(e, env, err).Log(err).Must()
+		signer.Sign(req, nil, time.Now())
+		exam.Equal(e, env, "", req.Header.Get(WebhookSignatureHeader))
+		exam.Nil(e, env, signer.PublicKey())
+	})
+
+	e.Run("not ed25519", func(e exam.E) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		exam.Nil(e, env, err).Log(err).Must()
+		_, err = LoadWebhookSigner(writeKey(key))
+		exam.NotNil(e, env, err)
+	})
+}
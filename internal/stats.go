@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ProfileStats is the combined throughput of the successful transcodes of a profile.
+type ProfileStats struct {
+	Jobs          int64
+	SourceSeconds float64
+	EncodeSeconds float64
+	OutputBytes   int64
+}
+
+// ProbeDuration returns the duration of the media file at path.
+func ProbeDuration(ctx context.Context, sandbox *Sandbox, path string) (time.Duration, error) {
+	return getDuration(ctx, sandbox, path)
+}
+
+// RecordProfileStats adds a successful transcode of args, which took encodeSeconds, to
+// the stats of its profile.
+func RecordProfileStats(ctx context.Context, pool *pgxpool.Pool, sandbox *Sandbox, args TranscodeJobArgs, encodeSeconds float64) error {
+	sourceDuration, err := getDuration(ctx, sandbox, args.SourcePath)
+	if err != nil {
+		return err
+	}
+	paths, err := args.OutputPaths()
+	if err != nil {
+		return err
+	}
+	var outputBytes int64
+	for _, path := range paths {
+		if info, err := os.Stat(path); err == nil {
+			outputBytes += info.Size()
+		}
+	}
+	err = RetryDB(ctx, func() error {
+		_, err := pool.Exec(ctx, `
+		INSERT INTO profile_stats (profile, jobs, source_seconds, encode_seconds, output_bytes)
+		VALUES ($1, 1, $2, $3, $4)
+		ON CONFLICT (profile) DO UPDATE SET
+			jobs = profile_stats.jobs + 1,
+			source_seconds = profile_stats.source_seconds + EXCLUDED.source_seconds,
+			encode_seconds = profile_stats.encode_seconds + EXCLUDED.encode_seconds,
+			output_bytes = profile_stats.output_bytes + EXCLUDED.output_bytes,
+			updated_at = now()`,
+			args.Profile, sourceDuration.Seconds(), encodeSeconds, outputBytes)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record profile stats: %w", err)
+	}
+	return nil
+}
+
+// LookupProfileStats returns the stats of profile, or nil if no transcode of it has
+// succeeded yet.
+func LookupProfileStats(ctx context.Context, pool *pgxpool.Pool, profile Profile) (*ProfileStats, error) {
+	var stats ProfileStats
+	err := pool.QueryRow(ctx, `
+		SELECT jobs, source_seconds, encode_seconds, output_bytes
+		FROM profile_stats
+		WHERE profile = $1`, profile).Scan(&stats.Jobs, &stats.SourceSeconds, &stats.EncodeSeconds, &stats.OutputBytes)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to look up profile stats: %w", err)
+	}
+	return &stats, nil
+}
+
+// Estimate predicts how long a source of the given duration takes to encode and how
+// many bytes of output it produces, assuming it encodes like the sources before it.  ok
+// is false if there is no throughput to go by.
+func (s *ProfileStats) Estimate(sourceDuration time.Duration) (encode time.Duration, outputBytes int64, ok bool) {
+	if s == nil || s.Jobs == 0 || s.SourceSeconds <= 0 {
+		return 0, 0, false
+	}
+	ratio := sourceDuration.Seconds() / s.SourceSeconds
+	encode = time.Duration(s.EncodeSeconds * ratio * float64(time.Second))
+	return encode, int64(float64(s.OutputBytes) * ratio), true
+}
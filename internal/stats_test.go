@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestProfileStatsEstimate(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc             exam.Loc
+		name            string
+		stats           *ProfileStats
+		source          time.Duration
+		wantEncode      time.Duration
+		wantOutputBytes int64
+		wantOK          bool
+	}{
+		{
+			loc:    exam.Here(),
+			name:   "no stats",
+			source: time.Hour,
+		},
+		{
+			loc:    exam.Here(),
+			name:   "no jobs",
+			stats:  &ProfileStats{},
+			source: time.Hour,
+		},
+		{
+			loc:             exam.Here(),
+			name:            "scales with duration",
+			stats:           &ProfileStats{Jobs: 2, SourceSeconds: 1800, EncodeSeconds: 900, OutputBytes: 1000},
+			source:          time.Hour,
+			wantEncode:      30 * time.Minute,
+			wantOutputBytes: 2000,
+			wantOK:          true,
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			encode, outputBytes, ok := tt.stats.Estimate(tt.source)
+			exam.Equal(e, env, tt.wantEncode, encode)
+			exam.Equal(e, env, tt.wantOutputBytes, outputBytes)
+			exam.Equal(e, env, tt.wantOK, ok)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MaxSelectedStreams bounds how many streams of each type a StreamSelection may keep.
+const MaxSelectedStreams = 32
+
+// StreamSelection overrides which source streams a job keeps.  Streams are numbered
+// from zero among the streams of the same type, like ffmpeg's -map 0:a:N.
+type StreamSelection struct {
+	// Video is the video stream to encode, e.g. a different angle.  Only profiles for
+	// which Profile.SupportsVideoSelection is true accept a non-zero value.
+	Video int `json:"video,omitempty"`
+	// Audio are the audio streams to keep, in output order.  Nil keeps the profile's
+	// default selection and empty drops all audio.
+	Audio []int `json:"audio"`
+	// Subtitles are the subtitle streams to keep, in output order.  Nil keeps the
+	// profile's default selection and empty drops all source subtitles.
+	Subtitles []int `json:"subtitles"`
+}
+
+// ffmpegMaps returns the -map options that select streams of kind from the first input.
+func ffmpegMaps(kind string, indexes []int) []string {
+	var args []string
+	for _, index := range indexes {
+		args = append(args, "-map", "0:"+kind+":"+strconv.Itoa(index))
+	}
+	return args
+}
+
+// handbrakeTrackList returns indexes as HandBrake's one-based track list, or "none"
+// if there are none.
+func handbrakeTrackList(indexes []int) string {
+	if len(indexes) == 0 {
+		return "none"
+	}
+	tracks := make([]string, len(indexes))
+	for i, index := range indexes {
+		tracks[i] = strconv.Itoa(index + 1)
+	}
+	return strings.Join(tracks, ",")
+}
+
+// handbrakeStreamArgs returns profileArgs adjusted for selection, followed by the
+// HandBrakeCLI options for the selection and the forced subtitle to burn in.  Either may
+// be nil.
+func handbrakeStreamArgs(profileArgs []string, selection *StreamSelection, forced *subtitleStream) []string {
+	var args []string
+	for _, arg := range profileArgs {
+		if selection != nil && (arg == "--all-audio" && selection.Audio != nil || arg == "--all-subtitles" && selection.Subtitles != nil) {
+			continue
+		}
+		args = append(args, arg)
+	}
+
+	if selection != nil && selection.Audio != nil {
+		args = append(args, "--audio", handbrakeTrackList(selection.Audio))
+	}
+	if selection == nil || selection.Subtitles == nil {
+		if forced != nil {
+			args = append(args, forced.handbrakeArgs()...)
+		}
+		return args
+	}
+	if forced == nil {
+		return append(args, "--subtitle", handbrakeTrackList(selection.Subtitles))
+	}
+	// The burned-in track goes first so --subtitle-burned can refer to it.
+	tracks := append([]int{forced.index}, selection.Subtitles...)
+	return append(args, "--subtitle", handbrakeTrackList(tracks), "--subtitle-burned", "1")
+}
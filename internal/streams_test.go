@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestHandbrakeStreamArgs(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	profileArgs := []string{"--encoder", "x265", "--all-audio", "--all-subtitles"}
+	tests := []struct {
+		loc       exam.Loc
+		name      string
+		selection *StreamSelection
+		forced    *subtitleStream
+		want      []string
+	}{
+		{
+			loc:  exam.Here(),
+			name: "Profile defaults",
+			want: profileArgs,
+		},
+		{
+			loc:    exam.Here(),
+			name:   "Forced subtitle only",
+			forced: &subtitleStream{index: 2},
+			want:   []string{"--encoder", "x265", "--all-audio", "--all-subtitles", "--subtitle", "3", "--subtitle-burned"},
+		},
+		{
+			loc:       exam.Here(),
+			name:      "Audio selection keeps subtitles",
+			selection: &StreamSelection{Audio: []int{1, 2}},
+			want:      []string{"--encoder", "x265", "--all-subtitles", "--audio", "2,3"},
+		},
+		{
+			loc:       exam.Here(),
+			name:      "Drop subtitles",
+			selection: &StreamSelection{Subtitles: []int{}},
+			want:      []string{"--encoder", "x265", "--all-audio", "--subtitle", "none"},
+		},
+		{
+			loc:       exam.Here(),
+			name:      "Subtitle selection with forced subtitle",
+			selection: &StreamSelection{Subtitles: []int{0, 4}},
+			forced:    &subtitleStream{index: 2},
+			want:      []string{"--encoder", "x265", "--all-audio", "--subtitle", "3,1,5", "--subtitle-burned", "1"},
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.want, handbrakeStreamArgs(profileArgs, tt.selection, tt.forced))
+		})
+	}
+}
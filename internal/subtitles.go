@@ -0,0 +1,267 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// SubtitleOptions controls the subtitles of a job's output.
+type SubtitleOptions struct {
+	// BurnForced burns the source's forced subtitle track, if it has one, into the video.
+	// Forced tracks carry only foreign-language dialog, so they are meant to be always on.
+	BurnForced bool `json:"burnForced,omitempty"`
+	// External are sidecar subtitle files muxed into the output as additional tracks.
+	External []ExternalSubtitle `json:"external,omitempty"`
+	// Captions keeps embedded closed captions; empty drops them.
+	Captions CaptionMode `json:"captions,omitempty"`
+}
+
+// ExternalSubtitle is a sidecar subtitle file to mux into the output.
+type ExternalSubtitle struct {
+	// Path is the .srt, .ass, or .ssa file.
+	Path string `json:"path"`
+	// Language is the ISO 639-2 language code of the track, or "" if unknown.
+	Language string `json:"language,omitempty"`
+}
+
+// ExternalSubtitleExtensions are the supported sidecar subtitle file extensions.
+var ExternalSubtitleExtensions = []string{".srt", ".ass", ".ssa"}
+
+// MaxExternalSubtitles bounds how many sidecar subtitle files a job may mux.
+const MaxExternalSubtitles = 32
+
+// externalSubtitles returns the sidecar subtitles for params.
+func externalSubtitles(params TranscodeParams) []ExternalSubtitle {
+	if params.Subtitles == nil {
+		return nil
+	}
+	return params.Subtitles.External
+}
+
+// subtitleCodecFor returns the ffmpeg subtitle encoder for text subtitles in the
+// container implied by destination's extension.
+func subtitleCodecFor(destination string) string {
+	switch strings.ToLower(filepath.Ext(destination)) {
+	case ".mkv":
+		return "copy"
+	case ".webm":
+		return "webvtt"
+	default:
+		return "mov_text"
+	}
+}
+
+// handbrakeSubtitleArgs returns the HandBrakeCLI options that import external.
+func handbrakeSubtitleArgs(external []ExternalSubtitle) []string {
+	var args []string
+	for _, kind := range []string{"srt", "ssa"} {
+		var files, langs []string
+		for _, sub := range external {
+			isSRT := strings.EqualFold(filepath.Ext(sub.Path), ".srt")
+			if isSRT != (kind == "srt") {
+				continue
+			}
+			lang := sub.Language
+			if lang == "" {
+				lang = "und"
+			}
+			files = append(files, sub.Path)
+			langs = append(langs, lang)
+		}
+		if len(files) > 0 {
+			args = append(args,
+				"--"+kind+"-file", strings.Join(files, ","),
+				"--"+kind+"-lang", strings.Join(langs, ","),
+			)
+		}
+	}
+	return args
+}
+
+// subtitleStream is a subtitle stream of a source file.
+type subtitleStream struct {
+	// index is the position of the stream among the source's subtitle streams.
+	index int
+	codec string
+}
+
+// bitmapSubtitleCodecs are subtitle codecs stored as images, which are overlaid onto the
+// video; all others are text and are rendered with libass.
+var bitmapSubtitleCodecs = []string{"hdmv_pgs_subtitle", "dvd_subtitle", "dvb_subtitle", "xsub"}
+
+// findForcedSubtitle returns the first subtitle stream of path with the forced
+// disposition, or nil if there isn't one.
+func findForcedSubtitle(ctx context.Context, sandbox *Sandbox, path string) (*subtitleStream, error) {
+	cmd := encoderCommand(ctx, sandbox, nil, "ffprobe",
+		"-v", "error",
+		"-select_streams", "s",
+		"-show_entries", "stream=codec_name:stream_disposition=forced",
+		"-of", "json",
+		path,
+	)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("failed to probe subtitles: %w: %s", err, exitErr.Stderr)
+		}
+		return nil, fmt.Errorf("failed to probe subtitles: %w", err)
+	}
+	return parseForcedSubtitle(output)
+}
+
+// parseForcedSubtitle finds the first forced stream in ffprobe's JSON stream list.
+func parseForcedSubtitle(output []byte) (*subtitleStream, error) {
+	var probe struct {
+		Streams []struct {
+			CodecName   string `json:"codec_name"`
+			Disposition struct {
+				Forced int `json:"forced"`
+			} `json:"disposition"`
+		} `json:"streams"`
+	}
+	if err := json.Unmarshal(output, &probe); err != nil {
+		return nil, fmt.Errorf("unexpected ffprobe output: %w", err)
+	}
+	for i, stream := range probe.Streams {
+		if stream.Disposition.Forced != 0 {
+			return &subtitleStream{index: i, codec: stream.CodecName}, nil
+		}
+	}
+	return nil, nil
+}
+
+// countSubtitleStreams returns the number of subtitle streams in path.
+func countSubtitleStreams(ctx context.Context, sandbox *Sandbox, path string) (int, error) {
+	return countStreams(ctx, sandbox, path, "s")
+}
+
+// forcedSubtitleFor returns the subtitle stream to burn in for params, or nil.
+func forcedSubtitleFor(ctx context.Context, params TranscodeParams) (*subtitleStream, error) {
+	if params.Subtitles == nil || !params.Subtitles.BurnForced {
+		return nil, nil
+	}
+	return findForcedSubtitle(ctx, params.Sandbox, params.SourcePath)
+}
+
+// ffmpegFilter returns a filtergraph fragment that burns s into the video labelled input.
+func (s *subtitleStream) ffmpegFilter(sourcePath, input string) string {
+	for _, codec := range bitmapSubtitleCodecs {
+		if s.codec == codec {
+			return fmt.Sprintf("%s[0:s:%d]overlay", input, s.index)
+		}
+	}
+	return fmt.Sprintf("%ssubtitles=filename=%s:si=%d", input, escapeFilterValue(sourcePath), s.index)
+}
+
+// escapeFilterValue quotes value for use as a filter option inside a filtergraph, which
+// takes two levels of escaping: one for the option value and one for the graph.
+func escapeFilterValue(value string) string {
+	optionEscaper := strings.NewReplacer(`\`, `\\`, `'`, `\'`, `:`, `\:`)
+	graphEscaper := strings.NewReplacer(`\`, `\\`, `'`, `\'`, `[`, `\[`, `]`, `\]`, `,`, `\,`, `;`, `\;`)
+	return graphEscaper.Replace(optionEscaper.Replace(value))
+}
+
+// handbrakeArgs returns the HandBrakeCLI options that burn s into the video.  HandBrake
+// numbers subtitle tracks from 1.
+func (s *subtitleStream) handbrakeArgs() []string {
+	return []string{"--subtitle", strconv.Itoa(s.index + 1), "--subtitle-burned"}
+}
+
+// ffmpegStreams describes how an ffmpeg encode selects and filters its input streams.
+type ffmpegStreams struct {
+	// sourceFilter is the filter chain applied to the source video before any subtitles
+	// are burned in, or "".
+	sourceFilter string
+	// videoFilter is the filter chain applied to the video, or "".
+	videoFilter string
+	// burnIn is the subtitle stream to burn into the video, or nil.
+	burnIn *subtitleStream
+	// audioMaps select the audio when streams are mapped explicitly.  If nil, the first
+	// audio stream, if any, is selected.
+	audioMaps []string
+	// explicit maps the streams explicitly even when nothing else requires it.
+	explicit bool
+	// subtitles are sidecar subtitle files to add as inputs and mux into the output.
+	subtitles []ExternalSubtitle
+	// subtitleCodec is the encoder for the sidecar subtitles and selected source subtitles.
+	subtitleCodec string
+	// selection overrides the source streams to keep, or nil.
+	selection *StreamSelection
+}
+
+// args returns the ffmpeg options for encoding sourcePath with the given audio options,
+// which may be nil: any additional inputs, then the stream selection and video filter.
+// They must directly follow the source's -i option.
+func (s ffmpegStreams) args(sourcePath string, audio *AudioOptions) []string {
+	stereoTrack := audio != nil && audio.StereoTrack
+	explicit := s.explicit || s.burnIn != nil || stereoTrack || len(s.subtitles) > 0 || s.selection != nil
+	video := "0:v:0"
+	var selectedSubtitles []int
+	if s.selection != nil {
+		video = "0:v:" + strconv.Itoa(s.selection.Video)
+		selectedSubtitles = s.selection.Subtitles
+	}
+
+	var args []string
+	for _, sub := range s.subtitles {
+		args = append(args, "-i", sub.Path)
+	}
+
+	if s.burnIn != nil {
+		var graph string
+		input := "[" + video + "]"
+		if s.sourceFilter != "" {
+			graph = input + s.sourceFilter + "[src];"
+			input = "[src]"
+		}
+		graph += s.burnIn.ffmpegFilter(sourcePath, input)
+		if s.videoFilter != "" {
+			graph += "," + s.videoFilter
+		}
+		args = append(args, "-filter_complex", graph+"[v]", "-map", "[v]")
+	} else {
+		if explicit {
+			args = append(args, "-map", video)
+		}
+		var filters []string
+		for _, filter := range []string{s.sourceFilter, s.videoFilter} {
+			if filter != "" {
+				filters = append(filters, filter)
+			}
+		}
+		if len(filters) > 0 {
+			args = append(args, "-vf", strings.Join(filters, ","))
+		}
+	}
+
+	if explicit {
+		switch {
+		case stereoTrack:
+			// The stereo compatibility track is a second encode of the main track.
+			args = append(args, "-map", "0:a:0", "-map", "0:a:0")
+		case s.selection != nil && s.selection.Audio != nil:
+			args = append(args, ffmpegMaps("a", s.selection.Audio)...)
+		case s.audioMaps != nil:
+			args = append(args, s.audioMaps...)
+		default:
+			args = append(args, "-map", "0:a:0?")
+		}
+	}
+
+	args = append(args, ffmpegMaps("s", selectedSubtitles)...)
+	for i, sub := range s.subtitles {
+		args = append(args, "-map", fmt.Sprintf("%d:s:0", i+1))
+		if sub.Language != "" {
+			args = append(args, fmt.Sprintf("-metadata:s:s:%d", len(selectedSubtitles)+i), "language="+sub.Language)
+		}
+	}
+	if len(selectedSubtitles) > 0 || len(s.subtitles) > 0 {
+		args = append(args, "-c:s", s.subtitleCodec)
+	}
+	return args
+}
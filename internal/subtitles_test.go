@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestParseForcedSubtitle(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	output := []byte(`{"streams": [
+		{"codec_name": "subrip", "disposition": {"forced": 0}},
+		{"codec_name": "hdmv_pgs_subtitle", "disposition": {"forced": 1}},
+		{"codec_name": "subrip", "disposition": {"forced": 1}}
+	]}`)
+	got, err := parseForcedSubtitle(output)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, &subtitleStream{index: 1, codec: "hdmv_pgs_subtitle"}, got)
+
+	got, err = parseForcedSubtitle([]byte(`{"streams": [{"codec_name": "subrip", "disposition": {"forced": 0}}]}`))
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Nil(e, env, got)
+}
+
+func TestFfmpegStreamsArgs(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc     exam.Loc
+		name    string
+		streams ffmpegStreams
+		audio   *AudioOptions
+		want    []string
+	}{
+		{
+			loc:     exam.Here(),
+			name:    "Automatic selection",
+			streams: ffmpegStreams{videoFilter: "fps=1"},
+			want:    []string{"-vf", "fps=1"},
+		},
+		{
+			loc:     exam.Here(),
+			name:    "Explicit with default audio maps",
+			streams: ffmpegStreams{explicit: true, audioMaps: []string{"-map", "0:a?"}},
+			want:    []string{"-map", "0:v:0", "-map", "0:a?"},
+		},
+		{
+			loc:     exam.Here(),
+			name:    "Bitmap burn-in with filter",
+			streams: ffmpegStreams{videoFilter: "fps=1", burnIn: &subtitleStream{index: 2, codec: "hdmv_pgs_subtitle"}},
+			want:    []string{"-filter_complex", "[0:v:0][0:s:2]overlay,fps=1[v]", "-map", "[v]", "-map", "0:a:0?"},
+		},
+		{
+			loc:     exam.Here(),
+			name:    "Text burn-in with stereo track",
+			streams: ffmpegStreams{burnIn: &subtitleStream{index: 0, codec: "subrip"}},
+			audio:   &AudioOptions{Codec: AudioCodecCopy, StereoTrack: true},
+			want: []string{
+				"-filter_complex", `[0:v:0]subtitles=filename=/media/it\\\'s\\:here \[1\].mkv:si=0[v]`,
+				"-map", "[v]",
+				"-map", "0:a:0", "-map", "0:a:0",
+			},
+		},
+		{
+			loc:     exam.Here(),
+			name:    "Source filter before burn-in",
+			streams: ffmpegStreams{sourceFilter: "fps=24000/1001", videoFilter: "fps=1", burnIn: &subtitleStream{index: 1, codec: "dvd_subtitle"}},
+			want:    []string{"-filter_complex", "[0:v:0]fps=24000/1001[src];[src][0:s:1]overlay,fps=1[v]", "-map", "[v]", "-map", "0:a:0?"},
+		},
+		{
+			loc:     exam.Here(),
+			name:    "Source filter without burn-in",
+			streams: ffmpegStreams{sourceFilter: "fieldmatch,decimate", videoFilter: "fps=1"},
+			want:    []string{"-vf", "fieldmatch,decimate,fps=1"},
+		},
+		{
+			loc:  exam.Here(),
+			name: "Stream selection with external subtitles",
+			streams: ffmpegStreams{
+				burnIn:        &subtitleStream{index: 3, codec: "hdmv_pgs_subtitle"},
+				subtitles:     []ExternalSubtitle{{Path: "/media/a.srt", Language: "fra"}},
+				subtitleCodec: "copy",
+				selection:     &StreamSelection{Video: 1, Audio: []int{1, 2}, Subtitles: []int{0}},
+			},
+			want: []string{
+				"-i", "/media/a.srt",
+				"-filter_complex", "[0:v:1][0:s:3]overlay[v]",
+				"-map", "[v]",
+				"-map", "0:a:1", "-map", "0:a:2",
+				"-map", "0:s:0",
+				"-map", "1:s:0", "-metadata:s:s:1", "language=fra",
+				"-c:s", "copy",
+			},
+		},
+		{
+			loc:     exam.Here(),
+			name:    "Stream selection dropping audio",
+			streams: ffmpegStreams{selection: &StreamSelection{Audio: []int{}}},
+			want:    []string{"-map", "0:v:0"},
+		},
+		{
+			loc:  exam.Here(),
+			name: "External subtitles",
+			streams: ffmpegStreams{
+				subtitles:     []ExternalSubtitle{{Path: "/media/a.en.srt", Language: "eng"}, {Path: "/media/a.ass"}},
+				subtitleCodec: "mov_text",
+			},
+			want: []string{
+				"-i", "/media/a.en.srt",
+				"-i", "/media/a.ass",
+				"-map", "0:v:0",
+				"-map", "0:a:0?",
+				"-map", "1:s:0", "-metadata:s:s:0", "language=eng",
+				"-map", "2:s:0",
+				"-c:s", "mov_text",
+			},
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.want, tt.streams.args("/media/it's:here [1].mkv", tt.audio))
+		})
+	}
+}
+
+func TestHandbrakeSubtitleArgs(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	got := handbrakeSubtitleArgs([]ExternalSubtitle{
+		{Path: "/media/a.en.srt", Language: "eng"},
+		{Path: "/media/a.signs.ass", Language: "eng"},
+		{Path: "/media/a.fr.SRT"},
+	})
+	exam.Equal(e, env, []string{
+		"--srt-file", "/media/a.en.srt,/media/a.fr.SRT",
+		"--srt-lang", "eng,und",
+		"--ssa-file", "/media/a.signs.ass",
+		"--ssa-lang", "eng",
+	}, got)
+}
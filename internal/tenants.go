@@ -0,0 +1,132 @@
+package internal
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"gopkg.in/yaml.v3"
+)
+
+// tenantNamePattern matches tenant names, which are recorded in the args of their jobs.
+var tenantNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// Tenant is a namespace of jobs sharing one server.  Each API key belongs to a tenant,
+// and requests made with it only see and create that tenant's jobs.
+type Tenant struct {
+	Name string `yaml:"name"`
+	// APIKeys authenticate the tenant's requests as bearer tokens, alongside any API
+	// tokens created for the tenant through the admin endpoints.
+	APIKeys []string `yaml:"apiKeys"`
+	// StorageRoots lists the directories the tenant's source and destination paths must
+	// be inside, in addition to the server's allowed paths.  If empty, the server's
+	// allowed paths apply alone.
+	StorageRoots []string `yaml:"storageRoots"`
+	// Profiles lists the profiles the tenant may use.  If empty, any profile is allowed.
+	Profiles []Profile `yaml:"profiles"`
+	// MaxActiveJobs caps the tenant's jobs that haven't finished yet; 0 means no cap.
+	// It is checked as jobs are submitted, so concurrent submissions can briefly
+	// exceed it.
+	MaxActiveJobs int `yaml:"maxActiveJobs"`
+}
+
+// tenantsFile is the format of the file named by EnvServerTenantsFile.
+type tenantsFile struct {
+	Tenants []Tenant `yaml:"tenants"`
+}
+
+// LoadTenants reads and validates the tenants defined in the YAML file at path.
+func LoadTenants(path string) ([]Tenant, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tenants file: %w", err)
+	}
+	var file tenantsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse tenants file: %w", err)
+	}
+	if err := validateTenants(file.Tenants); err != nil {
+		return nil, err
+	}
+	return file.Tenants, nil
+}
+
+func validateTenants(tenants []Tenant) error {
+	if len(tenants) == 0 {
+		return fmt.Errorf("no tenants are defined")
+	}
+	names := make(map[string]bool, len(tenants))
+	keys := make(map[string]bool)
+	for _, tenant := range tenants {
+		if !tenantNamePattern.MatchString(tenant.Name) {
+			return fmt.Errorf("invalid tenant name %q", tenant.Name)
+		}
+		if names[tenant.Name] {
+			return fmt.Errorf("tenant %q is defined more than once", tenant.Name)
+		}
+		names[tenant.Name] = true
+		for _, key := range tenant.APIKeys {
+			if key == "" {
+				return fmt.Errorf("tenant %q has an empty API key", tenant.Name)
+			}
+			if keys[key] {
+				return fmt.Errorf("tenant %q has an API key used more than once", tenant.Name)
+			}
+			keys[key] = true
+		}
+		for _, profile := range tenant.Profiles {
+			if !profile.IsValid() {
+				return fmt.Errorf("tenant %q has invalid profile %q", tenant.Name, profile)
+			}
+		}
+		if tenant.MaxActiveJobs < 0 {
+			return fmt.Errorf("tenant %q maxActiveJobs must not be negative", tenant.Name)
+		}
+	}
+	return nil
+}
+
+// TenantsByKey indexes tenants by the SHA-256 of each of their API keys, as returned
+// by HashAPIKey, so keys needn't be compared directly.
+func TenantsByKey(tenants []Tenant) map[string]*Tenant {
+	if len(tenants) == 0 {
+		return nil
+	}
+	byKey := make(map[string]*Tenant)
+	for i := range tenants {
+		for _, key := range tenants[i].APIKeys {
+			byKey[HashAPIKey(key)] = &tenants[i]
+		}
+	}
+	return byKey
+}
+
+// HashAPIKey returns the hex SHA-256 of an API key.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// ProfileAllowed reports whether the tenant may use profile.
+func (t *Tenant) ProfileAllowed(profile Profile) bool {
+	return len(t.Profiles) == 0 || slices.Contains(t.Profiles, profile)
+}
+
+// CountActiveJobs returns how many of a tenant's transcode jobs haven't finished.
+func CountActiveJobs(ctx context.Context, pool *pgxpool.Pool, tenant string) (int, error) {
+	var count int
+	err := pool.QueryRow(ctx, `
+		SELECT count(*) FROM river_job
+		WHERE kind = $1 AND coalesce(args->>'tenant', '') = $2
+			AND state IN ('available', 'pending', 'retryable', 'running', 'scheduled')`,
+		TranscodeJobArgs{}.Kind(), tenant).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count active jobs: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestLoadTenants(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc     exam.Loc
+		name    string
+		file    string
+		want    []Tenant
+		wantErr bool
+	}{
+		{
+			loc:  exam.Here(),
+			name: "Valid",
+			file: `
+tenants:
+  - name: acme
+    apiKeys: [key-1, key-2]
+    storageRoots: [/media/acme]
+    profiles: [preview, archive]
+    maxActiveJobs: 10
+  - name: beta
+    apiKeys: [key-3]
+`,
+			want: []Tenant{
+				{Name: "acme", APIKeys: []string{"key-1", "key-2"}, StorageRoots: []string{"/media/acme"}, Profiles: []Profile{ProfilePreview, ProfileArchive}, MaxActiveJobs: 10},
+				{Name: "beta", APIKeys: []string{"key-3"}},
+			},
+		},
+		{
+			loc:     exam.Here(),
+			name:    "No tenants",
+			file:    "tenants: []\n",
+			wantErr: true,
+		},
+		{
+			loc:     exam.Here(),
+			name:    "Invalid name",
+			file:    "tenants: [{name: Acme, apiKeys: [k]}]\n",
+			wantErr: true,
+		},
+		{
+			loc:     exam.Here(),
+			name:    "Duplicate name",
+			file:    "tenants: [{name: acme, apiKeys: [k1]}, {name: acme, apiKeys: [k2]}]\n",
+			wantErr: true,
+		},
+		{
+			loc:  exam.Here(),
+			name: "No API keys",
+			file: "tenants: [{name: acme}]\n",
+			want: []Tenant{{Name: "acme"}},
+		},
+		{
+			loc:     exam.Here(),
+			name:    "Shared API key",
+			file:    "tenants: [{name: acme, apiKeys: [k]}, {name: beta, apiKeys: [k]}]\n",
+			wantErr: true,
+		},
+		{
+			loc:     exam.Here(),
+			name:    "Invalid profile",
+			file:    "tenants: [{name: acme, apiKeys: [k], profiles: [bogus]}]\n",
+			wantErr: true,
+		},
+		{
+			loc:     exam.Here(),
+			name:    "Negative quota",
+			file:    "tenants: [{name: acme, apiKeys: [k], maxActiveJobs: -1}]\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+
+			path := filepath.Join(t.TempDir(), "tenants.yaml")
+			err := os.WriteFile(path, []byte(tt.file), 0o600)
+			exam.Nil(e, env, err).Log(err).Must()
+
+			tenants, err := LoadTenants(path)
+			if tt.wantErr {
+				exam.NotNil(e, env, err)
+				return
+			}
+			exam.Nil(e, env, err).Log(err).Must()
+			exam.Equal(e, env, tt.want, tenants)
+		})
+	}
+}
+
+func TestTenantsByKey(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tenants := []Tenant{
+		{Name: "acme", APIKeys: []string{"key-1", "key-2"}},
+		{Name: "beta", APIKeys: []string{"key-3"}, Profiles: []Profile{ProfilePreview}},
+	}
+	byKey := TenantsByKey(tenants)
+	exam.Equal(e, env, 3, len(byKey))
+	exam.Equal(e, env, "acme", byKey[HashAPIKey("key-2")].Name)
+	exam.Equal(e, env, "beta", byKey[HashAPIKey("key-3")].Name)
+	exam.Equal(e, env, (*Tenant)(nil), byKey[HashAPIKey("key-4")])
+	exam.Equal(e, env, map[string]*Tenant(nil), TenantsByKey(nil))
+
+	exam.Equal(e, env, true, byKey[HashAPIKey("key-1")].ProfileAllowed(ProfileArchive))
+	exam.Equal(e, env, true, byKey[HashAPIKey("key-3")].ProfileAllowed(ProfilePreview))
+	exam.Equal(e, env, false, byKey[HashAPIKey("key-3")].ProfileAllowed(ProfileArchive))
+}
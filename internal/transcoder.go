@@ -6,40 +6,92 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
 	"os/exec"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
 )
 
-type ProgressCallback func(progress float64)
+// Progress is a snapshot of transcoding progress reported by a Transcoder.
+type Progress struct {
+	// Percent is the transcoding progress percentage (0-100).
+	Percent float64
+	// Speed is the encoding speed as a multiple of realtime, or 0 if unknown.
+	Speed float64
+	// Frame is the number of frames encoded so far, or 0 if unknown.
+	Frame int64
+	// FPS is the current encoding rate in frames per second, or 0 if unknown.
+	FPS float64
+	// BitrateKbps is the current output bitrate in kilobits per second, or 0 if unknown.
+	BitrateKbps float64
+	// Position is how far into the source the encoder has got, or 0 if unknown.
+	Position time.Duration
+}
+
+type ProgressCallback func(progress Progress)
+
+// LogCallback receives each line of encoder output as it is written.
+type LogCallback func(line string)
 
 type TranscodeParams struct {
 	SourcePath       string
 	DestinationPath  string
 	ProgressCallback ProgressCallback
+	// LogCallback receives the encoder's output.  May be nil.
+	LogCallback LogCallback
+	// Limits controls the resources available to the encoder process.  May be nil.
+	Limits *ProcessLimits
+	// Sandbox restricts what the encoder process can do.  May be nil.
+	Sandbox *Sandbox
+	// Audio overrides the profile's audio encoding.  May be nil.
+	Audio *AudioOptions
+	// Subtitles controls the output subtitles.  May be nil.
+	Subtitles *SubtitleOptions
+	// Video adjusts the profile's video processing.  May be nil.
+	Video *VideoOptions
+	// Streams overrides which source streams are kept.  May be nil.
+	Streams *StreamSelection
+	// Animation configures the animated profile.  May be nil.
+	Animation *AnimationOptions
+	// Renditions are the outputs of the renditions profile.
+	Renditions []Rendition
+	// CRF overrides the profile's constant quality.  May be nil.
+	CRF *int
+	// HandBrake adds HandBrakeCLI settings to the profile's preset.  May be nil.
+	HandBrake *HandBrakeOptions
+	// Chapters controls chapters generated for sources without any.  May be nil.
+	Chapters *ChapterOptions
+	// ScratchDir holds the job's intermediate files.  If empty, they are kept next to
+	// the destination.
+	ScratchDir string
 }
 
 type Transcoder interface {
 	Transcode(context.Context, TranscodeParams) error
 }
 
-func NewTranscoder(profile Profile) Transcoder {
-	switch profile {
-	case ProfilePreview:
-		return &ffmpegTranscoder{}
-	case ProfileFast1080p30:
-		return &handbrakeTranscoder{}
-	default:
-		panic(fmt.Errorf("%w: %q", ErrPanicInvalidProfile, profile))
-	}
+func init() {
+	RegisterTranscoder(TranscoderBackend{
+		Name:     "ffmpeg",
+		Profiles: []Profile{ProfilePreview},
+		Tools:    []string{ToolFFmpeg, ToolFFprobe},
+		New:      func(Profile) Transcoder { return &ffmpegTranscoder{} },
+	})
+	RegisterTranscoder(TranscoderBackend{
+		Name:     "handbrake",
+		Profiles: []Profile{ProfileFast1080p30, ProfileArchive, ProfileHDR},
+		Tools:    []string{ToolFFmpeg, ToolFFprobe, ToolHandBrake},
+		New: func(profile Profile) Transcoder {
+			return &handbrakeTranscoder{args: handbrakeProfileArgs[profile]}
+		},
+	})
 }
 
 type ffmpegTranscoder struct{}
 
-func getResolution(ctx context.Context, path string) (width int, height int, err error) {
-	cmd := exec.CommandContext(ctx, "ffprobe",
+func getResolution(ctx context.Context, sandbox *Sandbox, path string) (width int, height int, err error) {
+	cmd := encoderCommand(ctx, sandbox, nil, "ffprobe",
 		"-v", "error",
 		"-select_streams", "v:0",
 		"-show_entries", "stream=width,height",
@@ -73,8 +125,8 @@ func getResolution(ctx context.Context, path string) (width int, height int, err
 	return width, height, nil
 }
 
-func getDuration(ctx context.Context, path string) (time.Duration, error) {
-	cmd := exec.CommandContext(ctx, "ffprobe",
+func getDuration(ctx context.Context, sandbox *Sandbox, path string) (time.Duration, error) {
+	cmd := encoderCommand(ctx, sandbox, nil, "ffprobe",
 		"-v", "error",
 		"-show_entries", "format=duration",
 		"-of", "csv=p=0",
@@ -97,45 +149,112 @@ func getDuration(ctx context.Context, path string) (time.Duration, error) {
 	return time.Duration(durationSec * float64(time.Second)), nil
 }
 
-var timeRegex = regexp.MustCompile(`time=(\d{2}):(\d{2}):(\d{2})\.(\d{2})`)
+// OutputInfo describes a finished output file.
+type OutputInfo struct {
+	SizeBytes int64
+	Duration  time.Duration
+}
 
-func parseFfmpegProgress(line string, totalDuration time.Duration) (float64, bool) {
-	matches := timeRegex.FindStringSubmatch(line)
-	if len(matches) != 5 {
-		return 0, false
+// countStreams returns the number of streams of the given type ("v", "a", or "s") in path.
+func countStreams(ctx context.Context, sandbox *Sandbox, path, kind string) (int, error) {
+	cmd := encoderCommand(ctx, sandbox, nil, "ffprobe",
+		"-v", "error",
+		"-select_streams", kind,
+		"-show_entries", "stream=index",
+		"-of", "csv=p=0",
+		path,
+	)
+	output, err := cmd.Output()
+	if err != nil {
+		return 0, fmt.Errorf("failed to probe %s streams: %w", kind, err)
 	}
+	return len(strings.Fields(string(output))), nil
+}
 
-	hours, _ := strconv.Atoi(matches[1])
-	minutes, _ := strconv.Atoi(matches[2])
-	seconds, _ := strconv.Atoi(matches[3])
-	centiseconds, _ := strconv.Atoi(matches[4])
+// ProbeOutput returns the size and duration of the file at path.
+func ProbeOutput(ctx context.Context, sandbox *Sandbox, path string) (*OutputInfo, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat output: %w", err)
+	}
+	duration, err := getDuration(ctx, sandbox, path)
+	if err != nil {
+		return nil, err
+	}
+	return &OutputInfo{SizeBytes: info.Size(), Duration: duration}, nil
+}
 
-	currentTime := time.Duration(hours)*time.Hour +
-		time.Duration(minutes)*time.Minute +
-		time.Duration(seconds)*time.Second +
-		time.Duration(centiseconds)*10*time.Millisecond
+// ffmpegProgress collects the key=value lines ffmpeg writes with -progress.  Each block
+// of lines ends with a progress= line, after which the block is reported.
+type ffmpegProgress struct {
+	totalDuration time.Duration
+	current       Progress
+}
 
-	if totalDuration == 0 {
-		return 0, false
+// parseLine updates the progress with one key=value line, and reports whether it ended
+// a block with a known position.  Values ffmpeg doesn't know yet are written as N/A and
+// leave the previous value in place.
+func (p *ffmpegProgress) parseLine(line string) bool {
+	key, value, ok := strings.Cut(line, "=")
+	if !ok {
+		return false
 	}
+	value = strings.TrimSpace(value)
+	switch key {
+	case "frame":
+		if frame, err := strconv.ParseInt(value, 10, 64); err == nil {
+			p.current.Frame = frame
+		}
+	case "fps":
+		if fps, err := strconv.ParseFloat(value, 64); err == nil {
+			p.current.FPS = fps
+		}
+	case "bitrate":
+		if bitrate, err := strconv.ParseFloat(strings.TrimSuffix(value, "kbits/s"), 64); err == nil {
+			p.current.BitrateKbps = bitrate
+		}
+	case "speed":
+		if speed, err := strconv.ParseFloat(strings.TrimSuffix(value, "x"), 64); err == nil {
+			p.current.Speed = speed
+		}
+	case "out_time_us":
+		if us, err := strconv.ParseInt(value, 10, 64); err == nil && us >= 0 {
+			p.current.Position = time.Duration(us) * time.Microsecond
+		}
+	case "progress":
+		if p.totalDuration <= 0 {
+			return false
+		}
+		p.current.Percent = min(float64(p.current.Position)/float64(p.totalDuration), 1) * 100
+		return true
+	}
+	return false
+}
 
-	progress := float64(currentTime) / float64(totalDuration)
-	if progress > 1.0 {
-		progress = 1.0
+// readFfmpegProgress calls callback with each block of -progress output read from r,
+// until r is closed.
+func readFfmpegProgress(r io.Reader, totalDuration time.Duration, callback ProgressCallback) {
+	progress := ffmpegProgress{totalDuration: totalDuration}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if progress.parseLine(scanner.Text()) {
+			callback(progress.current)
+		}
 	}
-	return progress, true
+	// Keep draining, so ffmpeg never blocks writing progress
+	io.Copy(io.Discard, r)
 }
 
 // For now, this only generates preview formats.  Extend it to do more stuff later if necessary.
 func (t *ffmpegTranscoder) Transcode(ctx context.Context, params TranscodeParams) error {
-	width, height, err := getResolution(ctx, params.SourcePath)
+	width, height, err := getResolution(ctx, params.Sandbox, params.SourcePath)
 	if err != nil {
 		return err
 	}
 
 	var totalDuration time.Duration
 	if params.ProgressCallback != nil {
-		totalDuration, err = getDuration(ctx, params.SourcePath)
+		totalDuration, err = getDuration(ctx, params.Sandbox, params.SourcePath)
 		if err != nil {
 			return err
 		}
@@ -148,81 +267,258 @@ func (t *ffmpegTranscoder) Transcode(ctx context.Context, params TranscodeParams
 	}
 	resolution := fmt.Sprintf("%dx%d", targetWidth, targetHeight)
 
-	cmd := exec.CommandContext(ctx, "ffmpeg",
+	forced, err := forcedSubtitleFor(ctx, params)
+	if err != nil {
+		return err
+	}
+	streams := ffmpegStreams{
+		videoFilter:   "fps=1,scale=" + resolution,
+		burnIn:        forced,
+		subtitles:     externalSubtitles(params),
+		subtitleCodec: subtitleCodecFor(params.DestinationPath),
+		selection:     params.Streams,
+	}
+
+	audioArgs := []string{"-ac", "1", "-c:a", "aac", "-b:a", "32k"}
+	if params.Audio != nil {
+		audioArgs = params.Audio.ffmpegArgs(AudioCodecAAC)
+	}
+
+	args := []string{
 		"-skip_frame", "nokey",
 		"-i", params.SourcePath,
-		"-vf", "fps=1,scale="+resolution,
-		"-c:v", "libx264",
-		"-ac", "1",
-		"-c:a", "aac",
-		"-b:a", "32k",
-		"-progress", "pipe:2",
+	}
+	args = append(args, streams.args(params.SourcePath, params.Audio)...)
+	args = append(args, "-c:v", "libx264")
+	args = append(args, audioArgs...)
+	args = append(args,
 		"-y",
 		params.DestinationPath,
 	)
+	return runFfmpeg(ctx, params.Sandbox, params.Limits, totalDuration, params.ProgressCallback, params.LogCallback, args...)
+}
 
-	if params.ProgressCallback != nil {
-		stderrPipe, err := cmd.StderrPipe()
+// runFfmpeg runs ffmpeg with args under the given limits.  If progressCallback is set,
+// totalDuration must be the source duration; ffmpeg writes its progress to a pipe of
+// its own, so changes to the format of its log can't break progress reporting.  If
+// logCallback is set, it receives ffmpeg's log output.
+func runFfmpeg(ctx context.Context, sandbox *Sandbox, limits *ProcessLimits, totalDuration time.Duration, progressCallback ProgressCallback, logCallback LogCallback, args ...string) error {
+	if progressCallback == nil && logCallback == nil {
+		output, err := encoderCommand(ctx, sandbox, limits, "ffmpeg", args...).CombinedOutput()
 		if err != nil {
-			return fmt.Errorf("failed to create stderr pipe: %w", err)
+			err = limits.classifyExit(ctx, err, string(output))
+			return fmt.Errorf("%w: %w: %s", ErrFFmpegFailed, err, output)
 		}
+		return nil
+	}
 
-		if err := cmd.Start(); err != nil {
-			return fmt.Errorf("failed to start ffmpeg: %w", err)
+	var progressReader, progressWriter *os.File
+	if progressCallback != nil {
+		var err error
+		if progressReader, progressWriter, err = os.Pipe(); err != nil {
+			return fmt.Errorf("failed to create progress pipe: %w", err)
 		}
+		defer progressReader.Close()
+		defer progressWriter.Close()
+		// The pipe is passed after any files the sandbox passes to the child
+		progressFD := 3 + sandbox.extraFiles()
+		args = append([]string{"-nostats", "-progress", fmt.Sprintf("pipe:%d", progressFD)}, args...)
+	}
+	cmd := encoderCommand(ctx, sandbox, limits, "ffmpeg", args...)
+	if progressWriter != nil {
+		cmd.ExtraFiles = append(cmd.ExtraFiles, progressWriter)
+	}
 
-		var stderrBuf strings.Builder
-		scanner := bufio.NewScanner(stderrPipe)
+	stderrPipe, err := cmd.StderrPipe()
+	if err != nil {
+		return fmt.Errorf("failed to create stderr pipe: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start ffmpeg: %w", err)
+	}
+
+	var progressDone chan struct{}
+	if progressCallback != nil {
+		// Only ffmpeg holds the write end now, so the reader stops when it exits
+		progressWriter.Close()
+		progressDone = make(chan struct{})
 		go func() {
-			for scanner.Scan() {
-				line := scanner.Text()
-				stderrBuf.WriteString(line)
-				stderrBuf.WriteString("\n")
-				if progress, ok := parseFfmpegProgress(line, totalDuration); ok {
-					params.ProgressCallback(progress * 100) // Convert to percentage
-				}
-			}
+			defer close(progressDone)
+			readFfmpegProgress(progressReader, totalDuration, progressCallback)
 		}()
+	}
 
-		if err := cmd.Wait(); err != nil {
-			if stderrOutput := stderrBuf.String(); stderrOutput != "" {
-				return fmt.Errorf("ffmpeg failed: %w: %s", err, stderrOutput)
-			}
-			return fmt.Errorf("ffmpeg failed: %w", err)
+	var stderrBuf strings.Builder
+	scanner := bufio.NewScanner(stderrPipe)
+	for scanner.Scan() {
+		line := scanner.Text()
+		stderrBuf.WriteString(line)
+		stderrBuf.WriteString("\n")
+		if logCallback != nil {
+			logCallback(line)
 		}
-
-		// Consume any remaining output
-		io.Copy(io.Discard, stderrPipe)
-
-		return nil
 	}
+	// Consume any remaining output
+	io.Copy(io.Discard, stderrPipe)
 
-	output, err := cmd.CombinedOutput()
+	err = cmd.Wait()
+	if progressDone != nil {
+		<-progressDone
+	}
 	if err != nil {
-		return fmt.Errorf("ffmpeg failed: %w: %s", err, output)
+		stderrOutput := stderrBuf.String()
+		err = limits.classifyExit(ctx, err, stderrOutput)
+		if stderrOutput != "" {
+			return fmt.Errorf("%w: %w: %s", ErrFFmpegFailed, err, stderrOutput)
+		}
+		return fmt.Errorf("%w: %w", ErrFFmpegFailed, err)
 	}
 	return nil
 }
 
+// realtimeSpeed returns how many seconds of media were encoded per wall-clock second,
+// or 0 if it can't be determined yet.
+func realtimeSpeed(fraction float64, totalDuration, elapsed time.Duration) float64 {
+	if elapsed <= 0 || totalDuration <= 0 {
+		return 0
+	}
+	return fraction * float64(totalDuration) / float64(elapsed)
+}
+
 // handbrakeTranscoder uses HandBrakeCLI for high-quality transcoding.
-type handbrakeTranscoder struct{}
+type handbrakeTranscoder struct {
+	// args select the preset or encoder settings.
+	args []string
+}
+
+// handbrakeProfileArgs are the HandBrake settings of each profile it encodes.
+var handbrakeProfileArgs = map[Profile][]string{
+	ProfileFast1080p30: {"--preset", "Fast 1080p30"},
+	ProfileArchive:     archiveArgs,
+	ProfileHDR:         hdrArgs,
+}
+
+// archiveArgs configure HandBrake for the archive profile.  Lossless audio formats
+// can't be stored in MP4, so the output is always Matroska; audio that can't be
+// passed through is re-encoded losslessly as FLAC.
+var archiveArgs = []string{
+	"--format", "av_mkv",
+	"--encoder", "x265_10bit",
+	"--encoder-preset", "slow",
+	"--quality", "18",
+	"--all-audio",
+	"--aencoder", "copy",
+	"--audio-copy-mask", "truehd,dtshd,flac,eac3,ac3,dts,aac,mp3",
+	"--audio-fallback", "flac24",
+	"--all-subtitles",
+	"--markers",
+}
+
+// hdrArgs configure HandBrake for the hdr profile.  10-bit x265 carries the HDR10 static
+// metadata (mastering display and content light level) through from the source, and
+// --hdr-dynamic-metadata keeps HDR10+ and Dolby Vision RPUs where HandBrake supports
+// them for the source's profile; otherwise the output falls back to its HDR10 base layer.
+var hdrArgs = []string{
+	"--format", "av_mkv",
+	"--encoder", "x265_10bit",
+	"--encoder-preset", "medium",
+	"--quality", "20",
+	"--hdr-dynamic-metadata", "all",
+	"--all-audio",
+	"--aencoder", "copy",
+	"--audio-copy-mask", "truehd,dtshd,flac,eac3,ac3,dts,aac,mp3",
+	"--audio-fallback", "eac3",
+	"--all-subtitles",
+	"--markers",
+}
+
+// handbrakeScanShare and handbrakeMuxShare are the parts of a HandBrake job's progress
+// given to scanning the source and muxing the output; encoding gets the rest.  Without
+// them progress would sit at 0 while the source is scanned and at 100 while muxing.
+const (
+	handbrakeScanShare = 0.02
+	handbrakeMuxShare  = 0.03
+)
 
 // handbrakeProgress represents the JSON progress output from HandBrake.
 type handbrakeProgress struct {
-	State   string `json:"State"`
+	State    string `json:"State"`
+	Scanning struct {
+		Progress float64 `json:"Progress"`
+	} `json:"Scanning"`
 	Working struct {
 		Progress float64 `json:"Progress"`
+		Rate     float64 `json:"Rate"`
+		// Pass counts from 1; Progress is the progress of the current pass.
+		Pass      int `json:"Pass"`
+		PassCount int `json:"PassCount"`
 	} `json:"Working"`
+	Muxing struct {
+		Progress float64 `json:"Progress"`
+	} `json:"Muxing"`
+}
+
+// encodeFraction returns how much of the encoding is done, across all its passes.
+func (p handbrakeProgress) encodeFraction() float64 {
+	if p.Working.PassCount <= 1 || p.Working.Pass < 1 {
+		return p.Working.Progress
+	}
+	return min((float64(p.Working.Pass-1)+p.Working.Progress)/float64(p.Working.PassCount), 1)
+}
+
+// fraction returns how much of the whole job is done, or false for states that don't
+// report progress.
+func (p handbrakeProgress) fraction() (float64, bool) {
+	switch p.State {
+	case "SCANNING":
+		return p.Scanning.Progress * handbrakeScanShare, true
+	case "WORKING":
+		return handbrakeScanShare + p.encodeFraction()*(1-handbrakeScanShare-handbrakeMuxShare), true
+	case "MUXING":
+		return 1 - handbrakeMuxShare + p.Muxing.Progress*handbrakeMuxShare, true
+	case "WORKDONE":
+		return 1, true
+	default:
+		return 0, false
+	}
 }
 
 func (t *handbrakeTranscoder) Transcode(ctx context.Context, params TranscodeParams) error {
-	cmd := exec.CommandContext(ctx,
-		"HandBrakeCLI",
+	// HandBrake reports frame rates rather than a realtime multiple, so derive speed
+	// from the source duration and the wall-clock time spent encoding.
+	var totalDuration time.Duration
+	if params.ProgressCallback != nil {
+		var err error
+		totalDuration, err = getDuration(ctx, params.Sandbox, params.SourcePath)
+		if err != nil {
+			return err
+		}
+	}
+	var encodeStart time.Time
+
+	forced, err := forcedSubtitleFor(ctx, params)
+	if err != nil {
+		return err
+	}
+	args := append([]string{
 		"-i", params.SourcePath,
 		"-o", params.DestinationPath,
 		"--json",
-		"--preset", "Fast 1080p30",
-	)
+	}, handbrakeStreamArgs(t.args, params.Streams, forced)...)
+	if params.CRF != nil {
+		args = append(args, "--quality", strconv.Itoa(*params.CRF))
+	}
+	if params.Audio != nil {
+		args = append(args, params.Audio.handbrakeArgs(AudioCodecAAC)...)
+	}
+	args = append(args, handbrakeSubtitleArgs(externalSubtitles(params))...)
+	pulldown, err := detelecineFor(ctx, params)
+	if err != nil {
+		return err
+	}
+	args = append(args, params.Video.handbrakeArgs(pulldown)...)
+	args = append(args, params.HandBrake.handbrakeArgs()...)
+	cmd := encoderCommand(ctx, params.Sandbox, params.Limits, "HandBrakeCLI", args...)
 
 	// Get stdout pipe for JSON progress output (--json flag outputs to stdout)
 	stdout, err := cmd.StdoutPipe()
@@ -240,6 +536,22 @@ func (t *handbrakeTranscoder) Transcode(ctx context.Context, params TranscodePar
 		return fmt.Errorf("failed to start HandBrake: %w", err)
 	}
 
+	// Capture stderr for error reporting, passing HandBrake's log on as it is written
+	var stderrBuf strings.Builder
+	stderrDone := make(chan struct{})
+	go func() {
+		defer close(stderrDone)
+		stderrScanner := bufio.NewScanner(stderr)
+		for stderrScanner.Scan() {
+			line := stderrScanner.Text()
+			stderrBuf.WriteString(line)
+			stderrBuf.WriteString("\n")
+			if params.LogCallback != nil {
+				params.LogCallback(line)
+			}
+		}
+	}()
+
 	// Parse JSON progress from stdout
 	// HandBrake outputs JSON with labels like "Progress: {..." spanning multiple lines
 	scanner := bufio.NewScanner(stdout)
@@ -277,24 +589,39 @@ func (t *handbrakeTranscoder) Transcode(ctx context.Context, params TranscodePar
 					continue
 				}
 
-				if progress.State == "WORKING" && params.ProgressCallback != nil {
-					params.ProgressCallback(progress.Working.Progress * 100) // Convert to percentage
+				fraction, ok := progress.fraction()
+				if !ok || params.ProgressCallback == nil {
+					continue
+				}
+				report := Progress{Percent: fraction * 100} // Convert to percentage
+				switch progress.State {
+				case "WORKING":
+					if encodeStart.IsZero() {
+						encodeStart = time.Now()
+					}
+					encoded := progress.encodeFraction()
+					report.Speed = realtimeSpeed(encoded, totalDuration, time.Since(encodeStart))
+					report.FPS = progress.Working.Rate
+					report.Position = time.Duration(encoded * float64(totalDuration))
+				case "MUXING", "WORKDONE":
+					report.Position = totalDuration
 				}
+				params.ProgressCallback(report)
 			}
 		}
 	}
 
-	// Capture stderr for error reporting
-	stderrOutput, _ := io.ReadAll(stderr)
-
 	// Consume any remaining stdout
 	io.Copy(io.Discard, stdout)
+	<-stderrDone
+	stderrOutput := stderrBuf.String()
 
 	if err := cmd.Wait(); err != nil {
+		err = params.Limits.classifyExit(ctx, err, stderrOutput)
 		if len(stderrOutput) > 0 {
-			return fmt.Errorf("HandBrake failed: %w: %s", err, stderrOutput)
+			return fmt.Errorf("%w: %w: %s", ErrHandBrakeFailed, err, stderrOutput)
 		}
-		return fmt.Errorf("HandBrake failed: %w", err)
+		return fmt.Errorf("%w: %w", ErrHandBrakeFailed, err)
 	}
 
 	return nil
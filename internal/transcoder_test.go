@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestReadFfmpegProgress(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc    exam.Loc
+		name   string
+		output string
+		total  time.Duration
+		want   []Progress
+	}{
+		{
+			loc:  exam.Here(),
+			name: "Blocks",
+			output: `frame=120
+fps=24.00
+stream_0_0_q=28.0
+bitrate= 512.0kbits/s
+total_size=327680
+out_time_us=5000000
+out_time_ms=5000000
+out_time=00:00:05.000000
+dup_frames=0
+drop_frames=0
+speed=1.5x
+progress=continue
+frame=240
+fps=24.00
+bitrate= 520.5kbits/s
+out_time_us=10000000
+speed=1.52x
+progress=end
+`,
+			total: 10 * time.Second,
+			want: []Progress{
+				{Percent: 50, Speed: 1.5, Frame: 120, FPS: 24, BitrateKbps: 512, Position: 5 * time.Second},
+				{Percent: 100, Speed: 1.52, Frame: 240, FPS: 24, BitrateKbps: 520.5, Position: 10 * time.Second},
+			},
+		},
+		{
+			loc:  exam.Here(),
+			name: "Unknown values are ignored",
+			output: `frame=0
+fps=0.00
+bitrate=N/A
+out_time_us=N/A
+speed=N/A
+progress=continue
+`,
+			total: time.Minute,
+			want:  []Progress{{}},
+		},
+		{
+			loc:    exam.Here(),
+			name:   "Past the end",
+			output: "out_time_us=120000000\nprogress=end\n",
+			total:  time.Minute,
+			want:   []Progress{{Percent: 100, Position: 2 * time.Minute}},
+		},
+		{
+			loc:    exam.Here(),
+			name:   "Unknown duration",
+			output: "out_time_us=5000000\nprogress=continue\n",
+			total:  0,
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+
+			var got []Progress
+			readFfmpegProgress(strings.NewReader(tt.output), tt.total, func(progress Progress) {
+				got = append(got, progress)
+			})
+			exam.Equal(e, env, tt.want, got)
+		})
+	}
+}
+
+func TestHandbrakeProgressFraction(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc    exam.Loc
+		name   string
+		json   string
+		want   float64
+		wantOk bool
+	}{
+		{
+			loc:    exam.Here(),
+			name:   "Scanning",
+			json:   `{"State": "SCANNING", "Scanning": {"Preview": 5, "PreviewCount": 10, "Progress": 0.5, "Title": 1, "TitleCount": 1}}`,
+			want:   0.01,
+			wantOk: true,
+		},
+		{
+			loc:    exam.Here(),
+			name:   "Single pass",
+			json:   `{"State": "WORKING", "Working": {"Pass": 1, "PassCount": 1, "PassID": 0, "Progress": 0.5, "Rate": 48.0}}`,
+			want:   0.02 + 0.5*0.95,
+			wantOk: true,
+		},
+		{
+			loc:    exam.Here(),
+			name:   "Second of two passes",
+			json:   `{"State": "WORKING", "Working": {"Pass": 2, "PassCount": 2, "PassID": 2, "Progress": 0.0, "Rate": 48.0}}`,
+			want:   0.02 + 0.5*0.95,
+			wantOk: true,
+		},
+		{
+			loc:    exam.Here(),
+			name:   "Muxing",
+			json:   `{"State": "MUXING", "Muxing": {"Progress": 0.0}}`,
+			want:   0.97,
+			wantOk: true,
+		},
+		{
+			loc:    exam.Here(),
+			name:   "Done",
+			json:   `{"State": "WORKDONE", "WorkDone": {"Error": 0}}`,
+			want:   1,
+			wantOk: true,
+		},
+		{
+			loc:  exam.Here(),
+			name: "Idle",
+			json: `{"State": "IDLE"}`,
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+
+			var progress handbrakeProgress
+			exam.Nil(e, env, json.Unmarshal([]byte(tt.json), &progress)).Must()
+			got, ok := progress.fraction()
+			exam.Equal(e, env, tt.wantOk, ok)
+			exam.Equal(e, env, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// OutputVideoCodec returns the ffprobe name of the video codec the profile encodes into
+// destination, or "" if it isn't known ahead of time.
+func (p Profile) OutputVideoCodec(destination string) string {
+	switch p {
+	case ProfilePreview, ProfilePreviewClip, ProfileRenditions, ProfileFast1080p30:
+		return "h264"
+	case ProfileArchive, ProfileHDR:
+		return "hevc"
+	case ProfileWebM:
+		return "vp9"
+	case ProfileProResProxy:
+		return "prores"
+	case ProfileDNxHRLB:
+		return "dnxhd"
+	case ProfileAnimated:
+		return strings.TrimPrefix(strings.ToLower(filepath.Ext(destination)), ".")
+	default:
+		return ""
+	}
+}
+
+// SupportsSkipIfValid reports whether an existing output of the profile can be verified
+// well enough to skip encoding it again.  Streaming ladders are spread over many files,
+// and what plugins write isn't known.
+func (p Profile) SupportsSkipIfValid() bool {
+	return p != ProfileABR && !p.IsPlugin()
+}
+
+// coversSource reports whether the profile's output is as long as its source, rather
+// than a short sample of it.
+func (p Profile) coversSource() bool {
+	return p != ProfilePreviewClip && p != ProfileAnimated
+}
+
+// writesWholeSource reports whether the profile writes a single output that covers
+// its whole source.
+func (p Profile) writesWholeSource() bool {
+	return p.coversSource() && p != ProfileRenditions && p != ProfileABR && !p.IsPlugin()
+}
+
+// VerifyExistingOutputs checks that the outputs a job would write already exist and
+// look like the job wrote them: each decodes with the profile's video codec and, unless
+// the profile only samples the source, has the source's duration.
+func VerifyExistingOutputs(ctx context.Context, sandbox *Sandbox, args TranscodeJobArgs) error {
+	paths := []string{args.DestinationPath}
+	if args.Profile == ProfileRenditions {
+		paths = nil
+		for _, rendition := range args.RenditionStatuses(100) {
+			paths = append(paths, rendition.DestinationPath)
+		}
+	}
+
+	var sourceDuration time.Duration
+	if args.Profile.coversSource() {
+		var err error
+		if sourceDuration, err = getDuration(ctx, sandbox, args.SourcePath); err != nil {
+			return err
+		}
+	}
+	for _, path := range paths {
+		codec, err := probeVideoCodec(ctx, sandbox, path)
+		if err != nil {
+			return err
+		}
+		if want := args.Profile.OutputVideoCodec(path); codec != want {
+			return fmt.Errorf("%s has video codec %s, not %s", path, codec, want)
+		}
+		if !args.Profile.coversSource() {
+			continue
+		}
+		output, err := ProbeOutput(ctx, sandbox, path)
+		if err != nil {
+			return err
+		}
+		if !DurationsMatch(sourceDuration, output.Duration) {
+			return fmt.Errorf("%s is %s long, but the source is %s", path, output.Duration, sourceDuration)
+		}
+	}
+	return nil
+}
+
+// probeVideoCodec returns the ffprobe name of the codec of the first video stream in path.
+func probeVideoCodec(ctx context.Context, sandbox *Sandbox, path string) (string, error) {
+	output, err := encoderCommand(ctx, sandbox, nil, "ffprobe",
+		"-v", "error",
+		"-select_streams", "v:0",
+		"-show_entries", "stream=codec_name",
+		"-of", "csv=p=0",
+		path,
+	).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to probe %s: %w", path, err)
+	}
+	codec := strings.TrimSpace(string(output))
+	if codec == "" {
+		return "", fmt.Errorf("%s has no video stream", path)
+	}
+	return codec, nil
+}
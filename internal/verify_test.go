@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestOutputVideoCodec(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc         exam.Loc
+		profile     Profile
+		destination string
+		want        string
+	}{
+		{loc: exam.Here(), profile: ProfilePreview, destination: "/out/a.mp4", want: "h264"},
+		{loc: exam.Here(), profile: ProfileArchive, destination: "/out/a.mkv", want: "hevc"},
+		{loc: exam.Here(), profile: ProfileWebM, destination: "/out/a.webm", want: "vp9"},
+		{loc: exam.Here(), profile: ProfileAnimated, destination: "/out/a.GIF", want: "gif"},
+		{loc: exam.Here(), profile: ProfileAnimated, destination: "/out/a.webp", want: "webp"},
+		{loc: exam.Here(), profile: "x-custom", destination: "/out/a.mp4", want: ""},
+	}
+	for _, tt := range tests {
+		e.Run(string(tt.profile)+" "+tt.destination, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.want, tt.profile.OutputVideoCodec(tt.destination))
+		})
+	}
+}
+
+func TestSupportsSkipIfValid(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	for _, profile := range Profiles {
+		e.Run(string(profile), func(e exam.E) {
+			exam.Equal(e, env, profile != ProfileABR, profile.SupportsSkipIfValid())
+			if profile.SupportsSkipIfValid() {
+				exam.Equal(e, env, true, profile.OutputVideoCodec("/out/a.gif") != "")
+			}
+		})
+	}
+	e.Run("plugin", func(e exam.E) {
+		exam.Equal(e, env, false, Profile("x-custom").SupportsSkipIfValid())
+	})
+}
@@ -0,0 +1,239 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// VideoOptions adjusts the profile's video processing for a single job.
+type VideoOptions struct {
+	// Detelecine detects 3:2 pulldown in the source and restores the original film frames.
+	Detelecine bool `json:"detelecine,omitempty"`
+	// Denoise is the denoise filter to apply; empty applies none.
+	Denoise DenoiseFilter `json:"denoise,omitempty"`
+	// DenoiseLevel is the strength of Denoise; empty means DenoiseLevelMedium.
+	DenoiseLevel DenoiseLevel `json:"denoiseLevel,omitempty"`
+	// GrainTune tunes the encoder to retain film grain rather than smear it.  Only
+	// profiles for which Profile.SupportsGrainTune is true accept it.
+	GrainTune bool `json:"grainTune,omitempty"`
+	// PerTitle chooses the CRF from an analysis of the source, within bounds, instead of
+	// the profile's fixed quality.  Only profiles for which Profile.SupportsPerTitle is
+	// true accept it.
+	PerTitle *PerTitleOptions `json:"perTitle,omitempty"`
+}
+
+// DenoiseFilter is a video denoise filter.
+type DenoiseFilter string
+
+const (
+	// DenoiseHQDN3D is the fast spatio-temporal hqdn3d filter.
+	DenoiseHQDN3D DenoiseFilter = "hqdn3d"
+	// DenoiseNLMeans is the slower, detail-preserving non-local means filter.
+	DenoiseNLMeans DenoiseFilter = "nlmeans"
+)
+
+// IsValid reports whether f is a supported denoise filter.
+func (f DenoiseFilter) IsValid() bool {
+	return f == DenoiseHQDN3D || f == DenoiseNLMeans
+}
+
+// DenoiseLevel is the strength of a denoise filter.
+type DenoiseLevel string
+
+const (
+	DenoiseLevelLight  DenoiseLevel = "light"
+	DenoiseLevelMedium DenoiseLevel = "medium"
+	DenoiseLevelStrong DenoiseLevel = "strong"
+)
+
+// IsValid reports whether l is a supported denoise level.
+func (l DenoiseLevel) IsValid() bool {
+	switch l {
+	case DenoiseLevelLight, DenoiseLevelMedium, DenoiseLevelStrong:
+		return true
+	default:
+		return false
+	}
+}
+
+// denoiseFilters are the ffmpeg filters for each denoise filter and level, chosen to
+// match the strength of HandBrake's presets of the same name.
+var denoiseFilters = map[DenoiseFilter]map[DenoiseLevel]string{
+	DenoiseHQDN3D: {
+		DenoiseLevelLight:  "hqdn3d=2:1:2:3",
+		DenoiseLevelMedium: "hqdn3d=3:2:2:3",
+		DenoiseLevelStrong: "hqdn3d=7:7:5:5",
+	},
+	DenoiseNLMeans: {
+		DenoiseLevelLight:  "nlmeans=s=1.5:p=7:r=15",
+		DenoiseLevelMedium: "nlmeans=s=3:p=7:r=15",
+		DenoiseLevelStrong: "nlmeans=s=6:p=7:r=15",
+	},
+}
+
+// denoiseLevel returns the effective denoise level.
+func (v *VideoOptions) denoiseLevel() DenoiseLevel {
+	if v.DenoiseLevel == "" {
+		return DenoiseLevelMedium
+	}
+	return v.DenoiseLevel
+}
+
+// ffmpegFilter returns the filter chain for v applied to the source video after the
+// given pulldown is removed, or "".  v may be nil.
+func (v *VideoOptions) ffmpegFilter(pulldown telecine) string {
+	var filters []string
+	if filter := pulldown.ffmpegFilter(); filter != "" {
+		filters = append(filters, filter)
+	}
+	if v != nil && v.Denoise != "" {
+		filters = append(filters, denoiseFilters[v.Denoise][v.denoiseLevel()])
+	}
+	return strings.Join(filters, ",")
+}
+
+// handbrakeArgs returns the HandBrakeCLI options for v after the given pulldown is
+// removed.  v may be nil.
+func (v *VideoOptions) handbrakeArgs(pulldown telecine) []string {
+	args := pulldown.handbrakeArgs()
+	if v == nil {
+		return args
+	}
+	if v.Denoise != "" {
+		args = append(args, fmt.Sprintf("--%s=%s", v.Denoise, v.denoiseLevel()))
+	}
+	if v.GrainTune {
+		args = append(args, "--encoder-tune", "grain")
+	}
+	return args
+}
+
+// telecine is the kind of 3:2 pulldown found in a source.
+type telecine int
+
+const (
+	telecineNone telecine = iota
+	// telecineSoft sources are progressive film frames flagged for repeated fields, as
+	// on most NTSC film DVDs.  Dropping the repeats only needs the output frame rate.
+	telecineSoft
+	// telecineHard sources have the pulldown baked into interlaced frames, so fields
+	// have to be matched back together and the duplicate frames dropped.
+	telecineHard
+)
+
+// telecineSampleFrames is how many frames are inspected to detect pulldown.
+const telecineSampleFrames = 1000
+
+// telecineThreshold is the share of sampled frames that must carry a repeated field
+// before a source is treated as telecined.  3:2 pulldown repeats a field in two of every
+// five frames, so this leaves room for mixed content and misdetections.
+const telecineThreshold = 0.2
+
+// detelecineFor returns the pulldown to remove for params.
+func detelecineFor(ctx context.Context, params TranscodeParams) (telecine, error) {
+	if params.Video == nil || !params.Video.Detelecine {
+		return telecineNone, nil
+	}
+	return detectTelecine(ctx, params.Sandbox, params.SourcePath)
+}
+
+// detectTelecine samples the start of path for soft and then hard telecine.
+func detectTelecine(ctx context.Context, sandbox *Sandbox, path string) (telecine, error) {
+	cmd := encoderCommand(ctx, sandbox, nil, "ffprobe",
+		"-v", "error",
+		"-select_streams", "v:0",
+		"-read_intervals", "%+#"+strconv.Itoa(telecineSampleFrames),
+		"-show_entries", "frame=repeat_pict",
+		"-of", "csv=p=0",
+		path,
+	)
+	output, err := cmd.Output()
+	if err != nil {
+		return telecineNone, fmt.Errorf("failed to probe frames: %w", err)
+	}
+	if parseSoftTelecine(output) {
+		return telecineSoft, nil
+	}
+
+	cmd = encoderCommand(ctx, sandbox, nil, "ffmpeg",
+		"-i", path,
+		"-map", "0:v:0",
+		"-frames:v", strconv.Itoa(telecineSampleFrames),
+		"-vf", "idet",
+		"-f", "null",
+		"-",
+	)
+	output, err = cmd.CombinedOutput()
+	if err != nil {
+		return telecineNone, fmt.Errorf("failed to detect interlacing: %w: %s", err, output)
+	}
+	hard, err := parseHardTelecine(output)
+	if err != nil {
+		return telecineNone, err
+	}
+	if hard {
+		return telecineHard, nil
+	}
+	return telecineNone, nil
+}
+
+// parseSoftTelecine reports whether enough of the frames in ffprobe's repeat_pict
+// listing repeat a field.
+func parseSoftTelecine(output []byte) bool {
+	var frames, repeated int
+	for _, field := range bytes.Fields(output) {
+		frames++
+		if n, err := strconv.Atoi(strings.TrimSuffix(string(field), ",")); err == nil && n > 0 {
+			repeated++
+		}
+	}
+	return frames > 0 && float64(repeated)/float64(frames) >= telecineThreshold
+}
+
+var repeatedFieldsPattern = regexp.MustCompile(`Repeated Fields: Neither:\s*(\d+)\s*Top:\s*(\d+)\s*Bottom:\s*(\d+)`)
+
+// parseHardTelecine reports whether the idet filter's summary in output shows the
+// repeated fields of hard telecine.
+func parseHardTelecine(output []byte) (bool, error) {
+	match := repeatedFieldsPattern.FindSubmatch(output)
+	if match == nil {
+		return false, fmt.Errorf("idet output has no repeated field summary")
+	}
+	var counts [3]int
+	for i := range counts {
+		n, err := strconv.Atoi(string(match[i+1]))
+		if err != nil {
+			return false, fmt.Errorf("failed to parse idet output: %w", err)
+		}
+		counts[i] = n
+	}
+	total := counts[0] + counts[1] + counts[2]
+	return total > 0 && float64(counts[1]+counts[2])/float64(total) >= telecineThreshold, nil
+}
+
+// ffmpegFilter returns the filter chain that removes the pulldown, or "".
+func (t telecine) ffmpegFilter() string {
+	switch t {
+	case telecineSoft:
+		return "fps=24000/1001"
+	case telecineHard:
+		// fieldmatch rebuilds the film frames, yadif cleans up any it couldn't match, and
+		// decimate drops the duplicate left in every group of five.
+		return "fieldmatch,yadif=deint=interlaced,decimate"
+	default:
+		return ""
+	}
+}
+
+// handbrakeArgs returns the HandBrakeCLI options that remove the pulldown.  HandBrake
+// already honours the repeat flags of soft telecine with variable frame rate output.
+func (t telecine) handbrakeArgs() []string {
+	if t == telecineHard {
+		return []string{"--detelecine"}
+	}
+	return nil
+}
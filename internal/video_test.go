@@ -0,0 +1,124 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestParseSoftTelecine(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc    exam.Loc
+		name   string
+		output string
+		want   bool
+	}{
+		{loc: exam.Here(), name: "Pulldown", output: "0\n1\n0\n1\n0\n1\n0\n1\n", want: true},
+		{loc: exam.Here(), name: "Trailing commas", output: "0,\n1,\n0,\n1,\n", want: true},
+		{loc: exam.Here(), name: "Progressive", output: "0\n0\n0\n0\n0\n0\n0\n0\n0\n1\n", want: false},
+		{loc: exam.Here(), name: "Empty", output: "", want: false},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.want, parseSoftTelecine([]byte(tt.output)))
+		})
+	}
+}
+
+func TestParseHardTelecine(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc     exam.Loc
+		name    string
+		output  string
+		want    bool
+		wantErr bool
+	}{
+		{
+			loc:  exam.Here(),
+			name: "Telecined",
+			output: `[Parsed_idet_0 @ 0x1] Repeated Fields: Neither:   600 Top:   200 Bottom:   200
+[Parsed_idet_0 @ 0x1] Single frame detection: TFF:   700 BFF:     0 Progressive:   300 Undetermined:     0`,
+			want: true,
+		},
+		{
+			loc:    exam.Here(),
+			name:   "Interlaced",
+			output: `[Parsed_idet_0 @ 0x1] Repeated Fields: Neither:   990 Top:     6 Bottom:     4`,
+			want:   false,
+		},
+		{
+			loc:     exam.Here(),
+			name:    "No summary",
+			output:  "Conversion failed!",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			got, err := parseHardTelecine([]byte(tt.output))
+			if tt.wantErr {
+				exam.NotNil(e, env, err)
+				return
+			}
+			exam.Nil(e, env, err).Log(err).Must()
+			exam.Equal(e, env, tt.want, got)
+		})
+	}
+}
+
+func TestVideoOptionsArgs(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc           exam.Loc
+		name          string
+		video         *VideoOptions
+		pulldown      telecine
+		wantFfmpeg    string
+		wantHandbrake []string
+	}{
+		{
+			loc:  exam.Here(),
+			name: "Nil",
+		},
+		{
+			loc:           exam.Here(),
+			name:          "Hard telecine only",
+			pulldown:      telecineHard,
+			wantFfmpeg:    "fieldmatch,yadif=deint=interlaced,decimate",
+			wantHandbrake: []string{"--detelecine"},
+		},
+		{
+			loc:           exam.Here(),
+			name:          "Default level",
+			video:         &VideoOptions{Denoise: DenoiseHQDN3D},
+			wantFfmpeg:    "hqdn3d=3:2:2:3",
+			wantHandbrake: []string{"--hqdn3d=medium"},
+		},
+		{
+			loc:           exam.Here(),
+			name:          "Soft telecine with strong nlmeans and grain",
+			video:         &VideoOptions{Denoise: DenoiseNLMeans, DenoiseLevel: DenoiseLevelStrong, GrainTune: true},
+			pulldown:      telecineSoft,
+			wantFfmpeg:    "fps=24000/1001,nlmeans=s=6:p=7:r=15",
+			wantHandbrake: []string{"--nlmeans=strong", "--encoder-tune", "grain"},
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.wantFfmpeg, tt.video.ffmpegFilter(tt.pulldown))
+			exam.Equal(e, env, tt.wantHandbrake, tt.video.handbrakeArgs(tt.pulldown))
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// vp9Transcoder encodes VP9 video and Opus audio into WebM using two-pass constrained
+// quality rate control: the first pass analyses the source, and the second uses that
+// analysis to spend bits where they are needed.
+type vp9Transcoder struct{}
+
+func init() {
+	RegisterTranscoder(TranscoderBackend{
+		Name:     "vp9",
+		Profiles: []Profile{ProfileWebM},
+		Tools:    []string{ToolFFmpeg, ToolFFprobe},
+		New:      func(Profile) Transcoder { return &vp9Transcoder{} },
+	})
+}
+
+func (t *vp9Transcoder) Transcode(ctx context.Context, params TranscodeParams) error {
+	var totalDuration time.Duration
+	if params.ProgressCallback != nil {
+		var err error
+		totalDuration, err = getDuration(ctx, params.Sandbox, params.SourcePath)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Without a scratch directory the pass log goes next to the output: a sandboxed
+	// encoder gets a private /tmp, where it couldn't see a directory made here.
+	logParent := params.ScratchDir
+	if logParent == "" {
+		logParent = filepath.Dir(params.DestinationPath)
+	}
+	logDir, err := os.MkdirTemp(logParent, ".vt-vp9-")
+	if err != nil {
+		return fmt.Errorf("failed to create pass log directory: %w", err)
+	}
+	defer os.RemoveAll(logDir)
+	passLog := filepath.Join(logDir, "pass")
+
+	videoArgs := []string{
+		"-c:v", "libvpx-vp9",
+		"-crf", "31",
+		"-b:v", "0",
+		"-row-mt", "1",
+		"-deadline", "good",
+		"-passlogfile", passLog,
+	}
+
+	// Each pass covers half of the reported progress.
+	passProgress := func(offset float64) ProgressCallback {
+		if params.ProgressCallback == nil {
+			return nil
+		}
+		return func(progress Progress) {
+			progress.Percent = offset + progress.Percent/2
+			params.ProgressCallback(progress)
+		}
+	}
+
+	// Both passes must see the same frames, so both remove pulldown and burn in the subtitles.
+	forced, err := forcedSubtitleFor(ctx, params)
+	if err != nil {
+		return err
+	}
+	pulldown, err := detelecineFor(ctx, params)
+	if err != nil {
+		return err
+	}
+	streams := ffmpegStreams{
+		sourceFilter:  params.Video.ffmpegFilter(pulldown),
+		burnIn:        forced,
+		subtitles:     externalSubtitles(params),
+		subtitleCodec: "webvtt",
+		selection:     params.Streams,
+	}.args(params.SourcePath, params.Audio)
+
+	firstPass := append([]string{"-i", params.SourcePath}, streams...)
+	firstPass = append(firstPass, videoArgs...)
+	firstPass = append(firstPass,
+		"-pass", "1",
+		"-cpu-used", "4",
+		"-an",
+		"-sn",
+		"-f", "null",
+		"-y",
+		os.DevNull,
+	)
+	if err := runFfmpeg(ctx, params.Sandbox, params.Limits, totalDuration, passProgress(0), params.LogCallback, firstPass...); err != nil {
+		return fmt.Errorf("first pass: %w", err)
+	}
+
+	audioArgs := []string{"-c:a", "libopus", "-b:a", "128k"}
+	if params.Audio != nil {
+		audioArgs = params.Audio.ffmpegArgs(AudioCodecOpus)
+	}
+	secondPass := append([]string{"-i", params.SourcePath}, streams...)
+	secondPass = append(secondPass, videoArgs...)
+	secondPass = append(secondPass, "-pass", "2", "-cpu-used", "2")
+	secondPass = append(secondPass, audioArgs...)
+	secondPass = append(secondPass,
+		"-f", "webm",
+		"-y",
+		params.DestinationPath,
+	)
+	if err := runFfmpeg(ctx, params.Sandbox, params.Limits, totalDuration, passProgress(50), params.LogCallback, secondPass...); err != nil {
+		return fmt.Errorf("second pass: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+)
+
+const (
+	// QueueMaintenance runs housekeeping jobs, so they aren't stuck behind transcodes.
+	QueueMaintenance = "maintenance"
+	// WatchdogInterval is how often the watchdog looks for stalled jobs.
+	WatchdogInterval = time.Minute
+)
+
+// WatchdogJobArgs are the arguments of the periodic job that rescues stalled transcodes.
+type WatchdogJobArgs struct{}
+
+// Kind returns the job kind identifier for River.
+func (WatchdogJobArgs) Kind() string {
+	return "watchdog"
+}
+
+// InsertOpts runs the watchdog on the maintenance queue without retries; the next
+// run a minute later takes over from a failed one.
+func (WatchdogJobArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       QueueMaintenance,
+		MaxAttempts: 1,
+	}
+}
+
+// StalledJob is a running transcode job that the watchdog took back from its worker.
+type StalledJob struct {
+	ID   int64
+	Args TranscodeJobArgs
+	// Final is true if the job had no attempts left and was discarded rather than retried.
+	Final bool
+	// Error is the error recorded for the attempt.
+	Error string
+	// RequestID is the ID of the API request that created the job.
+	RequestID string
+}
+
+// rescueStalledJobsQuery finds running transcode jobs whose worker has stopped sending
+// heartbeats, or whose progress has stood still for longer than the stall timeout
+// ($3, or three of the job's own progress intervals if that is longer; 0 disables the
+// check).  Each is made retryable, or discarded if it has no attempts left, with a
+// STALLED error recorded the way River records attempt errors, and in the job output.
+// Jobs get a grace period after starting so a worker has time to register, and retries
+// are held back for as long again so a live worker notices and stops first.
+const rescueStalledJobsQuery = `
+	WITH stalled AS (
+		SELECT j.id, j.attempt >= j.max_attempts AS final,
+			'STALLED: ' || CASE WHEN w.id IS NULL THEN 'worker stopped sending heartbeats' ELSE 'progress stopped advancing' END AS error
+		FROM river_job j
+		LEFT JOIN workers w ON w.id = j.attempted_by[cardinality(j.attempted_by)] AND w.heartbeat_at > now() - make_interval(secs => $2)
+		WHERE j.state = 'running' AND j.kind = $1
+			AND j.attempted_at < now() - make_interval(secs => $2)
+			AND (w.id IS NULL OR ($3 > 0 AND
+				coalesce((j.metadata->'output'->>'progressAt')::timestamptz, j.attempted_at) <
+				now() - make_interval(secs => greatest($3, 3 * coalesce((j.args->>'heartbeatIntervalSeconds')::float8, 0)))))
+		FOR UPDATE OF j SKIP LOCKED
+	)
+	UPDATE river_job j SET
+		state = CASE WHEN s.final THEN 'discarded' ELSE 'retryable' END::river_job_state,
+		scheduled_at = CASE WHEN s.final THEN j.scheduled_at ELSE now() + make_interval(secs => $2) END,
+		finalized_at = CASE WHEN s.final THEN now() END,
+		errors = array_append(j.errors, jsonb_build_object('at', now(), 'attempt', j.attempt, 'error', s.error, 'trace', '')),
+		metadata = jsonb_set(j.metadata, '{output}',
+			coalesce(j.metadata->'output', '{}') || jsonb_build_object('error', s.error, 'errorCode', $4::text))
+	FROM stalled s
+	WHERE j.id = s.id
+	RETURNING j.id, j.args, j.metadata, s.final, s.error`
+
+// RescueStalledJobs takes stalled transcode jobs back from their workers in tx.  A job
+// is stalled if its worker hasn't sent a heartbeat for WorkerStaleAfter, or if its
+// progress hasn't advanced for stallTimeout; zero disables the progress check.
+func RescueStalledJobs(ctx context.Context, tx pgx.Tx, stallTimeout time.Duration) ([]StalledJob, error) {
+	rows, err := tx.Query(ctx, rescueStalledJobsQuery, TranscodeJobArgs{}.Kind(), WorkerStaleAfter.Seconds(), stallTimeout.Seconds(), string(ErrorCodeStalled))
+	if err != nil {
+		return nil, fmt.Errorf("failed to rescue stalled jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var stalled []StalledJob
+	for rows.Next() {
+		var job StalledJob
+		var args, metadata []byte
+		if err := rows.Scan(&job.ID, &args, &metadata, &job.Final, &job.Error); err != nil {
+			return nil, fmt.Errorf("failed to scan stalled job: %w", err)
+		}
+		if err := json.Unmarshal(args, &job.Args); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal args of stalled job %d: %w", job.ID, err)
+		}
+		job.RequestID = ParseJobMetadata(metadata).RequestID
+		stalled = append(stalled, job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read stalled jobs: %w", err)
+	}
+	return stalled, nil
+}
+
+// JobRescued reports whether the watchdog has taken the given attempt of a job back
+// from its worker, which should then stop working it.
+func JobRescued(ctx context.Context, pool *pgxpool.Pool, jobID int64, attempt int) (bool, error) {
+	var running bool
+	err := pool.QueryRow(ctx, "SELECT state = 'running' AND attempt = $2 FROM river_job WHERE id = $1", jobID, attempt).Scan(&running)
+	if err != nil {
+		return false, fmt.Errorf("failed to check job state: %w", err)
+	}
+	return !running, nil
+}
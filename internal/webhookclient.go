@@ -0,0 +1,159 @@
+package internal
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"syscall"
+	"time"
+)
+
+const (
+	// defaultWebhookTimeout bounds each webhook request, including reading the response.
+	defaultWebhookTimeout = 30 * time.Second
+	// defaultWebhookMaxRedirects matches the redirect limit of Go's default HTTP client.
+	defaultWebhookMaxRedirects = 10
+	// webhookRedirectLookupTimeout bounds resolving the host a webhook is redirected to.
+	webhookRedirectLookupTimeout = 5 * time.Second
+)
+
+// ErrWebhookHostNotAllowed is returned by deliveries to internal addresses when they
+// are blocked.
+var ErrWebhookHostNotAllowed = errors.New("webhook host is an internal address")
+
+// PrivateAddr reports whether addr is an address webhooks must not be sent to when
+// private webhooks are blocked.
+func PrivateAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsUnspecified() || addr.IsMulticast()
+}
+
+// WebhookHTTPConfig configures the HTTP client that delivers webhooks.
+type WebhookHTTPConfig struct {
+	Timeout time.Duration
+	// MaxRedirects is how many redirects a delivery follows.  Zero treats a redirect
+	// response as a failed delivery.
+	MaxRedirects int
+	// CAFile is a PEM bundle of CAs trusted in addition to the system ones.
+	CAFile string
+	// InsecureSkipVerify accepts any server certificate.  Only for lab use.
+	InsecureSkipVerify bool
+	// CertFile and KeyFile are a PEM client certificate and key presented to receivers
+	// that require mutual TLS.
+	CertFile string
+	KeyFile  string
+	// BlockPrivate refuses to connect to the addresses PrivateAddr reports, checking
+	// each connection and redirect, since a host's DNS can change after the server
+	// validated it.  Proxies from the environment aren't used, as only the proxy's
+	// address could be checked.
+	BlockPrivate bool
+}
+
+// NewClient returns an HTTP client with the configured settings.  A nil config gives
+// the defaults.
+func (c *WebhookHTTPConfig) NewClient() (*http.Client, error) {
+	if c == nil {
+		c = &WebhookHTTPConfig{Timeout: defaultWebhookTimeout, MaxRedirects: defaultWebhookMaxRedirects}
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: c.InsecureSkipVerify}
+	if c.CAFile != "" {
+		pem, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read webhook CA file: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("webhook CA file %s contains no certificates", c.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load webhook client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	if c.BlockPrivate {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Control:   controlPrivateAddr,
+		}
+		transport.DialContext = dialer.DialContext
+		transport.Proxy = nil
+	}
+	maxRedirects := c.MaxRedirects
+	blockPrivate := c.BlockPrivate
+	return &http.Client{
+		Timeout:   c.Timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if maxRedirects == 0 {
+				return http.ErrUseLastResponse
+			}
+			if len(via) > maxRedirects {
+				return errors.New("stopped after too many redirects")
+			}
+			if blockPrivate {
+				return checkRedirectTarget(req)
+			}
+			return nil
+		},
+	}, nil
+}
+
+// controlPrivateAddr refuses connections to private addresses.  It runs once the host
+// has been resolved, so it sees the address actually connected to.
+func controlPrivateAddr(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("unexpected webhook address %q: %w", address, err)
+	}
+	if PrivateAddr(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrWebhookHostNotAllowed, addrPort.Addr())
+	}
+	return nil
+}
+
+// checkRedirectTarget checks a redirect the way the server checks webhook URIs, so
+// a redirect to another scheme or to a host that only resolves to private addresses
+// fails with a clear error.  The connection itself is still checked when dialing.
+func checkRedirectTarget(req *http.Request) error {
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return fmt.Errorf("webhook redirected to %q, which doesn't use http or https", req.URL)
+	}
+	host := req.URL.Hostname()
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if PrivateAddr(addr) {
+			return fmt.Errorf("%w: %s", ErrWebhookHostNotAllowed, host)
+		}
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), webhookRedirectLookupTimeout)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("failed to resolve webhook redirect host %q: %w", host, err)
+	}
+	for _, addr := range addrs {
+		if PrivateAddr(addr) {
+			return fmt.Errorf("%w: %s resolves to %s", ErrWebhookHostNotAllowed, host, addr)
+		}
+	}
+	return nil
+}
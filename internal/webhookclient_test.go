@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestWebhookHTTPConfigNewClient(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok", http.StatusFound)
+	})
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		loc        exam.Loc
+		name       string
+		cfg        *WebhookHTTPConfig
+		wantStatus int
+	}{
+		{loc: exam.Here(), name: "defaults follow redirects", cfg: nil, wantStatus: http.StatusNoContent},
+		{loc: exam.Here(), name: "redirects allowed", cfg: &WebhookHTTPConfig{Timeout: time.Second, MaxRedirects: 1}, wantStatus: http.StatusNoContent},
+		{loc: exam.Here(), name: "redirects not followed", cfg: &WebhookHTTPConfig{Timeout: time.Second}, wantStatus: http.StatusFound},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			client, err := tt.cfg.NewClient()
+			exam.Nil(e, env, err).Log(err).Must()
+			resp, err := client.Post(server.URL+"/redirect", "application/json", nil)
+			exam.Nil(e, env, err).Log(err).Must()
+			resp.Body.Close()
+			exam.Equal(e, env, tt.wantStatus, resp.StatusCode)
+		})
+	}
+
+	e.Run("private addresses blocked", func(e exam.E) {
+		cfg := &WebhookHTTPConfig{Timeout: time.Second, MaxRedirects: 1, BlockPrivate: true}
+		client, err := cfg.NewClient()
+		exam.Nil(e, env, err).Log(err).Must()
+		_, err = client.Post(server.URL+"/ok", "application/json", nil)
+		exam.Equal(e, env, true, errors.Is(err, ErrWebhookHostNotAllowed)).Log(err)
+	})
+
+	e.Run("missing CA file", func(e exam.E) {
+		cfg := &WebhookHTTPConfig{Timeout: time.Second, CAFile: filepath.Join(t.TempDir(), "ca.pem")}
+		_, err := cfg.NewClient()
+		exam.NotNil(e, env, err)
+	})
+}
@@ -0,0 +1,130 @@
+package internal
+
+import (
+	"encoding/base64"
+	"net/http"
+	"slices"
+)
+
+// WebhookEvent is a kind of transcode job event that a webhook can subscribe to.
+type WebhookEvent string
+
+const (
+	WebhookEventCompleted WebhookEvent = "completed"
+	WebhookEventFailed    WebhookEvent = "failed"
+	WebhookEventHeartbeat WebhookEvent = "heartbeat"
+)
+
+// defaultWebhookEvents are the events delivered to a webhook target that doesn't list any.
+var defaultWebhookEvents = []WebhookEvent{WebhookEventCompleted, WebhookEventFailed}
+
+// IsValid reports whether e is a known webhook event.
+func (e WebhookEvent) IsValid() bool {
+	switch e {
+	case WebhookEventCompleted, WebhookEventFailed, WebhookEventHeartbeat:
+		return true
+	default:
+		return false
+	}
+}
+
+// WebhookTarget is a webhook destination for a transcode job.
+type WebhookTarget struct {
+	URI   string `json:"uri"`
+	Token []byte `json:"token,omitempty"`
+	// SealedToken holds Token instead, encrypted, when secrets keys are configured.
+	SealedToken *SealedSecret `json:"sealedToken,omitempty"`
+	// TokenHeader sends Token in this header instead of the payload.
+	TokenHeader string `json:"tokenHeader,omitempty"`
+	// Events filters which events are delivered; empty means completed and failed.
+	Events []WebhookEvent `json:"events,omitempty"`
+}
+
+// wants reports whether the target subscribes to event.
+func (t WebhookTarget) wants(event WebhookEvent) bool {
+	if len(t.Events) == 0 {
+		return slices.Contains(defaultWebhookEvents, event)
+	}
+	return slices.Contains(t.Events, event)
+}
+
+// webhookTargets returns every webhook destination of the job subscribed to event,
+// including the single webhookUri and heartbeatWebhookUri fields.
+func (args TranscodeJobArgs) webhookTargets(event WebhookEvent) []WebhookTarget {
+	var targets []WebhookTarget
+	switch event {
+	case WebhookEventHeartbeat:
+		if args.HeartbeatWebhookURI != nil {
+			targets = append(targets, WebhookTarget{URI: *args.HeartbeatWebhookURI, Token: args.WebhookToken, SealedToken: args.SealedWebhookToken, TokenHeader: args.WebhookTokenHeader})
+		}
+	default:
+		if args.WebhookURI != nil {
+			targets = append(targets, WebhookTarget{URI: *args.WebhookURI, Token: args.WebhookToken, SealedToken: args.SealedWebhookToken, TokenHeader: args.WebhookTokenHeader})
+		}
+	}
+	for _, target := range args.Webhooks {
+		if target.wants(event) {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+// HasHeartbeatWebhooks reports whether any webhook destination of the job wants heartbeats.
+func (args TranscodeJobArgs) HasHeartbeatWebhooks() bool {
+	return len(args.webhookTargets(WebhookEventHeartbeat)) > 0
+}
+
+// CompletionWebhooks builds the webhooks to send for a transcode job that finished with
+// the given status: the completed or failed event, depending on whether status has an error.
+func CompletionWebhooks(args TranscodeJobArgs, status *TranscodeJobStatus, requestID string) []WebhookJobArgs {
+	event := WebhookEventCompleted
+	if status.Error != nil {
+		event = WebhookEventFailed
+	}
+	var webhooks []WebhookJobArgs
+	for _, target := range args.webhookTargets(event) {
+		webhooks = append(webhooks, WebhookJobArgs{
+			URI:             target.URI,
+			Token:           target.Token,
+			SealedToken:     target.SealedToken,
+			TokenHeader:     target.TokenHeader,
+			UUID:            args.UUID,
+			Status:          status,
+			Labels:          args.Labels,
+			RequestID:       requestID,
+			DestinationPath: args.DestinationPath,
+			Profile:         args.Profile,
+		})
+	}
+	return webhooks
+}
+
+// HeartbeatWebhooks builds the heartbeat webhooks to send for a running transcode job.
+func HeartbeatWebhooks(args TranscodeJobArgs, status *TranscodeJobStatus, requestID string) []WebhookJobArgs {
+	var webhooks []WebhookJobArgs
+	for _, target := range args.webhookTargets(WebhookEventHeartbeat) {
+		webhooks = append(webhooks, WebhookJobArgs{
+			URI:         target.URI,
+			Token:       target.Token,
+			SealedToken: target.SealedToken,
+			TokenHeader: target.TokenHeader,
+			UUID:        args.UUID,
+			Status:      status,
+			IsHeartbeat: true,
+			Labels:      args.Labels,
+			RequestID:   requestID,
+		})
+	}
+	return webhooks
+}
+
+// WebhookTokenHeaderValue formats token for sending in the given header: base64, after
+// "Bearer " in an Authorization header.
+func WebhookTokenHeaderValue(header string, token []byte) string {
+	value := base64.StdEncoding.EncodeToString(token)
+	if http.CanonicalHeaderKey(header) == "Authorization" {
+		return "Bearer " + value
+	}
+	return value
+}
@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+)
+
+func TestCompletionWebhooks(t *testing.T) {
+	legacyURI := "http://legacy/webhook"
+	args := TranscodeJobArgs{
+		WebhookURI:   &legacyURI,
+		WebhookToken: []byte("legacy"),
+		Webhooks: []WebhookTarget{
+			{URI: "http://default"},
+			{URI: "http://failures", Events: []WebhookEvent{WebhookEventFailed}},
+			{URI: "http://heartbeats", Events: []WebhookEvent{WebhookEventHeartbeat}},
+		},
+	}
+	errMsg := "boom"
+
+	tests := []struct {
+		name   string
+		status *TranscodeJobStatus
+		want   []string
+	}{
+		{
+			name:   "completed",
+			status: &TranscodeJobStatus{Progress: 100},
+			want:   []string{"http://legacy/webhook", "http://default"},
+		},
+		{
+			name:   "failed",
+			status: &TranscodeJobStatus{Error: &errMsg},
+			want:   []string{"http://legacy/webhook", "http://default", "http://failures"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			env := deep.NewEnv()
+			var got []string
+			for _, webhook := range CompletionWebhooks(args, tt.status, "") {
+				got = append(got, webhook.URI)
+			}
+			exam.Equal(e, env, tt.want, got)
+		})
+	}
+
+	t.Run("heartbeats", func(t *testing.T) {
+		e := exam.New(t)
+		env := deep.NewEnv()
+		exam.Equal(e, env, true, args.HasHeartbeatWebhooks())
+		exam.Equal(e, env, 1, len(HeartbeatWebhooks(args, &TranscodeJobStatus{}, "")))
+	})
+}
+
+func TestWebhookTokenHeaderValue(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	exam.Equal(e, env, "Bearer c2VjcmV0", WebhookTokenHeaderValue("authorization", []byte("secret")))
+	exam.Equal(e, env, "c2VjcmV0", WebhookTokenHeaderValue("X-Webhook-Token", []byte("secret")))
+}
@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// WorkerHeartbeatInterval is how often a worker refreshes its row in the workers table.
+	WorkerHeartbeatInterval = 15 * time.Second
+	// WorkerStaleAfter is how long after its last heartbeat a worker is no longer listed.
+	WorkerStaleAfter = 4 * WorkerHeartbeatInterval
+	// workerRetention is how long the rows of workers that died without deregistering
+	// are kept before the next worker to start removes them.
+	workerRetention = 24 * time.Hour
+)
+
+// RegisterWorker records a worker with the given ID, encoders, and webhook signing key,
+// if any, in the workers table, and removes workers that stopped sending heartbeats long
+// ago.
+func RegisterWorker(ctx context.Context, pool *pgxpool.Pool, id string, encoders []EncoderCheck, webhookKey ed25519.PublicKey) error {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("failed to get hostname: %w", err)
+	}
+	capabilities, toolVersions, err := workerTools(encoders)
+	if err != nil {
+		return err
+	}
+	_, err = pool.Exec(ctx, `
+		INSERT INTO workers (id, hostname, capabilities, tool_versions, webhook_public_key) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (id) DO UPDATE SET hostname = $2, capabilities = $3, tool_versions = $4, webhook_public_key = $5, started_at = now(), heartbeat_at = now()`,
+		id, hostname, capabilities, toolVersions, []byte(webhookKey))
+	if err != nil {
+		return fmt.Errorf("failed to register worker: %w", err)
+	}
+	if _, err := pool.Exec(ctx, "DELETE FROM workers WHERE heartbeat_at < now() - make_interval(secs => $1)", workerRetention.Seconds()); err != nil {
+		return fmt.Errorf("failed to remove old workers: %w", err)
+	}
+	return nil
+}
+
+// UpdateWorkerEncoders replaces the encoders recorded for the worker with the given ID,
+// once it has reloaded its plugins.
+func UpdateWorkerEncoders(ctx context.Context, pool *pgxpool.Pool, id string, encoders []EncoderCheck) error {
+	capabilities, toolVersions, err := workerTools(encoders)
+	if err != nil {
+		return err
+	}
+	if _, err := pool.Exec(ctx, "UPDATE workers SET capabilities = $2, tool_versions = $3 WHERE id = $1", id, capabilities, toolVersions); err != nil {
+		return fmt.Errorf("failed to update worker encoders: %w", err)
+	}
+	return nil
+}
+
+// workerTools encodes the capabilities and tool versions columns of a worker with
+// encoders.
+func workerTools(encoders []EncoderCheck) (capabilities, toolVersions []byte, err error) {
+	if capabilities, err = json.Marshal(AvailableTools(encoders)); err != nil {
+		return nil, nil, fmt.Errorf("failed to encode worker capabilities: %w", err)
+	}
+	if toolVersions, err = json.Marshal(ToolVersions(encoders)); err != nil {
+		return nil, nil, fmt.Errorf("failed to encode worker tool versions: %w", err)
+	}
+	return capabilities, toolVersions, nil
+}
+
+// RunWorkerHeartbeat refreshes the worker's heartbeat every WorkerHeartbeatInterval
+// until ctx is done.  Failures are logged; the next heartbeat tries again.
+func RunWorkerHeartbeat(ctx context.Context, pool *pgxpool.Pool, id string) {
+	ticker := time.NewTicker(WorkerHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := pool.Exec(ctx, "UPDATE workers SET heartbeat_at = now() WHERE id = $1", id); err != nil {
+				slog.Warn("failed to send worker heartbeat", "error", err)
+			}
+		}
+	}
+}
+
+// DeregisterWorker removes a worker from the workers table.
+func DeregisterWorker(ctx context.Context, pool *pgxpool.Pool, id string) error {
+	if _, err := pool.Exec(ctx, "DELETE FROM workers WHERE id = $1", id); err != nil {
+		return fmt.Errorf("failed to deregister worker: %w", err)
+	}
+	return nil
+}
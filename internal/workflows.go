@@ -0,0 +1,279 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// MaxWorkflowSteps bounds the number of steps in a workflow.
+const MaxWorkflowSteps = 32
+
+// minVerifyTolerance is the smallest duration difference a verify step accepts; encoders
+// routinely drop or pad a few frames at either end.
+const minVerifyTolerance = time.Second
+
+// DurationsMatch reports whether an output's duration is close enough to its source's
+// for a verify step to pass: within a second, or 1% of the source for long sources.
+func DurationsMatch(source, output time.Duration) bool {
+	tolerance := max(minVerifyTolerance, source/100)
+	return (output - source).Abs() <= tolerance
+}
+
+// WorkflowStepType is the kind of work a workflow step does.
+type WorkflowStepType string
+
+const (
+	// WorkflowStepProbe checks that a file exists and can be decoded.
+	WorkflowStepProbe WorkflowStepType = "probe"
+	// WorkflowStepTranscode runs a transcode job, which also covers thumbnails and previews.
+	WorkflowStepTranscode WorkflowStepType = "transcode"
+	// WorkflowStepVerify checks the output of a transcode step against its source.
+	WorkflowStepVerify WorkflowStepType = "verify"
+	// WorkflowStepWebhook notifies a URI that the steps it depends on have completed.
+	WorkflowStepWebhook WorkflowStepType = "webhook"
+)
+
+// IsValid reports whether t is a supported step type.
+func (t WorkflowStepType) IsValid() bool {
+	switch t {
+	case WorkflowStepProbe, WorkflowStepTranscode, WorkflowStepVerify, WorkflowStepWebhook:
+		return true
+	default:
+		return false
+	}
+}
+
+// WorkflowRef places a River job in a workflow.
+type WorkflowRef struct {
+	// ID identifies the workflow.
+	ID uuid.UUID `json:"id"`
+	// Step is the name of the job's step, unique within the workflow.
+	Step string `json:"step"`
+	// DependsOn are the steps that must complete before this one becomes available.
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// WorkflowStepJobArgs are the arguments of the workflow steps that aren't transcodes.
+type WorkflowStepJobArgs struct {
+	Workflow WorkflowRef      `json:"workflow"`
+	Type     WorkflowStepType `json:"type"`
+	// Path is the file checked by probe and verify steps.
+	Path string `json:"path,omitempty"`
+	// SourcePath is the source a verify step compares durations against, or "" to only
+	// check that Path decodes.
+	SourcePath string `json:"sourcePath,omitempty"`
+	// URI and Token are the destination and token of a webhook step.
+	URI   string `json:"uri,omitempty"`
+	Token []byte `json:"token,omitempty"`
+	// SealedToken holds Token instead, encrypted, when secrets keys are configured.
+	SealedToken *SealedSecret `json:"sealedToken,omitempty"`
+	// Tenant is the tenant that submitted the workflow, if the server has tenants.
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// Kind returns the job kind identifier for River.
+func (WorkflowStepJobArgs) Kind() string {
+	return "workflow_step"
+}
+
+// WorkflowStepStatus is recorded as the output of a workflow step job.
+type WorkflowStepStatus struct {
+	// DurationSeconds and SizeBytes describe the file checked by a probe or verify step.
+	DurationSeconds *float64 `json:"durationSeconds,omitempty"`
+	SizeBytes       *int64   `json:"sizeBytes,omitempty"`
+	// Error contains an error message if the step failed.
+	Error *string `json:"error,omitempty"`
+	// ErrorCode contains a machine-readable failure code if the step failed.
+	ErrorCode *ErrorCode `json:"errorCode,omitempty"`
+}
+
+// WorkflowJobKinds are the River job kinds that make up workflows.
+var WorkflowJobKinds = []string{TranscodeJobArgs{}.Kind(), WorkflowStepJobArgs{}.Kind()}
+
+// WorkflowJob is one River job of a workflow.
+type WorkflowJob struct {
+	Ref WorkflowRef
+	Row *rivertype.JobRow
+}
+
+// Succeeded reports whether the job completed without recording an error.  A
+// transcode that fails with webhooks is completed so the webhooks are enqueued
+// atomically, so the state alone isn't enough.
+func (j WorkflowJob) Succeeded() bool {
+	if j.Row.State != rivertype.JobStateCompleted {
+		return false
+	}
+	var output struct {
+		Error *string `json:"error"`
+	}
+	if err := json.Unmarshal(j.Row.Output(), &output); err != nil {
+		return true
+	}
+	return output.Error == nil
+}
+
+// WorkflowListParams returns the River list parameters that find every job of the
+// workflow with the given ID.
+func WorkflowListParams(id uuid.UUID) *river.JobListParams {
+	return river.NewJobListParams().
+		Kinds(WorkflowJobKinds...).
+		States(rivertype.JobStates()...).
+		Where("args->'workflow'->>'id' = @workflow", river.NamedArgs{"workflow": id.String()}).
+		OrderBy(river.JobListOrderByID, river.SortOrderAsc).
+		First(MaxWorkflowSteps)
+}
+
+// WorkflowJobs returns the jobs in result, in step order, with their workflow refs.
+func WorkflowJobs(result *river.JobListResult) ([]WorkflowJob, error) {
+	jobs := make([]WorkflowJob, 0, len(result.Jobs))
+	for _, row := range result.Jobs {
+		var args struct {
+			Workflow *WorkflowRef `json:"workflow"`
+		}
+		if err := json.Unmarshal(row.EncodedArgs, &args); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal workflow job args: %w", err)
+		}
+		if args.Workflow == nil {
+			continue
+		}
+		jobs = append(jobs, WorkflowJob{Ref: *args.Workflow, Row: row})
+	}
+	return jobs, nil
+}
+
+// LockWorkflow takes a transaction-scoped lock on the workflow with the given ID, so
+// steps finishing concurrently see each other's results.
+func LockWorkflow(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtextextended($1, 0))", id.String()); err != nil {
+		return fmt.Errorf("failed to lock workflow: %w", err)
+	}
+	return nil
+}
+
+// AdvanceWorkflow updates the rest of a workflow after the step ref has finished,
+// within tx.  The step's own job must already be finalized in tx.  When the step
+// succeeded, pending steps whose dependencies have all succeeded are made available.
+// When it failed for good, the steps depending on it, directly or not, are cancelled.
+func AdvanceWorkflow(ctx context.Context, tx pgx.Tx, client *river.Client[pgx.Tx], ref WorkflowRef, succeeded bool) error {
+	if err := LockWorkflow(ctx, tx, ref.ID); err != nil {
+		return err
+	}
+	result, err := client.JobListTx(ctx, tx, WorkflowListParams(ref.ID))
+	if err != nil {
+		return fmt.Errorf("failed to list workflow jobs: %w", err)
+	}
+	jobs, err := WorkflowJobs(result)
+	if err != nil {
+		return err
+	}
+
+	if succeeded {
+		for _, id := range readyWorkflowSteps(jobs) {
+			if _, err := client.JobRetryTx(ctx, tx, id); err != nil {
+				return fmt.Errorf("failed to start workflow step: %w", err)
+			}
+		}
+		return nil
+	}
+	for _, id := range dependentWorkflowSteps(jobs, ref.Step) {
+		if _, err := client.JobCancelTx(ctx, tx, id); err != nil {
+			return fmt.Errorf("failed to cancel workflow step: %w", err)
+		}
+	}
+	return nil
+}
+
+// readyWorkflowSteps returns the IDs of the pending jobs whose dependencies have all
+// succeeded.
+func readyWorkflowSteps(jobs []WorkflowJob) []int64 {
+	succeeded := make(map[string]bool, len(jobs))
+	for _, job := range jobs {
+		succeeded[job.Ref.Step] = job.Succeeded()
+	}
+	var ready []int64
+	for _, job := range jobs {
+		if job.Row.State != rivertype.JobStatePending {
+			continue
+		}
+		if !slices.ContainsFunc(job.Ref.DependsOn, func(dep string) bool { return !succeeded[dep] }) {
+			ready = append(ready, job.Row.ID)
+		}
+	}
+	return ready
+}
+
+// dependentWorkflowSteps returns the IDs of the pending jobs that depend on step,
+// directly or through other steps.
+func dependentWorkflowSteps(jobs []WorkflowJob, step string) []int64 {
+	blocked := map[string]bool{step: true}
+	for changed := true; changed; {
+		changed = false
+		for _, job := range jobs {
+			if !blocked[job.Ref.Step] && slices.ContainsFunc(job.Ref.DependsOn, func(dep string) bool { return blocked[dep] }) {
+				blocked[job.Ref.Step] = true
+				changed = true
+			}
+		}
+	}
+	var dependents []int64
+	for _, job := range jobs {
+		if job.Ref.Step != step && blocked[job.Ref.Step] && job.Row.State == rivertype.JobStatePending {
+			dependents = append(dependents, job.Row.ID)
+		}
+	}
+	return dependents
+}
+
+// SortWorkflowSteps returns steps ordered so that each follows the steps it depends
+// on, keeping the given order where the dependencies allow.  It fails if a step name
+// is repeated, a dependency names a step that doesn't exist, or the dependencies form
+// a cycle.
+func SortWorkflowSteps(steps []WorkflowRef) ([]WorkflowRef, error) {
+	names := make(map[string]bool, len(steps))
+	for _, step := range steps {
+		if names[step.Step] {
+			return nil, fmt.Errorf("step %q is defined more than once", step.Step)
+		}
+		names[step.Step] = true
+	}
+	for _, step := range steps {
+		for _, dep := range step.DependsOn {
+			if !names[dep] {
+				return nil, fmt.Errorf("step %q depends on unknown step %q", step.Step, dep)
+			}
+		}
+	}
+
+	sorted := make([]WorkflowRef, 0, len(steps))
+	placed := make(map[string]bool, len(steps))
+	for len(sorted) < len(steps) {
+		progressed := false
+		for _, step := range steps {
+			if placed[step.Step] || slices.ContainsFunc(step.DependsOn, func(dep string) bool { return !placed[dep] }) {
+				continue
+			}
+			sorted = append(sorted, step)
+			placed[step.Step] = true
+			progressed = true
+		}
+		if !progressed {
+			var blocked []string
+			for _, step := range steps {
+				if !placed[step.Step] {
+					blocked = append(blocked, step.Step)
+				}
+			}
+			return nil, fmt.Errorf("steps %s are in or depend on a dependency cycle", strings.Join(blocked, ", "))
+		}
+	}
+	return sorted, nil
+}
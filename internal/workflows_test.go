@@ -0,0 +1,157 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+	"github.com/riverqueue/river/rivertype"
+)
+
+func TestSortWorkflowSteps(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	step := func(name string, deps ...string) WorkflowRef {
+		return WorkflowRef{Step: name, DependsOn: deps}
+	}
+	tests := []struct {
+		loc     exam.Loc
+		name    string
+		steps   []WorkflowRef
+		want    []string
+		wantErr bool
+	}{
+		{
+			loc:   exam.Here(),
+			name:  "Already sorted",
+			steps: []WorkflowRef{step("probe"), step("transcode", "probe"), step("verify", "transcode")},
+			want:  []string{"probe", "transcode", "verify"},
+		},
+		{
+			loc:   exam.Here(),
+			name:  "Dependency listed later",
+			steps: []WorkflowRef{step("notify", "verify", "thumbnail"), step("verify", "transcode"), step("transcode"), step("thumbnail")},
+			want:  []string{"transcode", "thumbnail", "verify", "notify"},
+		},
+		{loc: exam.Here(), name: "Unknown dependency", steps: []WorkflowRef{step("transcode", "probe")}, wantErr: true},
+		{loc: exam.Here(), name: "Duplicate name", steps: []WorkflowRef{step("probe"), step("probe")}, wantErr: true},
+		{loc: exam.Here(), name: "Self dependency", steps: []WorkflowRef{step("probe", "probe")}, wantErr: true},
+		{
+			loc:     exam.Here(),
+			name:    "Cycle",
+			steps:   []WorkflowRef{step("probe"), step("a", "probe", "b"), step("b", "a")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			sorted, err := SortWorkflowSteps(tt.steps)
+			if tt.wantErr {
+				exam.NotNil(e, env, err)
+				return
+			}
+			exam.Nil(e, env, err).Log(err).Must()
+			var got []string
+			for _, ref := range sorted {
+				got = append(got, ref.Step)
+			}
+			exam.Equal(e, env, tt.want, got)
+		})
+	}
+}
+
+func TestAdvanceWorkflowSteps(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	job := func(id int64, state rivertype.JobState, output string, name string, deps ...string) WorkflowJob {
+		row := &rivertype.JobRow{ID: id, State: state}
+		if output != "" {
+			row.Metadata = []byte(`{"output":` + output + `}`)
+		}
+		return WorkflowJob{Ref: WorkflowRef{Step: name, DependsOn: deps}, Row: row}
+	}
+	const failedOutput = `{"progress":10,"error":"boom"}`
+	const succeededOutput = `{"progress":100}`
+
+	tests := []struct {
+		loc        exam.Loc
+		name       string
+		jobs       []WorkflowJob
+		step       string
+		wantReady  []int64
+		wantCancel []int64
+	}{
+		{
+			loc:  exam.Here(),
+			name: "Fan out after probe",
+			jobs: []WorkflowJob{
+				job(1, rivertype.JobStateCompleted, succeededOutput, "probe"),
+				job(2, rivertype.JobStatePending, "", "transcode", "probe"),
+				job(3, rivertype.JobStatePending, "", "thumbnail", "probe"),
+				job(4, rivertype.JobStatePending, "", "notify", "transcode", "thumbnail"),
+			},
+			step:       "probe",
+			wantReady:  []int64{2, 3},
+			wantCancel: []int64{2, 3, 4},
+		},
+		{
+			loc:  exam.Here(),
+			name: "Join waits for every dependency",
+			jobs: []WorkflowJob{
+				job(1, rivertype.JobStateCompleted, succeededOutput, "transcode"),
+				job(2, rivertype.JobStateRunning, "", "thumbnail"),
+				job(3, rivertype.JobStatePending, "", "notify", "transcode", "thumbnail"),
+			},
+			step:       "transcode",
+			wantCancel: []int64{3},
+		},
+		{
+			loc:  exam.Here(),
+			name: "Completed with an error is not success",
+			jobs: []WorkflowJob{
+				job(1, rivertype.JobStateCompleted, failedOutput, "transcode"),
+				job(2, rivertype.JobStatePending, "", "verify", "transcode"),
+				job(3, rivertype.JobStatePending, "", "notify", "verify"),
+				job(4, rivertype.JobStateAvailable, "", "thumbnail"),
+			},
+			step:       "transcode",
+			wantCancel: []int64{2, 3},
+		},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.wantReady, readyWorkflowSteps(tt.jobs))
+			exam.Equal(e, env, tt.wantCancel, dependentWorkflowSteps(tt.jobs, tt.step))
+		})
+	}
+}
+
+func TestDurationsMatch(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	tests := []struct {
+		loc    exam.Loc
+		name   string
+		source time.Duration
+		output time.Duration
+		want   bool
+	}{
+		{loc: exam.Here(), name: "Equal", source: time.Minute, output: time.Minute, want: true},
+		{loc: exam.Here(), name: "Within a second", source: time.Minute, output: time.Minute - 900*time.Millisecond, want: true},
+		{loc: exam.Here(), name: "Short output", source: time.Minute, output: 50 * time.Second, want: false},
+		{loc: exam.Here(), name: "Within 1% of a long source", source: 2 * time.Hour, output: 2*time.Hour + time.Minute, want: true},
+		{loc: exam.Here(), name: "Beyond 1% of a long source", source: 2 * time.Hour, output: 2*time.Hour + 2*time.Minute, want: false},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			exam.Equal(e, env, tt.want, DurationsMatch(tt.source, tt.output))
+		})
+	}
+}
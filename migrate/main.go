@@ -0,0 +1,102 @@
+// Command migrate applies or rolls back the database schema independently of the
+// server and worker.
+//
+// Usage:
+//
+//	migrate up          apply all pending River and application migrations
+//	migrate down [N]    roll back the last N application migrations (default 1)
+//	migrate to N        migrate the application schema to version N
+//	migrate version     print the applied River and application versions
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/krelinga/video-transcoder/internal"
+)
+
+var errUsage = errors.New("usage: migrate up | down [N] | to N | version")
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		log.Fatalf("migrate error: %v", err)
+	}
+}
+
+func run(args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	// Create context that listens for shutdown signals
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Load configuration
+	cfg := internal.NewMigrateConfigFromEnv()
+
+	// Create database pool
+	pool, err := internal.NewDBPool(ctx, cfg.Database)
+	if err != nil {
+		return fmt.Errorf("failed to create database pool: %w", err)
+	}
+	defer pool.Close()
+
+	switch command, rest := args[0], args[1:]; command {
+	case "up":
+		if len(rest) != 0 {
+			return errUsage
+		}
+		if err := internal.MigrateUp(ctx, pool); err != nil {
+			return err
+		}
+	case "down":
+		steps := 1
+		if len(rest) > 1 {
+			return errUsage
+		} else if len(rest) == 1 {
+			steps, err = strconv.Atoi(rest[0])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("%w: N must be a positive integer", errUsage)
+			}
+		}
+		if err := internal.MigrateSteps(ctx, pool, -steps); err != nil {
+			return err
+		}
+	case "to":
+		if len(rest) != 1 {
+			return errUsage
+		}
+		version, err := strconv.ParseUint(rest[0], 10, 0)
+		if err != nil || version < 1 {
+			return fmt.Errorf("%w: N must be a positive integer", errUsage)
+		}
+		if err := internal.MigrateTo(ctx, pool, uint(version)); err != nil {
+			return err
+		}
+	case "version":
+		if len(rest) != 0 {
+			return errUsage
+		}
+	default:
+		return errUsage
+	}
+
+	status, err := internal.MigrationVersion(ctx, pool)
+	if err != nil {
+		return err
+	}
+	dirty := ""
+	if status.Dirty {
+		dirty = " (dirty)"
+	}
+	fmt.Printf("river: %d\napp: %d%s\n", status.River, status.App, dirty)
+	return nil
+}
@@ -4,15 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"os/signal"
 	"syscall"
-	"time"
 
 	"github.com/krelinga/video-transcoder/internal"
-	"github.com/krelinga/video-transcoder/vtrest"
-	"github.com/riverqueue/river"
-	"github.com/riverqueue/river/riverdriver/riverpgxv5"
+	"github.com/krelinga/video-transcoder/vtserver"
 )
 
 func main() {
@@ -28,6 +24,7 @@ func run() error {
 
 	// Load configuration
 	cfg := internal.NewServerConfigFromEnv()
+	internal.SetupLogging(cfg.LogLevel)
 
 	// Create database pool
 	pool, err := internal.NewDBPool(ctx, cfg.Database)
@@ -36,60 +33,10 @@ func run() error {
 	}
 	defer pool.Close()
 
-	// Run migrations
-	log.Println("Running database migrations...")
-	if err := internal.MigrateUp(ctx, pool); err != nil {
-		return fmt.Errorf("failed to run migrations: %w", err)
+	// Run migrations, or make sure someone else already has
+	if err := internal.SetUpSchema(ctx, pool, cfg.AutoMigrate); err != nil {
+		return err
 	}
-	log.Println("Migrations complete")
 
-	// Create River client (insert-only, no workers)
-	riverClient, err := river.NewClient(riverpgxv5.New(pool), &river.Config{
-		// No workers needed for the server - it only inserts jobs
-		Workers: nil,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to create river client: %w", err)
-	}
-
-	// Create server and wire up HTTP handlers
-	server := NewServer(pool, riverClient)
-	strictHandler := vtrest.NewStrictHandler(server, nil)
-	httpHandler := vtrest.Handler(strictHandler)
-
-	// Configure HTTP server
-	httpServer := &http.Server{
-		Addr:    fmt.Sprintf(":%d", cfg.Port),
-		Handler: httpHandler,
-	}
-
-	// Start HTTP server in goroutine
-	serverErr := make(chan error, 1)
-	go func() {
-		log.Printf("Starting HTTP server on port %d", cfg.Port)
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			serverErr <- err
-		}
-		close(serverErr)
-	}()
-
-	// Wait for shutdown signal or server error
-	select {
-	case err := <-serverErr:
-		return fmt.Errorf("HTTP server error: %w", err)
-	case <-ctx.Done():
-		log.Println("Shutdown signal received, shutting down gracefully...")
-	}
-
-	// Create shutdown context with timeout
-	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	// Shutdown HTTP server
-	if err := httpServer.Shutdown(shutdownCtx); err != nil {
-		return fmt.Errorf("HTTP server shutdown error: %w", err)
-	}
-
-	log.Println("Server shutdown complete")
-	return nil
+	return vtserver.Run(ctx, pool, cfg)
 }
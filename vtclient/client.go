@@ -0,0 +1,276 @@
+// Package vtclient is the supported Go client for the video transcoder API.
+//
+// It wraps the generated vtrest client with higher-level helpers for submitting
+// jobs, waiting for them to finish, and verifying webhook deliveries.
+package vtclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// ErrJobFailed is returned by Wait and SubmitAndWait when the job finished in the failed state.
+var ErrJobFailed = errors.New("transcode job failed")
+
+const (
+	defaultMinPollInterval = time.Second
+	defaultMaxPollInterval = 30 * time.Second
+	pollBackoffFactor      = 1.5
+)
+
+// APIError is returned when the server responds with an error status.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	// Errors lists every problem found with the request body, for validation failures.
+	Errors []vtrest.FieldError
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("video transcoder API returned status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("video transcoder API returned status %d: %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// Client is a client for the video transcoder API.
+type Client struct {
+	rest            vtrest.ClientWithResponsesInterface
+	minPollInterval time.Duration
+	maxPollInterval time.Duration
+}
+
+// Option configures a Client.
+type Option func(*clientOptions)
+
+type clientOptions struct {
+	restOptions     []vtrest.ClientOption
+	minPollInterval time.Duration
+	maxPollInterval time.Duration
+}
+
+// WithHTTPClient sets the HTTP client used to talk to the server.
+func WithHTTPClient(doer vtrest.HttpRequestDoer) Option {
+	return func(o *clientOptions) {
+		o.restOptions = append(o.restOptions, vtrest.WithHTTPClient(doer))
+	}
+}
+
+// WithRequestEditor registers a function that can modify every outgoing request,
+// e.g. to add authentication headers.
+func WithRequestEditor(fn vtrest.RequestEditorFn) Option {
+	return func(o *clientOptions) {
+		o.restOptions = append(o.restOptions, vtrest.WithRequestEditorFn(fn))
+	}
+}
+
+// WithAPIKey authenticates every request with key, for servers with tenants.
+func WithAPIKey(key string) Option {
+	return WithRequestEditor(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+key)
+		return nil
+	})
+}
+
+// WithPollInterval sets the bounds of the polling backoff used while waiting for jobs.
+// Polling starts at min and backs off towards max while the job is unchanged.
+func WithPollInterval(min, max time.Duration) Option {
+	return func(o *clientOptions) {
+		o.minPollInterval = min
+		o.maxPollInterval = max
+	}
+}
+
+// New creates a Client for the server at the given base URL, which shouldn't include
+// the API version prefix; the client adds it.
+func New(server string, opts ...Option) (*Client, error) {
+	o := clientOptions{
+		minPollInterval: defaultMinPollInterval,
+		maxPollInterval: defaultMaxPollInterval,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.minPollInterval <= 0 || o.maxPollInterval < o.minPollInterval {
+		return nil, fmt.Errorf("invalid poll interval bounds: min %s, max %s", o.minPollInterval, o.maxPollInterval)
+	}
+
+	rest, err := vtrest.NewClientWithResponses(strings.TrimSuffix(server, "/")+vtrest.BasePath, o.restOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vtrest client: %w", err)
+	}
+	return &Client{
+		rest:            rest,
+		minPollInterval: o.minPollInterval,
+		maxPollInterval: o.maxPollInterval,
+	}, nil
+}
+
+// REST returns the underlying generated client for operations without a typed helper.
+func (c *Client) REST() vtrest.ClientWithResponsesInterface {
+	return c.rest
+}
+
+// Submit creates a new transcode job.
+func (c *Client) Submit(ctx context.Context, req vtrest.TranscodeRequest) (*vtrest.TranscodeJob, error) {
+	resp, err := c.rest.CreateTranscodeWithResponse(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transcode job: %w", err)
+	}
+	if resp.JSON201 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.ApplicationproblemJSON400, resp.ApplicationproblemJSON409, resp.ApplicationproblemJSON500)
+	}
+	return resp.JSON201, nil
+}
+
+// Validate runs the server-side checks for a transcode request without creating a job.
+// If probeSource is true, the server also verifies that the source file exists.
+func (c *Client) Validate(ctx context.Context, req vtrest.TranscodeRequest, probeSource bool) (*vtrest.TranscodeValidation, error) {
+	params := &vtrest.ValidateTranscodeParams{ProbeSource: &probeSource}
+	resp, err := c.rest.ValidateTranscodeWithResponse(ctx, params, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate transcode job: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.ApplicationproblemJSON400, resp.ApplicationproblemJSON500)
+	}
+	return resp.JSON200, nil
+}
+
+// WebhookKeys returns the public keys webhook deliveries are signed with, for
+// VerifyWebhookSignature.  Receivers should fetch them again when a delivery names a
+// key they don't have, since workers with new keys may have started.
+func (c *Client) WebhookKeys(ctx context.Context) ([]vtrest.WebhookKey, error) {
+	resp, err := c.rest.ListWebhookKeysWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook keys: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.ApplicationproblemJSON500)
+	}
+	return resp.JSON200.Keys, nil
+}
+
+// Status returns the current status of a transcode job.
+func (c *Client) Status(ctx context.Context, id uuid.UUID) (*vtrest.TranscodeJob, error) {
+	resp, err := c.rest.GetTranscodeStatusWithResponse(ctx, id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transcode status: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.ApplicationproblemJSON400, resp.ApplicationproblemJSON404, resp.ApplicationproblemJSON500)
+	}
+	return resp.JSON200, nil
+}
+
+// Cancel cancels a transcode job that hasn't finished.  With vtrest.Soft, a running job's
+// encoder finalizes the output it has written so far, which is kept; the job is failed
+// with partial set once it has.
+func (c *Client) Cancel(ctx context.Context, id uuid.UUID, mode vtrest.CancelMode) (*vtrest.TranscodeJob, error) {
+	resp, err := c.rest.CancelTranscodeWithResponse(ctx, id, &vtrest.CancelTranscodeParams{Mode: &mode})
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel transcode: %w", err)
+	}
+	if resp.JSON202 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.ApplicationproblemJSON400, resp.ApplicationproblemJSON404, resp.ApplicationproblemJSON409, resp.ApplicationproblemJSON500)
+	}
+	return resp.JSON202, nil
+}
+
+// Statuses returns the current status of several transcode jobs in one request.  Jobs
+// are returned in the order of ids; UUIDs that don't match a job are returned separately.
+func (c *Client) Statuses(ctx context.Context, ids []uuid.UUID) (jobs []vtrest.TranscodeJob, notFound []uuid.UUID, err error) {
+	resp, err := c.rest.GetTranscodeStatusesWithResponse(ctx, vtrest.TranscodeStatusRequest{Uuids: ids})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get transcode statuses: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, nil, newAPIError(resp.HTTPResponse, resp.ApplicationproblemJSON400, resp.ApplicationproblemJSON500)
+	}
+	return resp.JSON200.Jobs, resp.JSON200.NotFound, nil
+}
+
+// WatchProgress polls a job until it finishes, calling fn each time its status or
+// progress changes.  Polling backs off while the job is unchanged and resets when it
+// changes.  The final job is returned; if it failed, the error wraps ErrJobFailed.
+func (c *Client) WatchProgress(ctx context.Context, id uuid.UUID, fn func(*vtrest.TranscodeJob)) (*vtrest.TranscodeJob, error) {
+	interval := c.minPollInterval
+	var last *vtrest.TranscodeJob
+	for {
+		job, err := c.Status(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if last == nil || job.Status != last.Status || job.Progress != last.Progress {
+			if fn != nil {
+				fn(job)
+			}
+			interval = c.minPollInterval
+		} else {
+			interval = min(time.Duration(float64(interval)*pollBackoffFactor), c.maxPollInterval)
+		}
+		last = job
+
+		switch job.Status {
+		case vtrest.Completed:
+			return job, nil
+		case vtrest.Failed:
+			return job, failedError(job)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Wait polls a job until it finishes.  The final job is returned; if it failed, the
+// error wraps ErrJobFailed.
+func (c *Client) Wait(ctx context.Context, id uuid.UUID) (*vtrest.TranscodeJob, error) {
+	return c.WatchProgress(ctx, id, nil)
+}
+
+// SubmitAndWait creates a new transcode job and waits for it to finish.
+func (c *Client) SubmitAndWait(ctx context.Context, req vtrest.TranscodeRequest) (*vtrest.TranscodeJob, error) {
+	if _, err := c.Submit(ctx, req); err != nil {
+		return nil, err
+	}
+	return c.Wait(ctx, req.Uuid)
+}
+
+func failedError(job *vtrest.TranscodeJob) error {
+	if job.Error != nil {
+		return fmt.Errorf("%w: %s", ErrJobFailed, *job.Error)
+	}
+	return ErrJobFailed
+}
+
+// newAPIError builds an APIError from the first non-nil error body.
+func newAPIError(resp *http.Response, bodies ...*vtrest.Error) error {
+	apiErr := &APIError{}
+	if resp != nil {
+		apiErr.StatusCode = resp.StatusCode
+	}
+	for _, body := range bodies {
+		if body != nil {
+			apiErr.Code = body.Code
+			apiErr.Message = body.Message
+			apiErr.Errors = body.Errors
+			break
+		}
+	}
+	return apiErr
+}
@@ -0,0 +1,265 @@
+package vtclient_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtclient"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+func TestClient(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+	ctx := context.Background()
+
+	e.Run("SubmitAndWait", func(e exam.E) {
+		jobUUID := uuid.New()
+		progress := []float64{0, 50, 50, 100}
+		var polls atomic.Int32
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST /v1/transcodes", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(vtrest.TranscodeJob{Uuid: jobUUID, Status: vtrest.Pending})
+		})
+		mux.HandleFunc("GET /v1/transcodes/{uuid}", func(w http.ResponseWriter, r *http.Request) {
+			i := int(polls.Add(1)) - 1
+			job := vtrest.TranscodeJob{Uuid: jobUUID, Status: vtrest.Running, Progress: progress[i]}
+			if i == len(progress)-1 {
+				job.Status = vtrest.Completed
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(job)
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client, err := vtclient.New(server.URL, vtclient.WithPollInterval(time.Millisecond, 5*time.Millisecond))
+		exam.Nil(e, env, err).Log(err).Must()
+
+		var seen []float64
+		job, err := client.WatchProgress(ctx, jobUUID, func(job *vtrest.TranscodeJob) {
+			seen = append(seen, job.Progress)
+		})
+		exam.Nil(e, env, err).Log(err).Must()
+		exam.Equal(e, env, vtrest.Completed, job.Status)
+		// The repeated 50% poll is not reported.
+		exam.Equal(e, env, []float64{0, 50, 100}, seen)
+
+		polls.Store(0)
+		job, err = client.SubmitAndWait(ctx, vtrest.TranscodeRequest{Uuid: jobUUID})
+		exam.Nil(e, env, err).Log(err).Must()
+		exam.Equal(e, env, vtrest.Completed, job.Status)
+	})
+
+	e.Run("Failed job", func(e exam.E) {
+		jobUUID := uuid.New()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			errMsg := "boom"
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(vtrest.TranscodeJob{Uuid: jobUUID, Status: vtrest.Failed, Error: &errMsg})
+		}))
+		defer server.Close()
+
+		client, err := vtclient.New(server.URL)
+		exam.Nil(e, env, err).Log(err).Must()
+
+		job, err := client.Wait(ctx, jobUUID)
+		exam.Equal(e, env, true, errors.Is(err, vtclient.ErrJobFailed)).Log(err)
+		exam.Equal(e, env, vtrest.Failed, job.Status)
+	})
+
+	e.Run("Statuses", func(e exam.E) {
+		found, missing := uuid.New(), uuid.New()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				Uuids []string `json:"uuids"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			exam.Equal(e, env, []string{found.String(), missing.String()}, req.Uuids)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(vtrest.TranscodeStatusList{
+				Jobs:     []vtrest.TranscodeJob{{Uuid: found, Status: vtrest.Running}},
+				NotFound: []uuid.UUID{missing},
+			})
+		}))
+		defer server.Close()
+
+		client, err := vtclient.New(server.URL)
+		exam.Nil(e, env, err).Log(err).Must()
+
+		jobs, notFound, err := client.Statuses(ctx, []uuid.UUID{found, missing})
+		exam.Nil(e, env, err).Log(err).Must()
+		exam.Equal(e, env, 1, len(jobs)).Must()
+		exam.Equal(e, env, found.String(), jobs[0].Uuid.String())
+		exam.Equal(e, env, vtrest.Running, jobs[0].Status)
+		exam.Equal(e, env, 1, len(notFound)).Must()
+		exam.Equal(e, env, missing.String(), notFound[0].String())
+	})
+
+	e.Run("Cancel", func(e exam.E) {
+		jobUUID := uuid.New()
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST /v1/transcodes/{uuid}/cancel", func(w http.ResponseWriter, r *http.Request) {
+			exam.Equal(e, env, jobUUID.String(), r.PathValue("uuid"))
+			exam.Equal(e, env, "soft", r.URL.Query().Get("mode"))
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusAccepted)
+			json.NewEncoder(w).Encode(vtrest.TranscodeJob{Uuid: jobUUID, Status: vtrest.Running})
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client, err := vtclient.New(server.URL)
+		exam.Nil(e, env, err).Log(err).Must()
+
+		job, err := client.Cancel(ctx, jobUUID, vtrest.Soft)
+		exam.Nil(e, env, err).Log(err).Must()
+		exam.Equal(e, env, vtrest.Running, job.Status)
+	})
+
+	e.Run("API error", func(e exam.E) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(vtrest.Error{Code: "NOT_FOUND", Message: "missing"})
+		}))
+		defer server.Close()
+
+		client, err := vtclient.New(server.URL)
+		exam.Nil(e, env, err).Log(err).Must()
+
+		_, err = client.Status(ctx, uuid.New())
+		var apiErr *vtclient.APIError
+		exam.Equal(e, env, true, errors.As(err, &apiErr)).Log(err).Must()
+		exam.Equal(e, env, &vtclient.APIError{StatusCode: http.StatusNotFound, Code: "NOT_FOUND", Message: "missing"}, apiErr)
+	})
+}
+
+func TestParseWebhook(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	jobUUID := uuid.New()
+	body := `{"uuid":"` + jobUUID.String() + `","token":"c2VjcmV0"}`
+
+	e.Run("Valid token", func(e exam.E) {
+		r := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+		payload, err := vtclient.ParseWebhook(r, []byte("secret"))
+		exam.Nil(e, env, err).Log(err).Must()
+		exam.Equal(e, env, jobUUID.String(), payload.Uuid.String())
+	})
+
+	e.Run("Invalid token", func(e exam.E) {
+		r := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+		_, err := vtclient.ParseWebhook(r, []byte("wrong"))
+		exam.Equal(e, env, true, errors.Is(err, vtclient.ErrInvalidWebhookToken)).Log(err)
+	})
+}
+
+func TestParseWebhookTokenHeader(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	jobUUID := uuid.New()
+	body := `{"uuid":"` + jobUUID.String() + `"}`
+
+	tests := []struct {
+		loc     exam.Loc
+		name    string
+		header  string
+		value   string
+		wantErr bool
+	}{
+		{loc: exam.Here(), name: "Bearer token", header: "Authorization", value: "Bearer c2VjcmV0"},
+		{loc: exam.Here(), name: "Custom header", header: "X-Webhook-Token", value: "c2VjcmV0"},
+		{loc: exam.Here(), name: "Authorization without Bearer", header: "Authorization", value: "c2VjcmV0", wantErr: true},
+		{loc: exam.Here(), name: "Wrong token", header: "X-Webhook-Token", value: "d3Jvbmc=", wantErr: true},
+		{loc: exam.Here(), name: "Missing header", header: "X-Webhook-Token", wantErr: true},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			r := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+			if tt.value != "" {
+				r.Header.Set(tt.header, tt.value)
+			}
+			payload, err := vtclient.ParseWebhookTokenHeader(r, tt.header, []byte("secret"))
+			if tt.wantErr {
+				exam.Equal(e, env, true, errors.Is(err, vtclient.ErrInvalidWebhookToken)).Log(err)
+				return
+			}
+			exam.Nil(e, env, err).Log(err).Must()
+			exam.Equal(e, env, jobUUID.String(), payload.Uuid.String())
+		})
+	}
+}
+
+func TestParseSignedWebhook(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	exam.Nil(e, env, err).Log(err).Must()
+	keys := []vtrest.WebhookKey{{
+		Kid: internal.WebhookKeyID(public),
+		Kty: "OKP",
+		Crv: "Ed25519",
+		X:   base64.RawURLEncoding.EncodeToString(public),
+	}}
+	signer := internal.NewWebhookSigner(private)
+	_, otherPrivate, err := ed25519.GenerateKey(rand.Reader)
+	exam.Nil(e, env, err).Log(err).Must()
+
+	jobUUID := uuid.New()
+	body := []byte(`{"uuid":"` + jobUUID.String() + `"}`)
+
+	tests := []struct {
+		loc     exam.Loc
+		name    string
+		signer  *internal.WebhookSigner
+		signed  time.Time
+		tamper  bool
+		wantErr bool
+	}{
+		{loc: exam.Here(), name: "Valid signature", signer: signer, signed: time.Now()},
+		{loc: exam.Here(), name: "Unknown key", signer: internal.NewWebhookSigner(otherPrivate), signed: time.Now(), wantErr: true},
+		{loc: exam.Here(), name: "Tampered body", signer: signer, signed: time.Now(), tamper: true, wantErr: true},
+		{loc: exam.Here(), name: "Too old", signer: signer, signed: time.Now().Add(-time.Hour), wantErr: true},
+		{loc: exam.Here(), name: "Unsigned", signed: time.Now(), wantErr: true},
+	}
+	for _, tt := range tests {
+		e.Run(tt.name, func(e exam.E) {
+			e.Log("Running test at", tt.loc)
+			sent := body
+			if tt.tamper {
+				sent = bytes.Replace(body, []byte(`"}`), []byte(`","error":"x"}`), 1)
+			}
+			r := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(sent))
+			tt.signer.Sign(r, body, tt.signed)
+			payload, err := vtclient.ParseSignedWebhook(r, keys)
+			if tt.wantErr {
+				exam.Equal(e, env, true, errors.Is(err, vtclient.ErrInvalidWebhookSignature)).Log(err)
+				return
+			}
+			exam.Nil(e, env, err).Log(err).Must()
+			exam.Equal(e, env, jobUUID.String(), payload.Uuid.String())
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package vtclient
+
+import (
+	"crypto/ed25519"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// ErrInvalidWebhookToken is returned when a webhook payload does not carry the expected token.
+var ErrInvalidWebhookToken = errors.New("invalid webhook token")
+
+// ErrInvalidWebhookSignature is returned when a webhook delivery isn't signed by one of
+// the expected keys, or was signed too long ago.
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+// maxWebhookBodySize bounds how much of a webhook request body is read.
+const maxWebhookBodySize = 1 << 20
+
+// WebhookSignatureMaxAge is how far the signing time of a delivery may be from the
+// receiver's clock, in either direction, for VerifyWebhookSignature to accept it.
+const WebhookSignatureMaxAge = 5 * time.Minute
+
+// The headers of signed webhook deliveries.
+const (
+	webhookKeyIDHeader     = "X-VT-Key-ID"
+	webhookTimestampHeader = "X-VT-Timestamp"
+	webhookSignatureHeader = "X-VT-Signature"
+)
+
+// VerifyWebhookToken reports whether the payload carries the expected token,
+// using a constant-time comparison.
+func VerifyWebhookToken(payload *vtrest.WebhookPayload, token []byte) bool {
+	return subtle.ConstantTimeCompare(payload.Token, token) == 1
+}
+
+// ParseWebhook decodes a webhook request body and verifies that it carries the
+// expected token.  It is intended to be called from an http.Handler receiving
+// webhook or heartbeat deliveries.
+func ParseWebhook(r *http.Request, token []byte) (*vtrest.WebhookPayload, error) {
+	payload, err := decodeWebhook(r)
+	if err != nil {
+		return nil, err
+	}
+	if !VerifyWebhookToken(payload, token) {
+		return nil, ErrInvalidWebhookToken
+	}
+	return payload, nil
+}
+
+// VerifyWebhookTokenHeader reports whether r carries the expected token in header, as
+// sent for webhooks with a token header, using a constant-time comparison.
+func VerifyWebhookTokenHeader(r *http.Request, header string, token []byte) bool {
+	value := r.Header.Get(header)
+	if http.CanonicalHeaderKey(header) == "Authorization" {
+		var ok bool
+		if value, ok = strings.CutPrefix(value, "Bearer "); !ok {
+			return false
+		}
+	}
+	got, err := base64.StdEncoding.DecodeString(value)
+	return err == nil && subtle.ConstantTimeCompare(got, token) == 1
+}
+
+// ParseWebhookTokenHeader is like ParseWebhook for webhooks that send their token in
+// header instead of the payload.
+func ParseWebhookTokenHeader(r *http.Request, header string, token []byte) (*vtrest.WebhookPayload, error) {
+	if !VerifyWebhookTokenHeader(r, header, token) {
+		return nil, ErrInvalidWebhookToken
+	}
+	return decodeWebhook(r)
+}
+
+// decodeWebhook decodes the payload of a webhook request.
+func decodeWebhook(r *http.Request) (*vtrest.WebhookPayload, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook body: %w", err)
+	}
+
+	var payload vtrest.WebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal webhook payload: %w", err)
+	}
+	return &payload, nil
+}
+
+// VerifyWebhookSignature checks that a delivery with the given headers and body was
+// signed, within WebhookSignatureMaxAge of now, by one of keys, as served by the
+// server's GET /.well-known/vt-webhook-keys.
+func VerifyWebhookSignature(header http.Header, body []byte, keys []vtrest.WebhookKey, now time.Time) error {
+	timestamp := header.Get(webhookTimestampHeader)
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: missing or invalid timestamp", ErrInvalidWebhookSignature)
+	}
+	if age := now.Sub(time.Unix(signedAt, 0)); age > WebhookSignatureMaxAge || age < -WebhookSignatureMaxAge {
+		return fmt.Errorf("%w: signed %s ago", ErrInvalidWebhookSignature, age)
+	}
+	signature, err := base64.StdEncoding.DecodeString(header.Get(webhookSignatureHeader))
+	if err != nil {
+		return fmt.Errorf("%w: invalid signature encoding", ErrInvalidWebhookSignature)
+	}
+	keyID := header.Get(webhookKeyIDHeader)
+	for _, key := range keys {
+		if key.Kid != keyID || key.Kty != "OKP" || key.Crv != "Ed25519" {
+			continue
+		}
+		public, err := base64.RawURLEncoding.DecodeString(key.X)
+		if err != nil || len(public) != ed25519.PublicKeySize {
+			continue
+		}
+		if ed25519.Verify(public, append([]byte(timestamp+"."), body...), signature) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: no matching key %q", ErrInvalidWebhookSignature, keyID)
+}
+
+// ParseSignedWebhook is like ParseWebhook for receivers that verify deliveries by
+// their signature rather than a token.
+func ParseSignedWebhook(r *http.Request, keys []vtrest.WebhookKey) (*vtrest.WebhookPayload, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook body: %w", err)
+	}
+	if err := VerifyWebhookSignature(r.Header, body, keys, time.Now()); err != nil {
+		return nil, err
+	}
+	var payload vtrest.WebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal webhook payload: %w", err)
+	}
+	return &payload, nil
+}
@@ -25,6 +25,66 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	ApiKeyScopes = "apiKey.Scopes"
+)
+
+// Defines values for AudioOptionsCodec.
+const (
+	AudioCodecAac  AudioOptionsCodec = "aac"
+	AudioCodecAc3  AudioOptionsCodec = "ac3"
+	AudioCodecCopy AudioOptionsCodec = "copy"
+	AudioCodecEac3 AudioOptionsCodec = "eac3"
+	AudioCodecFlac AudioOptionsCodec = "flac"
+	AudioCodecOpus AudioOptionsCodec = "opus"
+)
+
+// Defines values for AudioOptionsDownmix.
+const (
+	AudioDownmixDpl2   AudioOptionsDownmix = "dpl2"
+	AudioDownmixSimple AudioOptionsDownmix = "simple"
+)
+
+// Defines values for AudioOptionsLayout.
+const (
+	AudioLayout51     AudioOptionsLayout = "5.1"
+	AudioLayout71     AudioOptionsLayout = "7.1"
+	AudioLayoutMono   AudioOptionsLayout = "mono"
+	AudioLayoutStereo AudioOptionsLayout = "stereo"
+)
+
+// Defines values for CancelMode.
+const (
+	Hard CancelMode = "hard"
+	Soft CancelMode = "soft"
+)
+
+// Defines values for ErrorCode.
+const (
+	ErrorCodeCancelled           ErrorCode = "CANCELLED"
+	ErrorCodeDiskFull            ErrorCode = "DISK_FULL"
+	ErrorCodeEncoderNotInstalled ErrorCode = "ENCODER_NOT_INSTALLED"
+	ErrorCodeFfmpegError         ErrorCode = "FFMPEG_ERROR"
+	ErrorCodeHandbrakeError      ErrorCode = "HANDBRAKE_ERROR"
+	ErrorCodeInvalidInput        ErrorCode = "INVALID_INPUT"
+	ErrorCodeMemoryLimitExceeded ErrorCode = "MEMORY_LIMIT_EXCEEDED"
+	ErrorCodeMountUnavailable    ErrorCode = "MOUNT_UNAVAILABLE"
+	ErrorCodeSourceNotFound      ErrorCode = "SOURCE_NOT_FOUND"
+	ErrorCodeStalled             ErrorCode = "STALLED"
+)
+
+// Defines values for ExportFormat.
+const (
+	Csv    ExportFormat = "csv"
+	Ndjson ExportFormat = "ndjson"
+)
+
+// Defines values for SubtitleOptionsCaptions.
+const (
+	CaptionModePreserve SubtitleOptionsCaptions = "preserve"
+	CaptionModeSidecar  SubtitleOptionsCaptions = "sidecar"
+)
+
 // Defines values for TranscodeStatus.
 const (
 	Completed TranscodeStatus = "completed"
@@ -33,788 +93,10286 @@ const (
 	Running   TranscodeStatus = "running"
 )
 
-// Error defines model for Error.
-type Error struct {
-	// Code Error code
-	Code string `json:"code"`
+// Defines values for VideoOptionsDenoise.
+const (
+	DenoiseHqdn3d  VideoOptionsDenoise = "hqdn3d"
+	DenoiseNlmeans VideoOptionsDenoise = "nlmeans"
+)
 
-	// Message Human-readable error message
-	Message string `json:"message"`
+// Defines values for VideoOptionsDenoiseLevel.
+const (
+	DenoiseLevelLight  VideoOptionsDenoiseLevel = "light"
+	DenoiseLevelMedium VideoOptionsDenoiseLevel = "medium"
+	DenoiseLevelStrong VideoOptionsDenoiseLevel = "strong"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Abandoned WebhookDeliveryStatus = "abandoned"
+	Delivered WebhookDeliveryStatus = "delivered"
+	Queued    WebhookDeliveryStatus = "queued"
+)
+
+// Defines values for WebhookEvent.
+const (
+	WebhookEventCompleted WebhookEvent = "completed"
+	WebhookEventFailed    WebhookEvent = "failed"
+	WebhookEventHeartbeat WebhookEvent = "heartbeat"
+)
+
+// Defines values for WorkflowStepType.
+const (
+	WorkflowStepTypeProbe     WorkflowStepType = "probe"
+	WorkflowStepTypeTranscode WorkflowStepType = "transcode"
+	WorkflowStepTypeVerify    WorkflowStepType = "verify"
+	WorkflowStepTypeWebhook   WorkflowStepType = "webhook"
+)
+
+// AnimationOptions Configures the animated profile, which renders at 10 frames per second
+type AnimationOptions struct {
+	// DurationSeconds Length of the animation; defaults to 3 seconds
+	DurationSeconds *float64 `json:"durationSeconds,omitempty"`
+
+	// StartSeconds Where in the source the animation starts; defaults to a tenth of the way in.  Clamped so the animation ends inside the source.
+	StartSeconds *float64 `json:"startSeconds,omitempty"`
+
+	// Width Width in pixels; defaults to 320.  The height follows the source aspect ratio.
+	Width *int `json:"width,omitempty"`
 }
 
-// TranscodeJob defines model for TranscodeJob.
-type TranscodeJob struct {
-	// CreatedAt Timestamp when the job was created
+// ApiToken defines model for ApiToken.
+type ApiToken struct {
+	// CreatedAt When the token was created
 	CreatedAt time.Time `json:"createdAt"`
 
-	// DestinationPath Path for the transcoded output file
-	DestinationPath string `json:"destinationPath"`
+	// Id The ID of the token
+	Id openapi_types.UUID `json:"id"`
 
-	// Error Error message if the transcode failed
-	Error *string `json:"error,omitempty"`
+	// Name The label given when the token was created
+	Name string `json:"name"`
 
-	// Profile Transcoding profile used
-	Profile string `json:"profile"`
+	// RevokedAt When the token was revoked, if it has been
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
 
-	// Progress Transcoding progress percentage
-	Progress float64 `json:"progress"`
+	// RotatedAt When the token's key was last rotated, if ever
+	RotatedAt *time.Time `json:"rotatedAt,omitempty"`
 
-	// SourcePath Path to the source video file
-	SourcePath string `json:"sourcePath"`
+	// Tenant The tenant the token authenticates as
+	Tenant string `json:"tenant"`
+}
 
-	// Status Current status of the transcode job
-	Status TranscodeStatus `json:"status"`
+// ApiTokenList defines model for ApiTokenList.
+type ApiTokenList struct {
+	Tokens []ApiToken `json:"tokens"`
+}
 
-	// UpdatedAt Timestamp when the job was last updated
-	UpdatedAt time.Time `json:"updatedAt"`
+// ApiTokenRequest defines model for ApiTokenRequest.
+type ApiTokenRequest struct {
+	// Name A label to tell tokens apart, such as what uses the token
+	Name *string `json:"name,omitempty"`
 
-	// Uuid Unique identifier for the transcode job
-	Uuid openapi_types.UUID `json:"uuid"`
+	// Tenant The tenant the token authenticates as
+	Tenant string `json:"tenant"`
 }
 
-// TranscodeRequest defines model for TranscodeRequest.
-type TranscodeRequest struct {
-	// DestinationPath Path for the transcoded output file
-	DestinationPath string `json:"destinationPath"`
-
-	// HeartbeatWebhookUri Optional URI to POST heartbeat webhook notifications with progress updates during transcoding
-	HeartbeatWebhookUri *string `json:"heartbeatWebhookUri,omitempty"`
+// ApiTokenSecret defines model for ApiTokenSecret.
+type ApiTokenSecret struct {
+	// Key The API key to send as a bearer token.  It can't be retrieved again.
+	Key   string   `json:"key"`
+	Token ApiToken `json:"token"`
+}
 
-	// Profile Transcoding profile to use.
-	Profile string `json:"profile"`
+// AudioOptions Overrides the profile's audio encoding
+type AudioOptions struct {
+	// BitrateKbps Target bitrate for lossy codecs; defaults to the encoder default.  Ignored with flac and copy.
+	BitrateKbps *int `json:"bitrateKbps,omitempty"`
 
-	// SourcePath Path to the source video file
-	SourcePath string `json:"sourcePath"`
+	// Codec Output audio codec, or copy to pass the source audio through.  The webm profile only supports opus and copy.
+	Codec AudioOptionsCodec `json:"codec"`
 
-	// Uuid Client-provided UUID for the transcode job
-	Uuid openapi_types.UUID `json:"uuid"`
+	// Downmix How surround audio is mixed to stereo, for a stereo layout or the stereo compatibility track; defaults to simple
+	Downmix *AudioOptionsDownmix `json:"downmix,omitempty"`
 
-	// WebhookToken Optional opaque token to include in webhook payload for authentication
-	WebhookToken []byte `json:"webhookToken,omitempty"`
+	// Layout Output channel layout; defaults to the source layout.  Ignored with copy.
+	Layout *AudioOptionsLayout `json:"layout,omitempty"`
 
-	// WebhookUri Optional URI to POST webhook notification when job completes
-	WebhookUri *string `json:"webhookUri,omitempty"`
+	// StereoTrack Keep the main track's layout and add a stereo compatibility track after it.  The stereo track uses the main codec if it is lossy, and AAC (Opus for webm) otherwise.
+	StereoTrack *bool `json:"stereoTrack,omitempty"`
 }
 
-// TranscodeStatus Current status of the transcode job
-type TranscodeStatus string
+// AudioOptionsCodec Output audio codec, or copy to pass the source audio through.  The webm profile only supports opus and copy.
+type AudioOptionsCodec string
 
-// CreateTranscodeJSONRequestBody defines body for CreateTranscode for application/json ContentType.
-type CreateTranscodeJSONRequestBody = TranscodeRequest
+// AudioOptionsDownmix How surround audio is mixed to stereo, for a stereo layout or the stereo compatibility track; defaults to simple
+type AudioOptionsDownmix string
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+// AudioOptionsLayout Output channel layout; defaults to the source layout.  Ignored with copy.
+type AudioOptionsLayout string
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+// BulkTranscodeRequest Selects jobs either by UUID or by a filter of status and labels, as for GET /transcodes
+type BulkTranscodeRequest struct {
+	// Labels Only act on jobs carrying all of these labels
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Status Current status of the transcode job
+	Status *TranscodeStatus `json:"status,omitempty"`
+
+	// Uuids UUIDs of the jobs to act on
+	Uuids []openapi_types.UUID `json:"uuids,omitempty"`
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// BulkTranscodeResult defines model for BulkTranscodeResult.
+type BulkTranscodeResult struct {
+	// Jobs The jobs acted on, as they are afterwards
+	Jobs []TranscodeJob `json:"jobs"`
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	// NotFound The requested UUIDs that don't match any job
+	NotFound []openapi_types.UUID `json:"notFound"`
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+	// Skipped Requested jobs that weren't acted on, with the reason
+	Skipped []BulkTranscodeSkipped `json:"skipped"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// BulkTranscodeSkipped defines model for BulkTranscodeSkipped.
+type BulkTranscodeSkipped struct {
+	// Code Why the job was skipped, e.g. JOB_FINISHED or JOB_NOT_FAILED
+	Code string `json:"code"`
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
-}
+	// Message Human-readable reason the job was skipped
+	Message string `json:"message"`
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
+	// Uuid UUID of the skipped job
+	Uuid openapi_types.UUID `json:"uuid"`
 }
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
+// CancelMode How to stop a running job:
+// * hard - Kill the encoder
+// * soft - Let the encoder finalize the output written so far, and keep it
+type CancelMode string
+
+// ChapterOptions Chapters for sources that have none.  Sources with chapters keep their own.  Only supported by profiles that write a
+// single output covering the whole source, so not by preview_clip, animated, renditions, abr, or plugin profiles.
+type ChapterOptions struct {
+	// Generate Detect scene changes in the output and write chapters starting at them, which takes about as long as decoding
+	// the output.  No chapters are written if the scene changes are too close together to make at least two.
+	Generate *bool `json:"generate,omitempty"`
+
+	// MinLengthSeconds Shortest a generated chapter may be
+	MinLengthSeconds *int `json:"minLengthSeconds,omitempty"`
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// CreateTranscodeWithBody request with any body
-	CreateTranscodeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// CloneTranscodeRequest defines model for CloneTranscodeRequest.
+type CloneTranscodeRequest struct {
+	// DestinationPath Path for the new job's output.  Defaults to the original's, which the new job overwrites.
+	DestinationPath *string `json:"destinationPath,omitempty"`
 
-	CreateTranscode(ctx context.Context, body CreateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Labels Arbitrary client-defined string labels attached to the job
+	Labels *Labels `json:"labels,omitempty"`
 
-	// GetTranscodeStatus request
-	GetTranscodeStatus(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
-}
+	// Profile Transcoding profile for the new job, as in TranscodeRequest.  Defaults to the original's.
+	Profile *string `json:"profile,omitempty"`
 
-func (c *Client) CreateTranscodeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTranscodeRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	// Uuid Client-provided UUID for the new transcode job
+	Uuid openapi_types.UUID `json:"uuid"`
 }
 
-func (c *Client) CreateTranscode(ctx context.Context, body CreateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTranscodeRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// CompareOptions Compare the output against the source once the transcode completes.  The report is available from
+// GET /comparisons/{uuid} with the transcode's UUID.  Only supported by profiles that write a single output covering
+// the whole source, so not by preview_clip, animated, renditions, abr, or plugin profiles.
+type CompareOptions struct {
+	// Vmaf Also score the output's picture quality with VMAF, which takes about as long as decoding both files
+	Vmaf *bool `json:"vmaf,omitempty"`
 }
 
-func (c *Client) GetTranscodeStatus(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTranscodeStatusRequest(c.Server, uuid)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// Comparison defines model for Comparison.
+type Comparison struct {
+	// CreatedAt Timestamp when the comparison was created
+	CreatedAt time.Time `json:"createdAt"`
 
-// NewCreateTranscodeRequest calls the generic CreateTranscode builder with application/json body
-func NewCreateTranscodeRequest(server string, body CreateTranscodeJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTranscodeRequestWithBody(server, "application/json", bodyReader)
+	// Error Error message if the comparison failed
+	Error *string `json:"error,omitempty"`
+
+	// ErrorCode Machine-readable failure code if the transcode failed:
+	// - MOUNT_UNAVAILABLE: a media mount was missing, not writable, or timed out (retried)
+	// - MEMORY_LIMIT_EXCEEDED: the encoder exceeded the worker's memory limit
+	// - SOURCE_NOT_FOUND: the source file does not exist
+	// - INVALID_INPUT: the source could not be decoded
+	// - DISK_FULL: the destination filesystem ran out of space (retried)
+	// - ENCODER_NOT_INSTALLED: ffmpeg or HandBrake is not installed on the worker
+	// - CANCELLED: the job was cancelled while running
+	// - FFMPEG_ERROR: ffmpeg failed for another reason (retried)
+	// - HANDBRAKE_ERROR: HandBrake failed for another reason (retried)
+	// - STALLED: the job's worker died or its progress stopped advancing (retried)
+	ErrorCode *ErrorCode `json:"errorCode,omitempty"`
+
+	// OutputPath Path to the file compared against the source
+	OutputPath string `json:"outputPath"`
+
+	// Report How an output differs from its source.  Codecs and video sizes are expected to change, so the stream layout only
+	// covers the number of video, audio, and subtitle streams and the channels and languages of audio streams.
+	Report *ComparisonReport `json:"report,omitempty"`
+
+	// SourcePath Path to the source video file
+	SourcePath string `json:"sourcePath"`
+
+	// Status Current status of the transcode job
+	Status TranscodeStatus `json:"status"`
+
+	// UpdatedAt Timestamp when the comparison was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Uuid Unique identifier for the comparison
+	Uuid openapi_types.UUID `json:"uuid"`
+
+	// Vmaf Whether the comparison scores the output with VMAF
+	Vmaf bool `json:"vmaf"`
 }
 
-// NewCreateTranscodeRequestWithBody generates requests for CreateTranscode with any type of body
-func NewCreateTranscodeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// ComparisonReport How an output differs from its source.  Codecs and video sizes are expected to change, so the stream layout only
+// covers the number of video, audio, and subtitle streams and the channels and languages of audio streams.
+type ComparisonReport struct {
+	// Differences Each way the files differ, for people reading the report
+	Differences []string `json:"differences,omitempty"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// DurationsMatch Whether the durations match, to within a second or 1% of the source's, whichever is longer
+	DurationsMatch bool         `json:"durationsMatch"`
+	Output         MediaSummary `json:"output"`
+	Source         MediaSummary `json:"source"`
 
-	operationPath := fmt.Sprintf("/transcodes")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// StreamLayoutMatches Whether the stream layouts match
+	StreamLayoutMatches bool `json:"streamLayoutMatches"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// Vmaf VMAF of the output's first video stream against the source's, from 0 to 100, pooled over every frame
+	Vmaf *VMAFScore `json:"vmaf,omitempty"`
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+// ComparisonRequest defines model for ComparisonRequest.
+type ComparisonRequest struct {
+	// OutputPath Path to the file to compare against the source
+	OutputPath string `json:"outputPath"`
 
-	req.Header.Add("Content-Type", contentType)
+	// SourcePath Path to the source video file
+	SourcePath string `json:"sourcePath"`
 
-	return req, nil
+	// Uuid Client-provided UUID for the comparison
+	Uuid openapi_types.UUID `json:"uuid"`
+
+	// Vmaf Also score the output's picture quality with VMAF, which takes about as long as decoding both files
+	Vmaf *bool `json:"vmaf,omitempty"`
 }
 
-// NewGetTranscodeStatusRequest generates requests for GetTranscodeStatus
-func NewGetTranscodeStatusRequest(server string, uuid openapi_types.UUID) (*http.Request, error) {
-	var err error
+// DatabaseHealth defines model for DatabaseHealth.
+type DatabaseHealth struct {
+	// Available Whether the last ping of the database succeeded
+	Available bool `json:"available"`
 
-	var pathParam0 string
+	// Error Why the last ping failed.  Omitted when the database is available.
+	Error *string `json:"error,omitempty"`
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
-	if err != nil {
-		return nil, err
-	}
+	// Since When the database last became available or unavailable, or when the server started if it hasn't changed
+	Since time.Time `json:"since"`
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// DirectoryTranscode defines model for DirectoryTranscode.
+type DirectoryTranscode struct {
+	// Created Jobs created by this request
+	Created []DirectoryTranscodeJob `json:"created"`
 
-	operationPath := fmt.Sprintf("/transcodes/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Existing Jobs for matching files that an earlier request with the same UUID already created
+	Existing []DirectoryTranscodeJob `json:"existing"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// GroupId Group the jobs were added to
+	GroupId string `json:"groupId"`
 
-	return req, nil
+	// Uuid UUID of the request
+	Uuid openapi_types.UUID `json:"uuid"`
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
+// DirectoryTranscodeJob defines model for DirectoryTranscodeJob.
+type DirectoryTranscodeJob struct {
+	DestinationPath string             `json:"destinationPath"`
+	SourcePath      string             `json:"sourcePath"`
+	Uuid            openapi_types.UUID `json:"uuid"`
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// DirectoryTranscodeRequest defines model for DirectoryTranscodeRequest.
+type DirectoryTranscodeRequest struct {
+	// Audio Overrides the profile's audio encoding
+	Audio *AudioOptions `json:"audio,omitempty"`
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &ClientWithResponses{client}, nil
-}
+	// DestinationDirectory Directory to write the outputs to, in the same subdirectories as their sources
+	DestinationDirectory string `json:"destinationDirectory"`
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
+	// Exclude Glob patterns, matched like include, of files to leave out
+	Exclude []string `json:"exclude,omitempty"`
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// CreateTranscodeWithBodyWithResponse request with any body
-	CreateTranscodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTranscodeResponse, error)
+	// GroupId Group to add the jobs to.  Defaults to the request UUID, so the progress of the whole directory is available from GET /groups/{groupId}.
+	GroupId *string `json:"groupId,omitempty"`
 
-	CreateTranscodeWithResponse(ctx context.Context, body CreateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTranscodeResponse, error)
+	// Include Glob patterns selecting the files to transcode, such as *.mkv.  A pattern containing / is matched against the path
+	// relative to sourceDirectory, and any other pattern against the file name.  Defaults to every file.
+	Include []string `json:"include,omitempty"`
 
-	// GetTranscodeStatusWithResponse request
-	GetTranscodeStatusWithResponse(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTranscodeStatusResponse, error)
-}
+	// Labels Arbitrary client-defined string labels attached to the job
+	Labels *Labels `json:"labels,omitempty"`
 
-type CreateTranscodeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *TranscodeJob
-	JSON400      *Error
-	JSON409      *Error
-	JSON500      *Error
-}
+	// OutputExtension Extension to give the outputs in place of the source's, such as .mp4.  Defaults to keeping the source's.
+	OutputExtension *string `json:"outputExtension,omitempty"`
 
-// Status returns HTTPResponse.Status
-func (r CreateTranscodeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	// Profile Transcoding profile to use for every job, as in TranscodeRequest
+	Profile string `json:"profile"`
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTranscodeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	// SourceDirectory Directory to search for source files, recursively
+	SourceDirectory string `json:"sourceDirectory"`
 
-type GetTranscodeStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TranscodeJob
-	JSON404      *Error
-	JSON500      *Error
-}
+	// Subtitles Controls the output subtitles
+	Subtitles *SubtitleOptions `json:"subtitles,omitempty"`
 
-// Status returns HTTPResponse.Status
-func (r GetTranscodeStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	// Uuid Client-provided UUID for the request, from which the UUID of each job is derived
+	Uuid openapi_types.UUID `json:"uuid"`
+
+	// Video Adjusts the profile's video processing.  Ignored by the preview and preview_clip profiles.
+	Video *VideoOptions `json:"video,omitempty"`
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTranscodeStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// Error An RFC 7807 problem details object.  code and message are extension members kept for clients written before problem details; message is the same as detail.
+type Error struct {
+	// Code Error code
+	Code string `json:"code"`
+
+	// Detail Human-readable explanation of this occurrence of the problem
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Every problem found with the request body, for validation failures.  The first is also reported in code and detail.
+	Errors []FieldError `json:"errors,omitempty"`
+
+	// Instance URI identifying this occurrence of the problem, built from the request ID
+	Instance *string `json:"instance,omitempty"`
+
+	// Message Human-readable error message
+	Message string `json:"message"`
+
+	// Status HTTP status code of the response
+	Status *int `json:"status,omitempty"`
+
+	// Title Short summary of the kind of problem
+	Title *string `json:"title,omitempty"`
+
+	// Type URI identifying the kind of problem, derived from code
+	Type *string `json:"type,omitempty"`
 }
 
-// CreateTranscodeWithBodyWithResponse request with arbitrary body returning *CreateTranscodeResponse
-func (c *ClientWithResponses) CreateTranscodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTranscodeResponse, error) {
-	rsp, err := c.CreateTranscodeWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateTranscodeResponse(rsp)
+// ErrorCode Machine-readable failure code if the transcode failed:
+// - MOUNT_UNAVAILABLE: a media mount was missing, not writable, or timed out (retried)
+// - MEMORY_LIMIT_EXCEEDED: the encoder exceeded the worker's memory limit
+// - SOURCE_NOT_FOUND: the source file does not exist
+// - INVALID_INPUT: the source could not be decoded
+// - DISK_FULL: the destination filesystem ran out of space (retried)
+// - ENCODER_NOT_INSTALLED: ffmpeg or HandBrake is not installed on the worker
+// - CANCELLED: the job was cancelled while running
+// - FFMPEG_ERROR: ffmpeg failed for another reason (retried)
+// - HANDBRAKE_ERROR: HandBrake failed for another reason (retried)
+// - STALLED: the job's worker died or its progress stopped advancing (retried)
+type ErrorCode string
+
+// Estimate defines model for Estimate.
+type Estimate struct {
+	// EstimatedEncodeSeconds Estimated wall-clock time to encode the source on one worker.  Omitted when sampleJobs is 0.
+	EstimatedEncodeSeconds *float64 `json:"estimatedEncodeSeconds,omitempty"`
+
+	// EstimatedOutputSizeBytes Estimated total size of the outputs.  Omitted when sampleJobs is 0.
+	EstimatedOutputSizeBytes *int64 `json:"estimatedOutputSizeBytes,omitempty"`
+
+	// Profile Profile the estimate is for
+	Profile string `json:"profile"`
+
+	// SampleJobs Number of past successful transcodes of the profile the estimate is based on
+	SampleJobs int64 `json:"sampleJobs"`
+
+	// SourceDurationSeconds Duration of the source
+	SourceDurationSeconds float64 `json:"sourceDurationSeconds"`
 }
 
-func (c *ClientWithResponses) CreateTranscodeWithResponse(ctx context.Context, body CreateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTranscodeResponse, error) {
-	rsp, err := c.CreateTranscode(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateTranscodeResponse(rsp)
+// EstimateRequest defines model for EstimateRequest.
+type EstimateRequest struct {
+	// Profile Transcoding profile to estimate, as in TranscodeRequest
+	Profile string `json:"profile"`
+
+	// SourcePath Path to the source video file
+	SourcePath string `json:"sourcePath"`
 }
 
-// GetTranscodeStatusWithResponse request returning *GetTranscodeStatusResponse
-func (c *ClientWithResponses) GetTranscodeStatusWithResponse(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTranscodeStatusResponse, error) {
-	rsp, err := c.GetTranscodeStatus(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetTranscodeStatusResponse(rsp)
+// ExportFormat Format of an export
+type ExportFormat string
+
+// ExternalSubtitle defines model for ExternalSubtitle.
+type ExternalSubtitle struct {
+	// Language ISO 639-2 language code of the track
+	Language *string `json:"language,omitempty"`
+
+	// Path Path to an .srt, .ass, or .ssa file.  Must be inside an allowed directory and must not contain commas.
+	Path string `json:"path"`
 }
 
-// ParseCreateTranscodeResponse parses an HTTP response from a CreateTranscodeWithResponse call
-func ParseCreateTranscodeResponse(rsp *http.Response) (*CreateTranscodeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// FieldError A problem with one field of a request body
+type FieldError struct {
+	// Code Error code
+	Code string `json:"code"`
 
-	response := &CreateTranscodeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Field JSON pointer to the request body field with the problem, if it concerns a single field
+	Field *string `json:"field,omitempty"`
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TranscodeJob
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// Message Human-readable error message
+	Message string `json:"message"`
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// HandBrakeOptions HandBrakeCLI settings layered onto the profile's preset.  Only supported by the HandBrake-based profiles (fast1080p30,
+// archive, and hdr), and only the values listed are accepted.
+type HandBrakeOptions struct {
+	// AllSubtitles Keep every subtitle track, rather than the preset's selection.  Can't be combined with streams.subtitles.
+	AllSubtitles *bool `json:"allSubtitles,omitempty"`
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	// AudioEncoder HandBrake audio encoder, from av_aac, ac3, eac3, opus, mp3, flac16, flac24, copy, copy:aac, copy:ac3, copy:eac3,
+	// copy:dts, copy:dtshd, copy:truehd, or copy:flac.  The copy encoders pass the source audio through, falling back to
+	// the preset's encoder for audio of other codecs.  Can't be combined with audio.
+	AudioEncoder *string `json:"audioEncoder,omitempty"`
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// EncoderPreset x264 or x265 preset, from ultrafast, superfast, veryfast, faster, fast, medium, slow, slower, or veryslow
+	EncoderPreset *string `json:"encoderPreset,omitempty"`
 
-	}
+	// EncoderTune x264 or x265 tune, from animation, grain, psnr, ssim, fastdecode, or zerolatency.  Can't be combined with video.grainTune.
+	EncoderTune *string `json:"encoderTune,omitempty"`
 
-	return response, nil
+	// Quality Constant quality RF; lower is better.  Can't be combined with video.perTitle.
+	Quality *float64 `json:"quality,omitempty"`
 }
 
-// ParseGetTranscodeStatusResponse parses an HTTP response from a GetTranscodeStatusWithResponse call
-func ParseGetTranscodeStatusResponse(rsp *http.Response) (*GetTranscodeStatusResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// JobGroup defines model for JobGroup.
+type JobGroup struct {
+	// Counts Number of jobs in the group in each status
+	Counts JobGroupCounts `json:"counts"`
 
-	response := &GetTranscodeStatusResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// CreatedAt Timestamp when the first job of the group was created
+	CreatedAt time.Time `json:"createdAt"`
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TranscodeJob
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// GroupId The client-defined group ID
+	GroupId string `json:"groupId"`
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// Jobs Status of every job in the group, in submission order
+	Jobs []TranscodeJob `json:"jobs"`
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// Progress Mean progress of the group's jobs, counting finished jobs as 100
+	Progress float64 `json:"progress"`
 
-	}
+	// Status Current status of the transcode job
+	Status TranscodeStatus `json:"status"`
 
-	return response, nil
+	// UpdatedAt Timestamp when a job of the group was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Start a new transcode job
-	// (POST /transcodes)
-	CreateTranscode(w http.ResponseWriter, r *http.Request)
-	// Get transcode job status
-	// (GET /transcodes/{uuid})
-	GetTranscodeStatus(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID)
+// JobGroupCounts Number of jobs in the group in each status
+type JobGroupCounts struct {
+	Completed int `json:"completed"`
+	Failed    int `json:"failed"`
+	Pending   int `json:"pending"`
+	Running   int `json:"running"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+// Labels Arbitrary client-defined string labels attached to the job
+type Labels map[string]string
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// MediaSummary defines model for MediaSummary.
+type MediaSummary struct {
+	// DurationSeconds Duration of the file, in seconds
+	DurationSeconds float64 `json:"durationSeconds"`
 
-// CreateTranscode operation middleware
-func (siw *ServerInterfaceWrapper) CreateTranscode(w http.ResponseWriter, r *http.Request) {
+	// SizeBytes Size of the file, in bytes
+	SizeBytes int64           `json:"sizeBytes"`
+	Streams   []StreamSummary `json:"streams"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTranscode(w, r)
-	}))
+// OutputOwnership Sets the owner and permissions of the output files once they have been written.  Each field overrides the worker's
+// configured default; fields that are unset everywhere are left as the encoder created them.
+type OutputOwnership struct {
+	// Gid Group ID to own the outputs.
+	Gid *int `json:"gid,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Mode Permission bits in octal.
+	Mode *string `json:"mode,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// Uid User ID to own the outputs.
+	Uid *int `json:"uid,omitempty"`
 }
 
-// GetTranscodeStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetTranscodeStatus(w http.ResponseWriter, r *http.Request) {
+// PerTitleOptions Content-adaptive encoding: an analysis pass measures how hard the source is to compress and picks the CRF within
+// these bounds, so simple content gets smaller files and complex content keeps its quality.  Only supported by the
+// fast1080p30, archive, hdr, and renditions profiles; renditions with videoBitrateKbps keep their bitrate.
+type PerTitleOptions struct {
+	// MaxCrf Lowest quality (highest CRF) that simple content is encoded at
+	MaxCrf *int `json:"maxCrf,omitempty"`
 
-	var err error
+	// MinCrf Best quality (lowest CRF) that complex content is encoded at
+	MinCrf *int `json:"minCrf,omitempty"`
+}
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid openapi_types.UUID
+// Queue defines model for Queue.
+type Queue struct {
+	// Name Name of the queue
+	Name string `json:"name"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "uuid", r.PathValue("uuid"), &uuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
-		return
-	}
+	// OldestPendingAgeSeconds How long the longest-waiting pending job has been ready to run.  Omitted when nothing is pending.
+	OldestPendingAgeSeconds *float64 `json:"oldestPendingAgeSeconds,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTranscodeStatus(w, r, uuid)
-	}))
+	// Pending Jobs of any kind that are ready to run and waiting for a worker
+	Pending int `json:"pending"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Retryable Jobs that failed and are waiting to be retried
+	Retryable int `json:"retryable"`
 
-	handler.ServeHTTP(w, r)
+	// Running Jobs being worked
+	Running int `json:"running"`
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+// QueueList defines model for QueueList.
+type QueueList struct {
+	// Queues Every queue that exists or has unfinished jobs, ordered by name
+	Queues []Queue `json:"queues"`
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// QueueScaling defines model for QueueScaling.
+type QueueScaling struct {
+	// BacklogMinutes Estimated minutes one worker needs to work the pending jobs.  Transcodes are estimated from the average encode
+	// time of their profile's past successful transcodes, and other jobs from the average run time of the queue's
+	// recently completed jobs of the same kind.
+	BacklogMinutes float64 `json:"backlogMinutes"`
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// PendingJobs Jobs that are ready to run and waiting for a worker
+	PendingJobs int `json:"pendingJobs"`
 
-type UnmarshalingParamError struct {
+	// Queue Name of the queue
+	Queue string `json:"queue"`
+
+	// RunningJobs Jobs being worked
+	RunningJobs int `json:"runningJobs"`
+
+	// UnestimatedJobs Pending jobs left out of backlogMinutes because there is nothing yet to estimate them from
+	UnestimatedJobs int `json:"unestimatedJobs"`
+}
+
+// Readiness defines model for Readiness.
+type Readiness struct {
+	Database DatabaseHealth `json:"database"`
+
+	// Ready Whether the server can serve requests
+	Ready bool `json:"ready"`
+}
+
+// Rendition defines model for Rendition.
+type Rendition struct {
+	// Height Output height in pixels, which must be even; the width follows the source aspect ratio
+	Height int `json:"height"`
+
+	// Name Unique name of the rendition, appended to the destination file name
+	Name string `json:"name"`
+
+	// VideoBitrateKbps Caps the video bitrate; by default the rendition is encoded at constant quality
+	VideoBitrateKbps *int `json:"videoBitrateKbps,omitempty"`
+}
+
+// RenditionStatus defines model for RenditionStatus.
+type RenditionStatus struct {
+	// DestinationPath Path of the rendition's output file
+	DestinationPath string `json:"destinationPath"`
+
+	// Name Name of the rendition
+	Name string `json:"name"`
+
+	// OutputDurationSeconds Duration of the output file in seconds, once the job has succeeded
+	OutputDurationSeconds *float64 `json:"outputDurationSeconds,omitempty"`
+
+	// OutputSizeBytes Size of the output file in bytes, once the job has succeeded
+	OutputSizeBytes *int64 `json:"outputSizeBytes,omitempty"`
+
+	// Progress Encoding progress percentage of the rendition
+	Progress float64 `json:"progress"`
+}
+
+// StreamSelection Overrides which source streams are kept, for multi-angle and multi-language sources.
+// Streams are numbered from zero among the streams of the same type, like ffmpeg's -map 0:a:N.
+// A job fails with INVALID_INPUT if the source doesn't have a selected stream.
+type StreamSelection struct {
+	// Audio Audio streams to keep, in output order.  Omit to use the profile's default selection; an empty list drops all audio.
+	Audio *[]int `json:"audio,omitempty"`
+
+	// Subtitles Subtitle streams to keep, in output order, ahead of any external subtitles.
+	// Omit to use the profile's default selection; an empty list drops all source subtitles.
+	// Bitmap subtitles can only be kept in mkv outputs.
+	Subtitles *[]int `json:"subtitles,omitempty"`
+
+	// Video Video stream to encode.  HandBrake-based profiles (fast1080p30, archive, hdr) only support 0.
+	Video *int `json:"video,omitempty"`
+}
+
+// StreamSummary defines model for StreamSummary.
+type StreamSummary struct {
+	Channels *int    `json:"channels,omitempty"`
+	Codec    *string `json:"codec,omitempty"`
+	Height   *int    `json:"height,omitempty"`
+
+	// Index Index of the stream in the file
+	Index    int     `json:"index"`
+	Language *string `json:"language,omitempty"`
+
+	// Type Type of the stream (video, audio, subtitle, data, or attachment)
+	Type  string `json:"type"`
+	Width *int   `json:"width,omitempty"`
+}
+
+// SubtitleOptions Controls the output subtitles
+type SubtitleOptions struct {
+	// BurnForced Burn the source's forced subtitle track (foreign-language dialog), detected by its forced disposition flag, into the video.  Ignored if the source has no forced track.
+	BurnForced *bool `json:"burnForced,omitempty"`
+
+	// Captions What to do with broadcast closed captions (CEA-608/708) embedded in the source video, which are dropped by default:
+	// - preserve: convert them to a text subtitle track in the output
+	// - sidecar: extract them to a .cc.srt file next to the output
+	Captions *SubtitleOptionsCaptions `json:"captions,omitempty"`
+
+	// External Sidecar subtitle files to mux into the output as additional tracks, in order
+	External []ExternalSubtitle `json:"external,omitempty"`
+}
+
+// SubtitleOptionsCaptions What to do with broadcast closed captions (CEA-608/708) embedded in the source video, which are dropped by default:
+// - preserve: convert them to a text subtitle track in the output
+// - sidecar: extract them to a .cc.srt file next to the output
+type SubtitleOptionsCaptions string
+
+// ToolVersions Versions of the encoding tools, keyed by tool name.  On a job, the tools its profile uses on the worker that last ran it.
+type ToolVersions map[string]string
+
+// TranscodeEvent defines model for TranscodeEvent.
+type TranscodeEvent struct {
+	// Attempt The attempt number at the time of the transition (0 before the first run)
+	Attempt int `json:"attempt"`
+
+	// Error The error that caused a retry or failure, if any
+	Error *string `json:"error,omitempty"`
+
+	// OccurredAt When the transition happened
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// State The underlying queue state, which distinguishes e.g. a retry from a first run
+	State string `json:"state"`
+
+	// Status Current status of the transcode job
+	Status TranscodeStatus `json:"status"`
+}
+
+// TranscodeEventList defines model for TranscodeEventList.
+type TranscodeEventList struct {
+	Events []TranscodeEvent `json:"events"`
+}
+
+// TranscodeJob defines model for TranscodeJob.
+type TranscodeJob struct {
+	// BitrateKbps Current output bitrate in kilobits per second, while the job is running
+	BitrateKbps *float64 `json:"bitrateKbps,omitempty"`
+
+	// Commands The encoder command lines the latest attempt has run so far, in the order they started, as POSIX shell commands
+	// for reproducing the encode by hand.  Passwords and keys in them are replaced with REDACTED, and probes aren't
+	// included.
+	Commands []string `json:"commands,omitempty"`
+
+	// CreatedAt Timestamp when the job was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DestinationPath Path for the transcoded output file
+	DestinationPath string `json:"destinationPath"`
+
+	// Error Error message if the transcode failed
+	Error *string `json:"error,omitempty"`
+
+	// ErrorCode Machine-readable failure code if the transcode failed:
+	// - MOUNT_UNAVAILABLE: a media mount was missing, not writable, or timed out (retried)
+	// - MEMORY_LIMIT_EXCEEDED: the encoder exceeded the worker's memory limit
+	// - SOURCE_NOT_FOUND: the source file does not exist
+	// - INVALID_INPUT: the source could not be decoded
+	// - DISK_FULL: the destination filesystem ran out of space (retried)
+	// - ENCODER_NOT_INSTALLED: ffmpeg or HandBrake is not installed on the worker
+	// - CANCELLED: the job was cancelled while running
+	// - FFMPEG_ERROR: ffmpeg failed for another reason (retried)
+	// - HANDBRAKE_ERROR: HandBrake failed for another reason (retried)
+	// - STALLED: the job's worker died or its progress stopped advancing (retried)
+	ErrorCode *ErrorCode `json:"errorCode,omitempty"`
+
+	// EstimatedSecondsRemaining Estimated seconds until the transcode finishes, while the job is running
+	EstimatedSecondsRemaining *float64 `json:"estimatedSecondsRemaining,omitempty"`
+
+	// Fps Current encoding rate in frames per second, while the job is running
+	Fps *float64 `json:"fps,omitempty"`
+
+	// Frame Number of frames encoded so far, while the job is running
+	Frame *int64 `json:"frame,omitempty"`
+
+	// GroupId Group the job was added to, if any
+	GroupId *string `json:"groupId,omitempty"`
+
+	// Labels Arbitrary client-defined string labels attached to the job
+	Labels *Labels `json:"labels,omitempty"`
+
+	// Partial True if a soft cancel stopped the encoder early and the outputs it finalized were kept
+	Partial *bool `json:"partial,omitempty"`
+
+	// PausedAt When the job was stopped because its worker shut down.  Progress and positionSeconds are where it stopped; the
+	// job is pending until another worker picks it up, and this is cleared once it does.
+	PausedAt *time.Time `json:"pausedAt,omitempty"`
+
+	// PositionSeconds How far into the source the encoder has got, in seconds, if the encoder reports it
+	PositionSeconds *float64 `json:"positionSeconds,omitempty"`
+
+	// Priority Priority of the job; workers take pending jobs with a lower number first
+	Priority *int `json:"priority,omitempty"`
+
+	// Profile Transcoding profile used
+	Profile string `json:"profile"`
+
+	// Progress Transcoding progress percentage
+	Progress float64 `json:"progress"`
+
+	// Renditions Status of each rendition of a renditions job, once it has started
+	Renditions []RenditionStatus `json:"renditions,omitempty"`
+
+	// ReusedFrom UUID of the completed job whose outputs this job reused instead of encoding the source again
+	ReusedFrom *openapi_types.UUID `json:"reusedFrom,omitempty"`
+
+	// SkippedExisting True if the job was submitted with skipIfValid and its outputs already existed and passed verification
+	SkippedExisting *bool `json:"skippedExisting,omitempty"`
+
+	// SourcePath Path to the source video file
+	SourcePath string `json:"sourcePath"`
+
+	// SourceSha256 SHA-256 of the source's contents, for jobs submitted with reuseCompleted
+	SourceSha256 *string `json:"sourceSha256,omitempty"`
+
+	// Speed Encoding speed as a multiple of realtime, while the job is running
+	Speed *float64 `json:"speed,omitempty"`
+
+	// Status Current status of the transcode job
+	Status TranscodeStatus `json:"status"`
+
+	// ToolVersions Versions of the encoding tools, keyed by tool name.  On a job, the tools its profile uses on the worker that last ran it.
+	ToolVersions *ToolVersions `json:"toolVersions,omitempty"`
+
+	// UpdatedAt Timestamp when the job was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Uuid Unique identifier for the transcode job
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+// TranscodeJobList defines model for TranscodeJobList.
+type TranscodeJobList struct {
+	Jobs []TranscodeJob `json:"jobs"`
+
+	// NextCursor Cursor for fetching the next page.  Absent when there are no more jobs.
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// TranscodeOutput defines model for TranscodeOutput.
+type TranscodeOutput struct {
+	// ExpiresAt Timestamp after which the URL is no longer valid
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Url Signed URL for downloading the output file.  Relative to the API server unless a public URL is configured.
+	Url string `json:"url"`
+}
+
+// TranscodeRequest defines model for TranscodeRequest.
+type TranscodeRequest struct {
+	// Animation Configures the animated profile, which renders at 10 frames per second
+	Animation *AnimationOptions `json:"animation,omitempty"`
+
+	// Audio Overrides the profile's audio encoding
+	Audio *AudioOptions `json:"audio,omitempty"`
+
+	// Chapters Chapters for sources that have none.  Sources with chapters keep their own.  Only supported by profiles that write a
+	// single output covering the whole source, so not by preview_clip, animated, renditions, abr, or plugin profiles.
+	Chapters *ChapterOptions `json:"chapters,omitempty"`
+
+	// Compare Compare the output against the source once the transcode completes.  The report is available from
+	// GET /comparisons/{uuid} with the transcode's UUID.  Only supported by profiles that write a single output covering
+	// the whole source, so not by preview_clip, animated, renditions, abr, or plugin profiles.
+	Compare *CompareOptions `json:"compare,omitempty"`
+
+	// DestinationPath Path for the transcoded output file
+	DestinationPath string `json:"destinationPath"`
+
+	// GroupId Optional client-defined group to add the job to.  The group's aggregate status is available from GET /groups/{groupId}.
+	GroupId *string `json:"groupId,omitempty"`
+
+	// Handbrake HandBrakeCLI settings layered onto the profile's preset.  Only supported by the HandBrake-based profiles (fast1080p30,
+	// archive, and hdr), and only the values listed are accepted.
+	Handbrake *HandBrakeOptions `json:"handbrake,omitempty"`
+
+	// HeartbeatIntervalSeconds Optional interval between progress updates and heartbeat webhooks.  Defaults to the worker's configured interval.
+	// Heartbeat webhooks are only sent once progress has also advanced by the worker's configured minimum since the last one.
+	HeartbeatIntervalSeconds *int `json:"heartbeatIntervalSeconds,omitempty"`
+
+	// HeartbeatWebhookUri Optional URI to POST heartbeat webhook notifications with progress updates during transcoding
+	HeartbeatWebhookUri *string `json:"heartbeatWebhookUri,omitempty"`
+
+	// Labels Arbitrary client-defined string labels attached to the job
+	Labels *Labels `json:"labels,omitempty"`
+
+	// Output Sets the owner and permissions of the output files once they have been written.  Each field overrides the worker's
+	// configured default; fields that are unset everywhere are left as the encoder created them.
+	Output *OutputOwnership `json:"output,omitempty"`
+
+	// ParallelSegments Optional number of keyframe-aligned segments to encode in parallel and then concatenate; defaults to 1.  Sources are split into segments of at most ten minutes, which are kept until the output is assembled, so a retry after a worker crash resumes from the first segment not yet encoded.  Sources shorter than a few minutes, and profiles that don't support parallelSegments, are encoded in one piece and start over when retried.
+	ParallelSegments *int `json:"parallelSegments,omitempty"`
+
+	// Profile Transcoding profile to use (preview, preview_clip, animated, renditions, abr, fast1080p30, archive, webm, hdr, prores_proxy, or dnxhr_lb).
+	// renditions decodes the source once and encodes each of the requested renditions as H.264 and AAC; it doesn't support
+	// parallelSegments and ignores the video, streams, and subtitles options.
+	// abr packages an adaptive-bitrate ladder of renditions for streaming, as HLS when destinationPath is an .m3u8 master
+	// playlist or DASH when it is an .mpd manifest, with the segments written alongside.  Its audio must be aac, ac3,
+	// or eac3.  It has the same restrictions as renditions.
+	// preview_clip produces a 30 second 480p clip sampled from several points in the source.
+	// animated produces a short looping GIF or animated WebP, chosen by the destinationPath extension, configured by animation.
+	// Neither supports parallelSegments, and both ignore the audio, video, streams, and subtitles options.
+	// Profiles starting with x- are encoded by transcoder plugins installed on the workers, and wait until a worker with
+	// the plugin is running.  They don't support parallelSegments.
+	Profile string `json:"profile"`
+
+	// Renditions Outputs of the renditions profile, which requires at least one.  Each is written next to destinationPath
+	// with the rendition name appended to the file name, e.g. movie_720p.mp4 for destinationPath movie.mp4.
+	// For the abr profile, the ladder steps, each of which needs videoBitrateKbps; defaults to 1080p at 5000 kbps,
+	// 720p at 3000 kbps, 480p at 1400 kbps, and 360p at 800 kbps.
+	Renditions []Rendition `json:"renditions,omitempty"`
+
+	// ReuseCompleted Skip encoding if a job that also set reuseCompleted recently completed with a source of identical contents, the
+	// same destinationPath, and the same encoding options, and its outputs are still in place.  The job completes
+	// with that job's results and reusedFrom set.  The worker hashes the whole source first.  Completed jobs are
+	// retained, and so can be reused, for 24 hours.
+	ReuseCompleted *bool `json:"reuseCompleted,omitempty"`
+
+	// SkipIfValid Skip encoding if the outputs already exist, have the profile's video codec, and, for profiles that cover the whole
+	// source, the source's duration.  The job completes with skippedExisting set.  Not supported by the abr profile or
+	// plugin profiles.
+	SkipIfValid *bool `json:"skipIfValid,omitempty"`
+
+	// SourcePath Path to the source video file
+	SourcePath string `json:"sourcePath"`
+
+	// Streams Overrides which source streams are kept, for multi-angle and multi-language sources.
+	// Streams are numbered from zero among the streams of the same type, like ffmpeg's -map 0:a:N.
+	// A job fails with INVALID_INPUT if the source doesn't have a selected stream.
+	Streams *StreamSelection `json:"streams,omitempty"`
+
+	// Subtitles Controls the output subtitles
+	Subtitles *SubtitleOptions `json:"subtitles,omitempty"`
+
+	// Uuid Client-provided UUID for the transcode job
+	Uuid openapi_types.UUID `json:"uuid"`
+
+	// Video Adjusts the profile's video processing.  Ignored by the preview and preview_clip profiles.
+	Video *VideoOptions `json:"video,omitempty"`
+
+	// WebhookToken Optional opaque token to include in webhook payload for authentication
+	WebhookToken []byte `json:"webhookToken,omitempty"`
+
+	// WebhookTokenHeader Optional name of a header to send webhookToken in, base64-encoded, instead of the payload, since many receivers
+	// log request bodies.  In an Authorization header the token follows "Bearer ".
+	WebhookTokenHeader *string `json:"webhookTokenHeader,omitempty"`
+
+	// WebhookUri Optional URI to POST webhook notification when job completes
+	WebhookUri *string `json:"webhookUri,omitempty"`
+
+	// Webhooks Additional webhook destinations, each with its own token and event filter
+	Webhooks []WebhookTarget `json:"webhooks,omitempty"`
+}
+
+// TranscodeStatus Current status of the transcode job
+type TranscodeStatus string
+
+// TranscodeStatusList defines model for TranscodeStatusList.
+type TranscodeStatusList struct {
+	// Jobs The jobs that were found, in the order their UUIDs were requested
+	Jobs []TranscodeJob `json:"jobs"`
+
+	// NotFound The requested UUIDs that don't match any job
+	NotFound []openapi_types.UUID `json:"notFound"`
+}
+
+// TranscodeStatusRequest defines model for TranscodeStatusRequest.
+type TranscodeStatusRequest struct {
+	// Uuids UUIDs of the transcode jobs to look up
+	Uuids []openapi_types.UUID `json:"uuids"`
+}
+
+// TranscodeValidation defines model for TranscodeValidation.
+type TranscodeValidation struct {
+	// Errors Every problem found with the request
+	Errors []FieldError `json:"errors"`
+
+	// Valid True if createTranscode would accept the request
+	Valid bool `json:"valid"`
+}
+
+// UpdateTranscodeRequest defines model for UpdateTranscodeRequest.
+type UpdateTranscodeRequest struct {
+	// DestinationPath New path for the job's output
+	DestinationPath *string `json:"destinationPath,omitempty"`
+
+	// Priority New priority.  Workers take pending jobs with a lower number first.
+	Priority *int `json:"priority,omitempty"`
+
+	// Profile New transcoding profile, as in TranscodeRequest
+	Profile *string `json:"profile,omitempty"`
+
+	// Webhooks Replaces all the job's completion webhooks, including one given by webhookUri.  An empty array removes them.
+	Webhooks []WebhookTarget `json:"webhooks,omitempty"`
+}
+
+// VMAFScore VMAF of the output's first video stream against the source's, from 0 to 100, pooled over every frame
+type VMAFScore struct {
+	HarmonicMean float64 `json:"harmonicMean"`
+	Max          float64 `json:"max"`
+	Mean         float64 `json:"mean"`
+	Min          float64 `json:"min"`
+}
+
+// VideoOptions Adjusts the profile's video processing.  Ignored by the preview and preview_clip profiles.
+type VideoOptions struct {
+	// Denoise Denoise filter to apply before encoding; hqdn3d is fast, nlmeans is slower but keeps more detail
+	Denoise *VideoOptionsDenoise `json:"denoise,omitempty"`
+
+	// DenoiseLevel Strength of the denoise filter; defaults to medium
+	DenoiseLevel *VideoOptionsDenoiseLevel `json:"denoiseLevel,omitempty"`
+
+	// Detelecine Detect 3:2 pulldown (soft or hard telecine, as on NTSC film DVDs) and restore the original 23.976 fps progressive frames.
+	// Sources without pulldown are encoded as usual.
+	Detelecine *bool `json:"detelecine,omitempty"`
+
+	// GrainTune Tune the encoder to retain film grain rather than smear it.  Only supported by the fast1080p30, archive, and hdr profiles.
+	GrainTune *bool `json:"grainTune,omitempty"`
+
+	// PerTitle Content-adaptive encoding: an analysis pass measures how hard the source is to compress and picks the CRF within
+	// these bounds, so simple content gets smaller files and complex content keeps its quality.  Only supported by the
+	// fast1080p30, archive, hdr, and renditions profiles; renditions with videoBitrateKbps keep their bitrate.
+	PerTitle *PerTitleOptions `json:"perTitle,omitempty"`
+}
+
+// VideoOptionsDenoise Denoise filter to apply before encoding; hqdn3d is fast, nlmeans is slower but keeps more detail
+type VideoOptionsDenoise string
+
+// VideoOptionsDenoiseLevel Strength of the denoise filter; defaults to medium
+type VideoOptionsDenoiseLevel string
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts Number of delivery attempts made so far
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FinalizedAt When the delivery succeeded or was abandoned
+	FinalizedAt *time.Time `json:"finalizedAt,omitempty"`
+
+	// Heartbeat Whether this is a heartbeat rather than a completion webhook
+	Heartbeat bool `json:"heartbeat"`
+
+	// LastError Error from the latest failed attempt, if any
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastStatusCode HTTP status code returned by the receiver on the latest attempt, if it responded
+	LastStatusCode *int `json:"lastStatusCode,omitempty"`
+
+	// MaxAttempts Number of attempts after which delivery is abandoned
+	MaxAttempts int `json:"maxAttempts"`
+
+	// Status Delivery status:
+	// * queued - Not yet attempted, or waiting to be retried
+	// * delivered - The receiver responded with a 2xx status
+	// * abandoned - Delivery was given up after exhausting its attempts
+	Status WebhookDeliveryStatus `json:"status"`
+
+	// Uri The URI the webhook is POSTed to
+	Uri string `json:"uri"`
+}
+
+// WebhookDeliveryList defines model for WebhookDeliveryList.
+type WebhookDeliveryList struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+// WebhookDeliveryStatus Delivery status:
+// * queued - Not yet attempted, or waiting to be retried
+// * delivered - The receiver responded with a 2xx status
+// * abandoned - Delivery was given up after exhausting its attempts
+type WebhookDeliveryStatus string
+
+// WebhookEvent A job event a webhook destination can subscribe to
+type WebhookEvent string
+
+// WebhookKey An ed25519 public key as a JSON Web Key (RFC 8037)
+type WebhookKey struct {
+	// Alg Always EdDSA
+	Alg *string `json:"alg,omitempty"`
+
+	// Crv Always Ed25519
+	Crv string `json:"crv"`
+
+	// Kid Key ID, as sent in the X-VT-Key-ID header of the deliveries it signs
+	Kid string `json:"kid"`
+
+	// Kty Always OKP
+	Kty string `json:"kty"`
+
+	// Use Always sig
+	Use *string `json:"use,omitempty"`
+
+	// X The public key, base64url-encoded without padding
+	X string `json:"x"`
+}
+
+// WebhookKeySet defines model for WebhookKeySet.
+type WebhookKeySet struct {
+	Keys []WebhookKey `json:"keys"`
+}
+
+// WebhookPayload JSON body POSTed to webhookUri, heartbeatWebhookUri, and webhooks destinations
+type WebhookPayload struct {
+	// BitrateKbps Current output bitrate in kilobits per second.  Only present in heartbeat webhooks.
+	BitrateKbps *float64 `json:"bitrateKbps,omitempty"`
+
+	// DestinationPath Path of the output file.  Only present in completion webhooks.
+	DestinationPath *string `json:"destinationPath,omitempty"`
+
+	// EncodeSeconds Wall-clock time spent transcoding, in seconds.  Only present in completion webhooks.
+	EncodeSeconds *float64 `json:"encodeSeconds,omitempty"`
+
+	// Error Error message if the transcode failed
+	Error *string `json:"error,omitempty"`
+
+	// ErrorCode Machine-readable failure code if the transcode failed:
+	// - MOUNT_UNAVAILABLE: a media mount was missing, not writable, or timed out (retried)
+	// - MEMORY_LIMIT_EXCEEDED: the encoder exceeded the worker's memory limit
+	// - SOURCE_NOT_FOUND: the source file does not exist
+	// - INVALID_INPUT: the source could not be decoded
+	// - DISK_FULL: the destination filesystem ran out of space (retried)
+	// - ENCODER_NOT_INSTALLED: ffmpeg or HandBrake is not installed on the worker
+	// - CANCELLED: the job was cancelled while running
+	// - FFMPEG_ERROR: ffmpeg failed for another reason (retried)
+	// - HANDBRAKE_ERROR: HandBrake failed for another reason (retried)
+	// - STALLED: the job's worker died or its progress stopped advancing (retried)
+	ErrorCode *ErrorCode `json:"errorCode,omitempty"`
+
+	// EstimatedSecondsRemaining Estimated seconds until the transcode finishes.  Only present in heartbeat webhooks.
+	EstimatedSecondsRemaining *float64 `json:"estimatedSecondsRemaining,omitempty"`
+
+	// Fps Current encoding rate in frames per second.  Only present in heartbeat webhooks.
+	Fps *float64 `json:"fps,omitempty"`
+
+	// Frame Number of frames encoded so far.  Only present in heartbeat webhooks.
+	Frame *int64 `json:"frame,omitempty"`
+
+	// Labels Arbitrary client-defined string labels attached to the job
+	Labels *Labels `json:"labels,omitempty"`
+
+	// OutputDurationSeconds Duration of the output file in seconds.  Only present in completion webhooks for successful jobs.
+	OutputDurationSeconds *float64 `json:"outputDurationSeconds,omitempty"`
+
+	// OutputSizeBytes Size of the output file in bytes.  Only present in completion webhooks for successful jobs.
+	OutputSizeBytes *int64 `json:"outputSizeBytes,omitempty"`
+
+	// Profile Transcoding profile used.  Only present in completion webhooks.
+	Profile *string `json:"profile,omitempty"`
+
+	// Progress Transcoding progress percentage.  Only present in heartbeat webhooks.
+	Progress *float64 `json:"progress,omitempty"`
+
+	// Renditions Status of each rendition of a renditions job
+	Renditions []RenditionStatus `json:"renditions,omitempty"`
+
+	// RequestId X-Request-ID of the API call that created the job.  Also sent as the X-Request-ID header.
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Speed Encoding speed as a multiple of realtime.  Only present in heartbeat webhooks.
+	Speed *float64 `json:"speed,omitempty"`
+
+	// Token The webhookToken provided when the job was created
+	Token []byte `json:"token,omitempty"`
+
+	// Uuid UUID of the transcode job
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+// WebhookTarget defines model for WebhookTarget.
+type WebhookTarget struct {
+	// Events Events to deliver to this URI; defaults to completed and failed
+	Events []WebhookEvent `json:"events,omitempty"`
+
+	// Token Optional opaque token to include in webhook payloads sent to this URI
+	Token []byte `json:"token,omitempty"`
+
+	// TokenHeader Optional name of a header to send token in instead of the payload, as with webhookTokenHeader
+	TokenHeader *string `json:"tokenHeader,omitempty"`
+
+	// Uri URI to POST webhook notifications to
+	Uri string `json:"uri"`
+}
+
+// Worker defines model for Worker.
+type Worker struct {
+	// Capabilities Encoding tools that ran successfully when the worker started, such as ffmpeg and HandBrakeCLI
+	Capabilities []string `json:"capabilities"`
+
+	// CurrentJob UUID of the transcode job the worker is running, if any
+	CurrentJob *openapi_types.UUID `json:"currentJob,omitempty"`
+
+	// Hostname Host the worker is running on
+	Hostname string `json:"hostname"`
+
+	// Id Unique ID of the worker process
+	Id string `json:"id"`
+
+	// LastHeartbeatAt Timestamp of the worker's last heartbeat
+	LastHeartbeatAt time.Time `json:"lastHeartbeatAt"`
+
+	// Progress Progress of the current job, if any
+	Progress *float64 `json:"progress,omitempty"`
+
+	// StartedAt Timestamp when the worker started
+	StartedAt time.Time `json:"startedAt"`
+
+	// ToolVersions Versions of the encoding tools, keyed by tool name.  On a job, the tools its profile uses on the worker that last ran it.
+	ToolVersions ToolVersions `json:"toolVersions"`
+}
+
+// WorkerList defines model for WorkerList.
+type WorkerList struct {
+	// Workers Active workers, ordered by hostname
+	Workers []Worker `json:"workers"`
+}
+
+// Workflow defines model for Workflow.
+type Workflow struct {
+	// CreatedAt Timestamp when the workflow was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Status Current status of the transcode job
+	Status TranscodeStatus      `json:"status"`
+	Steps  []WorkflowStepStatus `json:"steps"`
+
+	// UpdatedAt Timestamp when a step of the workflow was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Uuid Unique identifier for the workflow
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+// WorkflowRequest defines model for WorkflowRequest.
+type WorkflowRequest struct {
+	Steps []WorkflowStep `json:"steps"`
+
+	// Uuid Client-provided UUID identifying the workflow
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+// WorkflowStep defines model for WorkflowStep.
+type WorkflowStep struct {
+	// CompareDuration Whether a verify step also checks that the output's duration matches the source's
+	CompareDuration *bool `json:"compareDuration,omitempty"`
+
+	// DependsOn Names of the steps that must complete successfully before this one starts
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// Name Name of the step, unique within the workflow
+	Name string `json:"name"`
+
+	// Path File to probe.  Required for probe steps.
+	Path *string `json:"path,omitempty"`
+
+	// Target Name of the transcode step whose output a verify step checks.  The target is an implicit dependency.  Required for verify steps.
+	Target    *string           `json:"target,omitempty"`
+	Transcode *TranscodeRequest `json:"transcode,omitempty"`
+
+	// Type What a workflow step does.  Thumbnails and previews are transcode steps using the animated or preview_clip profile.
+	Type WorkflowStepType `json:"type"`
+
+	// WebhookToken Optional opaque token to include in the webhook payload
+	WebhookToken []byte `json:"webhookToken,omitempty"`
+
+	// WebhookUri URI to POST a WorkflowWebhookPayload to.  Required for webhook steps.
+	WebhookUri *string `json:"webhookUri,omitempty"`
+}
+
+// WorkflowStepStatus defines model for WorkflowStepStatus.
+type WorkflowStepStatus struct {
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// DurationSeconds Duration of the file checked by a probe or verify step, in seconds
+	DurationSeconds *float64 `json:"durationSeconds,omitempty"`
+
+	// Error Error message if the step failed or was cancelled
+	Error *string `json:"error,omitempty"`
+
+	// ErrorCode Machine-readable failure code if the transcode failed:
+	// - MOUNT_UNAVAILABLE: a media mount was missing, not writable, or timed out (retried)
+	// - MEMORY_LIMIT_EXCEEDED: the encoder exceeded the worker's memory limit
+	// - SOURCE_NOT_FOUND: the source file does not exist
+	// - INVALID_INPUT: the source could not be decoded
+	// - DISK_FULL: the destination filesystem ran out of space (retried)
+	// - ENCODER_NOT_INSTALLED: ffmpeg or HandBrake is not installed on the worker
+	// - CANCELLED: the job was cancelled while running
+	// - FFMPEG_ERROR: ffmpeg failed for another reason (retried)
+	// - HANDBRAKE_ERROR: HandBrake failed for another reason (retried)
+	// - STALLED: the job's worker died or its progress stopped advancing (retried)
+	ErrorCode *ErrorCode `json:"errorCode,omitempty"`
+
+	// Name Name of the step
+	Name string `json:"name"`
+
+	// SizeBytes Size of the file checked by a probe or verify step, in bytes
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+
+	// Status Current status of the transcode job
+	Status    TranscodeStatus `json:"status"`
+	Transcode *TranscodeJob   `json:"transcode,omitempty"`
+
+	// Type What a workflow step does.  Thumbnails and previews are transcode steps using the animated or preview_clip profile.
+	Type WorkflowStepType `json:"type"`
+}
+
+// WorkflowStepType What a workflow step does.  Thumbnails and previews are transcode steps using the animated or preview_clip profile.
+type WorkflowStepType string
+
+// WorkflowWebhookPayload JSON body POSTed by webhook steps of a workflow
+type WorkflowWebhookPayload struct {
+	// Step Name of the webhook step
+	Step string `json:"step"`
+
+	// Token The webhookToken provided for the step
+	Token []byte `json:"token,omitempty"`
+
+	// WorkflowId UUID of the workflow
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
+// ListApiTokensParams defines parameters for ListApiTokens.
+type ListApiTokensParams struct {
+	// Tenant Only list the tokens of this tenant
+	Tenant *string `form:"tenant,omitempty" json:"tenant,omitempty"`
+}
+
+// ListTranscodesParams defines parameters for ListTranscodes.
+type ListTranscodesParams struct {
+	// Status Only return jobs with this status
+	Status *TranscodeStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Label Only return jobs carrying this label, given as key=value.  May be repeated; jobs must match every label.
+	Label []string `form:"label,omitempty" json:"label,omitempty"`
+
+	// Limit Maximum number of jobs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from a previous response's nextCursor, to fetch the next page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ExportTranscodesParams defines parameters for ExportTranscodes.
+type ExportTranscodesParams struct {
+	// Status Only export jobs with this status
+	Status *TranscodeStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Label Only export jobs carrying this label, given as key=value.  May be repeated; jobs must match every label.
+	Label []string `form:"label,omitempty" json:"label,omitempty"`
+
+	// Format Format to export the jobs in
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ValidateTranscodeParams defines parameters for ValidateTranscode.
+type ValidateTranscodeParams struct {
+	// ProbeSource Also verify that the source file exists.  Requires the server to share the media mount with the workers.
+	ProbeSource *bool `form:"probeSource,omitempty" json:"probeSource,omitempty"`
+}
+
+// GetTranscodeStatusParams defines parameters for GetTranscodeStatus.
+type GetTranscodeStatusParams struct {
+	// WaitForChange Hold the request open until the job's status or progress differs from when the request arrived, or the timeout passes.  The job is returned either way.
+	WaitForChange *bool `form:"waitForChange,omitempty" json:"waitForChange,omitempty"`
+
+	// TimeoutSeconds How long waitForChange holds the request open
+	TimeoutSeconds *int `form:"timeoutSeconds,omitempty" json:"timeoutSeconds,omitempty"`
+
+	// IfNoneMatch ETag from a previous response.  If the job still matches it, a 304 is returned without a body.  With waitForChange, the request is held until the job no longer matches it.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// CancelTranscodeParams defines parameters for CancelTranscode.
+type CancelTranscodeParams struct {
+	// Mode How to stop the job if it is running
+	Mode *CancelMode `form:"mode,omitempty" json:"mode,omitempty"`
+}
+
+// GetTranscodeLogParams defines parameters for GetTranscodeLog.
+type GetTranscodeLogParams struct {
+	// Follow Keep the response open and stream new output until the job finishes
+	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
+}
+
+// DownloadTranscodeOutputParams defines parameters for DownloadTranscodeOutput.
+type DownloadTranscodeOutputParams struct {
+	// Expires Unix timestamp after which the URL is no longer valid
+	Expires int64 `form:"expires" json:"expires"`
+
+	// Signature Hex-encoded signature of the UUID and expiry
+	Signature string `form:"signature" json:"signature"`
+}
+
+// ListTranscodeWebhooksParams defines parameters for ListTranscodeWebhooks.
+type ListTranscodeWebhooksParams struct {
+	// IncludeHeartbeats Also list heartbeat webhooks, of which there may be many
+	IncludeHeartbeats *bool `form:"includeHeartbeats,omitempty" json:"includeHeartbeats,omitempty"`
+}
+
+// CreateApiTokenJSONRequestBody defines body for CreateApiToken for application/json ContentType.
+type CreateApiTokenJSONRequestBody = ApiTokenRequest
+
+// CreateComparisonJSONRequestBody defines body for CreateComparison for application/json ContentType.
+type CreateComparisonJSONRequestBody = ComparisonRequest
+
+// EstimateTranscodeJSONRequestBody defines body for EstimateTranscode for application/json ContentType.
+type EstimateTranscodeJSONRequestBody = EstimateRequest
+
+// CreateTranscodeJSONRequestBody defines body for CreateTranscode for application/json ContentType.
+type CreateTranscodeJSONRequestBody = TranscodeRequest
+
+// CancelTranscodesJSONRequestBody defines body for CancelTranscodes for application/json ContentType.
+type CancelTranscodesJSONRequestBody = BulkTranscodeRequest
+
+// CreateDirectoryTranscodeJSONRequestBody defines body for CreateDirectoryTranscode for application/json ContentType.
+type CreateDirectoryTranscodeJSONRequestBody = DirectoryTranscodeRequest
+
+// RetryTranscodesJSONRequestBody defines body for RetryTranscodes for application/json ContentType.
+type RetryTranscodesJSONRequestBody = BulkTranscodeRequest
+
+// GetTranscodeStatusesJSONRequestBody defines body for GetTranscodeStatuses for application/json ContentType.
+type GetTranscodeStatusesJSONRequestBody = TranscodeStatusRequest
+
+// ValidateTranscodeJSONRequestBody defines body for ValidateTranscode for application/json ContentType.
+type ValidateTranscodeJSONRequestBody = TranscodeRequest
+
+// UpdateTranscodeJSONRequestBody defines body for UpdateTranscode for application/json ContentType.
+type UpdateTranscodeJSONRequestBody = UpdateTranscodeRequest
+
+// CloneTranscodeJSONRequestBody defines body for CloneTranscode for application/json ContentType.
+type CloneTranscodeJSONRequestBody = CloneTranscodeRequest
+
+// CreateWorkflowJSONRequestBody defines body for CreateWorkflow for application/json ContentType.
+type CreateWorkflowJSONRequestBody = WorkflowRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListWebhookKeys request
+	ListWebhookKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListApiTokens request
+	ListApiTokens(ctx context.Context, params *ListApiTokensParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateApiTokenWithBody request with any body
+	CreateApiTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateApiToken(ctx context.Context, body CreateApiTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeApiToken request
+	RevokeApiToken(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateApiToken request
+	RotateApiToken(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateComparisonWithBody request with any body
+	CreateComparisonWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateComparison(ctx context.Context, body CreateComparisonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetComparison request
+	GetComparison(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EstimateTranscodeWithBody request with any body
+	EstimateTranscodeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EstimateTranscode(ctx context.Context, body EstimateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetGroupStatus request
+	GetGroupStatus(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListQueues request
+	ListQueues(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetQueueScaling request
+	GetQueueScaling(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReadiness request
+	GetReadiness(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTranscodes request
+	ListTranscodes(ctx context.Context, params *ListTranscodesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTranscodeWithBody request with any body
+	CreateTranscodeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTranscode(ctx context.Context, body CreateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelTranscodesWithBody request with any body
+	CancelTranscodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CancelTranscodes(ctx context.Context, body CancelTranscodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateDirectoryTranscodeWithBody request with any body
+	CreateDirectoryTranscodeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateDirectoryTranscode(ctx context.Context, body CreateDirectoryTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportTranscodes request
+	ExportTranscodes(ctx context.Context, params *ExportTranscodesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RetryTranscodesWithBody request with any body
+	RetryTranscodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RetryTranscodes(ctx context.Context, body RetryTranscodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTranscodeStatusesWithBody request with any body
+	GetTranscodeStatusesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetTranscodeStatuses(ctx context.Context, body GetTranscodeStatusesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ValidateTranscodeWithBody request with any body
+	ValidateTranscodeWithBody(ctx context.Context, params *ValidateTranscodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateTranscode(ctx context.Context, params *ValidateTranscodeParams, body ValidateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTranscodeStatus request
+	GetTranscodeStatus(ctx context.Context, uuid openapi_types.UUID, params *GetTranscodeStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTranscodeWithBody request with any body
+	UpdateTranscodeWithBody(ctx context.Context, uuid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTranscode(ctx context.Context, uuid openapi_types.UUID, body UpdateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelTranscode request
+	CancelTranscode(ctx context.Context, uuid openapi_types.UUID, params *CancelTranscodeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloneTranscodeWithBody request with any body
+	CloneTranscodeWithBody(ctx context.Context, uuid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CloneTranscode(ctx context.Context, uuid openapi_types.UUID, body CloneTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTranscodeEvents request
+	GetTranscodeEvents(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTranscodeLog request
+	GetTranscodeLog(ctx context.Context, uuid openapi_types.UUID, params *GetTranscodeLogParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTranscodeOutput request
+	GetTranscodeOutput(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DownloadTranscodeOutput request
+	DownloadTranscodeOutput(ctx context.Context, uuid openapi_types.UUID, params *DownloadTranscodeOutputParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PurgeTranscode request
+	PurgeTranscode(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTranscodeWebhooks request
+	ListTranscodeWebhooks(ctx context.Context, uuid openapi_types.UUID, params *ListTranscodeWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplayTranscodeWebhook request
+	ReplayTranscodeWebhook(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWorkers request
+	ListWorkers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWorkflowWithBody request with any body
+	CreateWorkflowWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWorkflow(ctx context.Context, body CreateWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWorkflowStatus request
+	GetWorkflowStatus(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListWebhookKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhookKeysRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListApiTokens(ctx context.Context, params *ListApiTokensParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListApiTokensRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateApiTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateApiTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateApiToken(ctx context.Context, body CreateApiTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateApiTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeApiToken(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeApiTokenRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RotateApiToken(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateApiTokenRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateComparisonWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateComparisonRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateComparison(ctx context.Context, body CreateComparisonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateComparisonRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetComparison(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetComparisonRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EstimateTranscodeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEstimateTranscodeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EstimateTranscode(ctx context.Context, body EstimateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEstimateTranscodeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetGroupStatus(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetGroupStatusRequest(c.Server, groupId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListQueues(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListQueuesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetQueueScaling(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetQueueScalingRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetReadiness(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReadinessRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTranscodes(ctx context.Context, params *ListTranscodesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTranscodesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTranscodeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTranscodeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTranscode(ctx context.Context, body CreateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTranscodeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelTranscodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelTranscodesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelTranscodes(ctx context.Context, body CancelTranscodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelTranscodesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDirectoryTranscodeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDirectoryTranscodeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDirectoryTranscode(ctx context.Context, body CreateDirectoryTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDirectoryTranscodeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportTranscodes(ctx context.Context, params *ExportTranscodesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportTranscodesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RetryTranscodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetryTranscodesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RetryTranscodes(ctx context.Context, body RetryTranscodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetryTranscodesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTranscodeStatusesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTranscodeStatusesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTranscodeStatuses(ctx context.Context, body GetTranscodeStatusesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTranscodeStatusesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateTranscodeWithBody(ctx context.Context, params *ValidateTranscodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateTranscodeRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateTranscode(ctx context.Context, params *ValidateTranscodeParams, body ValidateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateTranscodeRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTranscodeStatus(ctx context.Context, uuid openapi_types.UUID, params *GetTranscodeStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTranscodeStatusRequest(c.Server, uuid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTranscodeWithBody(ctx context.Context, uuid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTranscodeRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTranscode(ctx context.Context, uuid openapi_types.UUID, body UpdateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTranscodeRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelTranscode(ctx context.Context, uuid openapi_types.UUID, params *CancelTranscodeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelTranscodeRequest(c.Server, uuid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CloneTranscodeWithBody(ctx context.Context, uuid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneTranscodeRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CloneTranscode(ctx context.Context, uuid openapi_types.UUID, body CloneTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneTranscodeRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTranscodeEvents(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTranscodeEventsRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTranscodeLog(ctx context.Context, uuid openapi_types.UUID, params *GetTranscodeLogParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTranscodeLogRequest(c.Server, uuid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTranscodeOutput(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTranscodeOutputRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DownloadTranscodeOutput(ctx context.Context, uuid openapi_types.UUID, params *DownloadTranscodeOutputParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadTranscodeOutputRequest(c.Server, uuid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PurgeTranscode(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPurgeTranscodeRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTranscodeWebhooks(ctx context.Context, uuid openapi_types.UUID, params *ListTranscodeWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTranscodeWebhooksRequest(c.Server, uuid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReplayTranscodeWebhook(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplayTranscodeWebhookRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListWorkers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWorkersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWorkflowWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWorkflowRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWorkflow(ctx context.Context, body CreateWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWorkflowRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWorkflowStatus(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkflowStatusRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListWebhookKeysRequest generates requests for ListWebhookKeys
+func NewListWebhookKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/.well-known/vt-webhook-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListApiTokensRequest generates requests for ListApiTokens
+func NewListApiTokensRequest(server string, params *ListApiTokensParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Tenant != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tenant", runtime.ParamLocationQuery, *params.Tenant); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateApiTokenRequest calls the generic CreateApiToken builder with application/json body
+func NewCreateApiTokenRequest(server string, body CreateApiTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateApiTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateApiTokenRequestWithBody generates requests for CreateApiToken with any type of body
+func NewCreateApiTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokeApiTokenRequest generates requests for RevokeApiToken
+func NewRevokeApiTokenRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRotateApiTokenRequest generates requests for RotateApiToken
+func NewRotateApiTokenRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tokens/%s/rotate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateComparisonRequest calls the generic CreateComparison builder with application/json body
+func NewCreateComparisonRequest(server string, body CreateComparisonJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateComparisonRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateComparisonRequestWithBody generates requests for CreateComparison with any type of body
+func NewCreateComparisonRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/comparisons")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetComparisonRequest generates requests for GetComparison
+func NewGetComparisonRequest(server string, uuid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/comparisons/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEstimateTranscodeRequest calls the generic EstimateTranscode builder with application/json body
+func NewEstimateTranscodeRequest(server string, body EstimateTranscodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEstimateTranscodeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewEstimateTranscodeRequestWithBody generates requests for EstimateTranscode with any type of body
+func NewEstimateTranscodeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/estimate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetGroupStatusRequest generates requests for GetGroupStatus
+func NewGetGroupStatusRequest(server string, groupId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/groups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListQueuesRequest generates requests for ListQueues
+func NewListQueuesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/queues")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetQueueScalingRequest generates requests for GetQueueScaling
+func NewGetQueueScalingRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/queues/%s/scaling", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReadinessRequest generates requests for GetReadiness
+func NewGetReadinessRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/readyz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTranscodesRequest generates requests for ListTranscodes
+func NewListTranscodesRequest(server string, params *ListTranscodesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "label", runtime.ParamLocationQuery, params.Label); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTranscodeRequest calls the generic CreateTranscode builder with application/json body
+func NewCreateTranscodeRequest(server string, body CreateTranscodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTranscodeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTranscodeRequestWithBody generates requests for CreateTranscode with any type of body
+func NewCreateTranscodeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelTranscodesRequest calls the generic CancelTranscodes builder with application/json body
+func NewCancelTranscodesRequest(server string, body CancelTranscodesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCancelTranscodesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCancelTranscodesRequestWithBody generates requests for CancelTranscodes with any type of body
+func NewCancelTranscodesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/cancel")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateDirectoryTranscodeRequest calls the generic CreateDirectoryTranscode builder with application/json body
+func NewCreateDirectoryTranscodeRequest(server string, body CreateDirectoryTranscodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDirectoryTranscodeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateDirectoryTranscodeRequestWithBody generates requests for CreateDirectoryTranscode with any type of body
+func NewCreateDirectoryTranscodeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/directory")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExportTranscodesRequest generates requests for ExportTranscodes
+func NewExportTranscodesRequest(server string, params *ExportTranscodesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "label", runtime.ParamLocationQuery, params.Label); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRetryTranscodesRequest calls the generic RetryTranscodes builder with application/json body
+func NewRetryTranscodesRequest(server string, body RetryTranscodesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRetryTranscodesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRetryTranscodesRequestWithBody generates requests for RetryTranscodes with any type of body
+func NewRetryTranscodesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/retry")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTranscodeStatusesRequest calls the generic GetTranscodeStatuses builder with application/json body
+func NewGetTranscodeStatusesRequest(server string, body GetTranscodeStatusesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGetTranscodeStatusesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGetTranscodeStatusesRequestWithBody generates requests for GetTranscodeStatuses with any type of body
+func NewGetTranscodeStatusesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewValidateTranscodeRequest calls the generic ValidateTranscode builder with application/json body
+func NewValidateTranscodeRequest(server string, params *ValidateTranscodeParams, body ValidateTranscodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewValidateTranscodeRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewValidateTranscodeRequestWithBody generates requests for ValidateTranscode with any type of body
+func NewValidateTranscodeRequestWithBody(server string, params *ValidateTranscodeParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/validate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProbeSource != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "probeSource", runtime.ParamLocationQuery, *params.ProbeSource); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTranscodeStatusRequest generates requests for GetTranscodeStatus
+func NewGetTranscodeStatusRequest(server string, uuid openapi_types.UUID, params *GetTranscodeStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.WaitForChange != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "waitForChange", runtime.ParamLocationQuery, *params.WaitForChange); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TimeoutSeconds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeoutSeconds", runtime.ParamLocationQuery, *params.TimeoutSeconds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUpdateTranscodeRequest calls the generic UpdateTranscode builder with application/json body
+func NewUpdateTranscodeRequest(server string, uuid openapi_types.UUID, body UpdateTranscodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTranscodeRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateTranscodeRequestWithBody generates requests for UpdateTranscode with any type of body
+func NewUpdateTranscodeRequestWithBody(server string, uuid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelTranscodeRequest generates requests for CancelTranscode
+func NewCancelTranscodeRequest(server string, uuid openapi_types.UUID, params *CancelTranscodeParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/%s/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Mode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "mode", runtime.ParamLocationQuery, *params.Mode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCloneTranscodeRequest calls the generic CloneTranscode builder with application/json body
+func NewCloneTranscodeRequest(server string, uuid openapi_types.UUID, body CloneTranscodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCloneTranscodeRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewCloneTranscodeRequestWithBody generates requests for CloneTranscode with any type of body
+func NewCloneTranscodeRequestWithBody(server string, uuid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/%s/clone", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTranscodeEventsRequest generates requests for GetTranscodeEvents
+func NewGetTranscodeEventsRequest(server string, uuid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTranscodeLogRequest generates requests for GetTranscodeLog
+func NewGetTranscodeLogRequest(server string, uuid openapi_types.UUID, params *GetTranscodeLogParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/%s/log", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Follow != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "follow", runtime.ParamLocationQuery, *params.Follow); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTranscodeOutputRequest generates requests for GetTranscodeOutput
+func NewGetTranscodeOutputRequest(server string, uuid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/%s/output", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDownloadTranscodeOutputRequest generates requests for DownloadTranscodeOutput
+func NewDownloadTranscodeOutputRequest(server string, uuid openapi_types.UUID, params *DownloadTranscodeOutputParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/%s/output/download", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires", runtime.ParamLocationQuery, params.Expires); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "signature", runtime.ParamLocationQuery, params.Signature); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPurgeTranscodeRequest generates requests for PurgeTranscode
+func NewPurgeTranscodeRequest(server string, uuid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/%s/purge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTranscodeWebhooksRequest generates requests for ListTranscodeWebhooks
+func NewListTranscodeWebhooksRequest(server string, uuid openapi_types.UUID, params *ListTranscodeWebhooksParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/%s/webhooks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeHeartbeats != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeHeartbeats", runtime.ParamLocationQuery, *params.IncludeHeartbeats); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReplayTranscodeWebhookRequest generates requests for ReplayTranscodeWebhook
+func NewReplayTranscodeWebhookRequest(server string, uuid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transcodes/%s/webhooks/replay", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListWorkersRequest generates requests for ListWorkers
+func NewListWorkersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWorkflowRequest calls the generic CreateWorkflow builder with application/json body
+func NewCreateWorkflowRequest(server string, body CreateWorkflowJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWorkflowRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateWorkflowRequestWithBody generates requests for CreateWorkflow with any type of body
+func NewCreateWorkflowRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workflows")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWorkflowStatusRequest generates requests for GetWorkflowStatus
+func NewGetWorkflowStatusRequest(server string, uuid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workflows/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListWebhookKeysWithResponse request
+	ListWebhookKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWebhookKeysResponse, error)
+
+	// ListApiTokensWithResponse request
+	ListApiTokensWithResponse(ctx context.Context, params *ListApiTokensParams, reqEditors ...RequestEditorFn) (*ListApiTokensResponse, error)
+
+	// CreateApiTokenWithBodyWithResponse request with any body
+	CreateApiTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiTokenResponse, error)
+
+	CreateApiTokenWithResponse(ctx context.Context, body CreateApiTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiTokenResponse, error)
+
+	// RevokeApiTokenWithResponse request
+	RevokeApiTokenWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeApiTokenResponse, error)
+
+	// RotateApiTokenWithResponse request
+	RotateApiTokenWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RotateApiTokenResponse, error)
+
+	// CreateComparisonWithBodyWithResponse request with any body
+	CreateComparisonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateComparisonResponse, error)
+
+	CreateComparisonWithResponse(ctx context.Context, body CreateComparisonJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateComparisonResponse, error)
+
+	// GetComparisonWithResponse request
+	GetComparisonWithResponse(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetComparisonResponse, error)
+
+	// EstimateTranscodeWithBodyWithResponse request with any body
+	EstimateTranscodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EstimateTranscodeResponse, error)
+
+	EstimateTranscodeWithResponse(ctx context.Context, body EstimateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*EstimateTranscodeResponse, error)
+
+	// GetGroupStatusWithResponse request
+	GetGroupStatusWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*GetGroupStatusResponse, error)
+
+	// ListQueuesWithResponse request
+	ListQueuesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListQueuesResponse, error)
+
+	// GetQueueScalingWithResponse request
+	GetQueueScalingWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetQueueScalingResponse, error)
+
+	// GetReadinessWithResponse request
+	GetReadinessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadinessResponse, error)
+
+	// ListTranscodesWithResponse request
+	ListTranscodesWithResponse(ctx context.Context, params *ListTranscodesParams, reqEditors ...RequestEditorFn) (*ListTranscodesResponse, error)
+
+	// CreateTranscodeWithBodyWithResponse request with any body
+	CreateTranscodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTranscodeResponse, error)
+
+	CreateTranscodeWithResponse(ctx context.Context, body CreateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTranscodeResponse, error)
+
+	// CancelTranscodesWithBodyWithResponse request with any body
+	CancelTranscodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelTranscodesResponse, error)
+
+	CancelTranscodesWithResponse(ctx context.Context, body CancelTranscodesJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelTranscodesResponse, error)
+
+	// CreateDirectoryTranscodeWithBodyWithResponse request with any body
+	CreateDirectoryTranscodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDirectoryTranscodeResponse, error)
+
+	CreateDirectoryTranscodeWithResponse(ctx context.Context, body CreateDirectoryTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDirectoryTranscodeResponse, error)
+
+	// ExportTranscodesWithResponse request
+	ExportTranscodesWithResponse(ctx context.Context, params *ExportTranscodesParams, reqEditors ...RequestEditorFn) (*ExportTranscodesResponse, error)
+
+	// RetryTranscodesWithBodyWithResponse request with any body
+	RetryTranscodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RetryTranscodesResponse, error)
+
+	RetryTranscodesWithResponse(ctx context.Context, body RetryTranscodesJSONRequestBody, reqEditors ...RequestEditorFn) (*RetryTranscodesResponse, error)
+
+	// GetTranscodeStatusesWithBodyWithResponse request with any body
+	GetTranscodeStatusesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetTranscodeStatusesResponse, error)
+
+	GetTranscodeStatusesWithResponse(ctx context.Context, body GetTranscodeStatusesJSONRequestBody, reqEditors ...RequestEditorFn) (*GetTranscodeStatusesResponse, error)
+
+	// ValidateTranscodeWithBodyWithResponse request with any body
+	ValidateTranscodeWithBodyWithResponse(ctx context.Context, params *ValidateTranscodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateTranscodeResponse, error)
+
+	ValidateTranscodeWithResponse(ctx context.Context, params *ValidateTranscodeParams, body ValidateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateTranscodeResponse, error)
+
+	// GetTranscodeStatusWithResponse request
+	GetTranscodeStatusWithResponse(ctx context.Context, uuid openapi_types.UUID, params *GetTranscodeStatusParams, reqEditors ...RequestEditorFn) (*GetTranscodeStatusResponse, error)
+
+	// UpdateTranscodeWithBodyWithResponse request with any body
+	UpdateTranscodeWithBodyWithResponse(ctx context.Context, uuid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTranscodeResponse, error)
+
+	UpdateTranscodeWithResponse(ctx context.Context, uuid openapi_types.UUID, body UpdateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTranscodeResponse, error)
+
+	// CancelTranscodeWithResponse request
+	CancelTranscodeWithResponse(ctx context.Context, uuid openapi_types.UUID, params *CancelTranscodeParams, reqEditors ...RequestEditorFn) (*CancelTranscodeResponse, error)
+
+	// CloneTranscodeWithBodyWithResponse request with any body
+	CloneTranscodeWithBodyWithResponse(ctx context.Context, uuid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneTranscodeResponse, error)
+
+	CloneTranscodeWithResponse(ctx context.Context, uuid openapi_types.UUID, body CloneTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneTranscodeResponse, error)
+
+	// GetTranscodeEventsWithResponse request
+	GetTranscodeEventsWithResponse(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTranscodeEventsResponse, error)
+
+	// GetTranscodeLogWithResponse request
+	GetTranscodeLogWithResponse(ctx context.Context, uuid openapi_types.UUID, params *GetTranscodeLogParams, reqEditors ...RequestEditorFn) (*GetTranscodeLogResponse, error)
+
+	// GetTranscodeOutputWithResponse request
+	GetTranscodeOutputWithResponse(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTranscodeOutputResponse, error)
+
+	// DownloadTranscodeOutputWithResponse request
+	DownloadTranscodeOutputWithResponse(ctx context.Context, uuid openapi_types.UUID, params *DownloadTranscodeOutputParams, reqEditors ...RequestEditorFn) (*DownloadTranscodeOutputResponse, error)
+
+	// PurgeTranscodeWithResponse request
+	PurgeTranscodeWithResponse(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*PurgeTranscodeResponse, error)
+
+	// ListTranscodeWebhooksWithResponse request
+	ListTranscodeWebhooksWithResponse(ctx context.Context, uuid openapi_types.UUID, params *ListTranscodeWebhooksParams, reqEditors ...RequestEditorFn) (*ListTranscodeWebhooksResponse, error)
+
+	// ReplayTranscodeWebhookWithResponse request
+	ReplayTranscodeWebhookWithResponse(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*ReplayTranscodeWebhookResponse, error)
+
+	// ListWorkersWithResponse request
+	ListWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWorkersResponse, error)
+
+	// CreateWorkflowWithBodyWithResponse request with any body
+	CreateWorkflowWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWorkflowResponse, error)
+
+	CreateWorkflowWithResponse(ctx context.Context, body CreateWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWorkflowResponse, error)
+
+	// GetWorkflowStatusWithResponse request
+	GetWorkflowStatusWithResponse(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetWorkflowStatusResponse, error)
+}
+
+type ListWebhookKeysResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *WebhookKeySet
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhookKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhookKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListApiTokensResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ApiTokenList
+	ApplicationproblemJSON403 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListApiTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListApiTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateApiTokenResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *ApiTokenSecret
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON403 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateApiTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateApiTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeApiTokenResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ApiToken
+	ApplicationproblemJSON403 *Error
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeApiTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeApiTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RotateApiTokenResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ApiTokenSecret
+	ApplicationproblemJSON403 *Error
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RotateApiTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RotateApiTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateComparisonResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Comparison
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON409 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateComparisonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateComparisonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetComparisonResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Comparison
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetComparisonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetComparisonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EstimateTranscodeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Estimate
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r EstimateTranscodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EstimateTranscodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetGroupStatusResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *JobGroup
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetGroupStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetGroupStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListQueuesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *QueueList
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListQueuesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListQueuesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetQueueScalingResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *QueueScaling
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetQueueScalingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetQueueScalingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReadinessResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Readiness
+	JSON503      *Readiness
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReadinessResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReadinessResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTranscodesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TranscodeJobList
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTranscodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTranscodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTranscodeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *TranscodeJob
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON409 *Error
+	ApplicationproblemJSON429 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTranscodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTranscodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelTranscodesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *BulkTranscodeResult
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelTranscodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelTranscodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDirectoryTranscodeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *DirectoryTranscode
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON429 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDirectoryTranscodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDirectoryTranscodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportTranscodesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportTranscodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportTranscodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RetryTranscodesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *BulkTranscodeResult
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON429 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RetryTranscodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RetryTranscodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTranscodeStatusesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TranscodeStatusList
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTranscodeStatusesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTranscodeStatusesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateTranscodeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TranscodeValidation
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateTranscodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateTranscodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTranscodeStatusResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TranscodeJob
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTranscodeStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTranscodeStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTranscodeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TranscodeJob
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON409 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTranscodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTranscodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelTranscodeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON202                   *TranscodeJob
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON409 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelTranscodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelTranscodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloneTranscodeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *TranscodeJob
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON409 *Error
+	ApplicationproblemJSON429 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CloneTranscodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloneTranscodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTranscodeEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TranscodeEventList
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTranscodeEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTranscodeEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTranscodeLogResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTranscodeLogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTranscodeLogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTranscodeOutputResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TranscodeOutput
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON409 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTranscodeOutputResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTranscodeOutputResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DownloadTranscodeOutputResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON403 *Error
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadTranscodeOutputResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadTranscodeOutputResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PurgeTranscodeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON403 *Error
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON409 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PurgeTranscodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PurgeTranscodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTranscodeWebhooksResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *WebhookDeliveryList
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTranscodeWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTranscodeWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplayTranscodeWebhookResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON202                   *WebhookDeliveryList
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON409 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplayTranscodeWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplayTranscodeWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWorkersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *WorkerList
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWorkersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWorkersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWorkflowResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Workflow
+	ApplicationproblemJSON400 *Error
+	ApplicationproblemJSON409 *Error
+	ApplicationproblemJSON429 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWorkflowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWorkflowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkflowStatusResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Workflow
+	ApplicationproblemJSON404 *Error
+	ApplicationproblemJSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkflowStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkflowStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListWebhookKeysWithResponse request returning *ListWebhookKeysResponse
+func (c *ClientWithResponses) ListWebhookKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWebhookKeysResponse, error) {
+	rsp, err := c.ListWebhookKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhookKeysResponse(rsp)
+}
+
+// ListApiTokensWithResponse request returning *ListApiTokensResponse
+func (c *ClientWithResponses) ListApiTokensWithResponse(ctx context.Context, params *ListApiTokensParams, reqEditors ...RequestEditorFn) (*ListApiTokensResponse, error) {
+	rsp, err := c.ListApiTokens(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListApiTokensResponse(rsp)
+}
+
+// CreateApiTokenWithBodyWithResponse request with arbitrary body returning *CreateApiTokenResponse
+func (c *ClientWithResponses) CreateApiTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiTokenResponse, error) {
+	rsp, err := c.CreateApiTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateApiTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateApiTokenWithResponse(ctx context.Context, body CreateApiTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiTokenResponse, error) {
+	rsp, err := c.CreateApiToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateApiTokenResponse(rsp)
+}
+
+// RevokeApiTokenWithResponse request returning *RevokeApiTokenResponse
+func (c *ClientWithResponses) RevokeApiTokenWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeApiTokenResponse, error) {
+	rsp, err := c.RevokeApiToken(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeApiTokenResponse(rsp)
+}
+
+// RotateApiTokenWithResponse request returning *RotateApiTokenResponse
+func (c *ClientWithResponses) RotateApiTokenWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RotateApiTokenResponse, error) {
+	rsp, err := c.RotateApiToken(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateApiTokenResponse(rsp)
+}
+
+// CreateComparisonWithBodyWithResponse request with arbitrary body returning *CreateComparisonResponse
+func (c *ClientWithResponses) CreateComparisonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateComparisonResponse, error) {
+	rsp, err := c.CreateComparisonWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateComparisonResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateComparisonWithResponse(ctx context.Context, body CreateComparisonJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateComparisonResponse, error) {
+	rsp, err := c.CreateComparison(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateComparisonResponse(rsp)
+}
+
+// GetComparisonWithResponse request returning *GetComparisonResponse
+func (c *ClientWithResponses) GetComparisonWithResponse(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetComparisonResponse, error) {
+	rsp, err := c.GetComparison(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetComparisonResponse(rsp)
+}
+
+// EstimateTranscodeWithBodyWithResponse request with arbitrary body returning *EstimateTranscodeResponse
+func (c *ClientWithResponses) EstimateTranscodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EstimateTranscodeResponse, error) {
+	rsp, err := c.EstimateTranscodeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEstimateTranscodeResponse(rsp)
+}
+
+func (c *ClientWithResponses) EstimateTranscodeWithResponse(ctx context.Context, body EstimateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*EstimateTranscodeResponse, error) {
+	rsp, err := c.EstimateTranscode(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEstimateTranscodeResponse(rsp)
+}
+
+// GetGroupStatusWithResponse request returning *GetGroupStatusResponse
+func (c *ClientWithResponses) GetGroupStatusWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*GetGroupStatusResponse, error) {
+	rsp, err := c.GetGroupStatus(ctx, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetGroupStatusResponse(rsp)
+}
+
+// ListQueuesWithResponse request returning *ListQueuesResponse
+func (c *ClientWithResponses) ListQueuesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListQueuesResponse, error) {
+	rsp, err := c.ListQueues(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListQueuesResponse(rsp)
+}
+
+// GetQueueScalingWithResponse request returning *GetQueueScalingResponse
+func (c *ClientWithResponses) GetQueueScalingWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetQueueScalingResponse, error) {
+	rsp, err := c.GetQueueScaling(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetQueueScalingResponse(rsp)
+}
+
+// GetReadinessWithResponse request returning *GetReadinessResponse
+func (c *ClientWithResponses) GetReadinessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadinessResponse, error) {
+	rsp, err := c.GetReadiness(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReadinessResponse(rsp)
+}
+
+// ListTranscodesWithResponse request returning *ListTranscodesResponse
+func (c *ClientWithResponses) ListTranscodesWithResponse(ctx context.Context, params *ListTranscodesParams, reqEditors ...RequestEditorFn) (*ListTranscodesResponse, error) {
+	rsp, err := c.ListTranscodes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTranscodesResponse(rsp)
+}
+
+// CreateTranscodeWithBodyWithResponse request with arbitrary body returning *CreateTranscodeResponse
+func (c *ClientWithResponses) CreateTranscodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTranscodeResponse, error) {
+	rsp, err := c.CreateTranscodeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTranscodeResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTranscodeWithResponse(ctx context.Context, body CreateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTranscodeResponse, error) {
+	rsp, err := c.CreateTranscode(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTranscodeResponse(rsp)
+}
+
+// CancelTranscodesWithBodyWithResponse request with arbitrary body returning *CancelTranscodesResponse
+func (c *ClientWithResponses) CancelTranscodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelTranscodesResponse, error) {
+	rsp, err := c.CancelTranscodesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelTranscodesResponse(rsp)
+}
+
+func (c *ClientWithResponses) CancelTranscodesWithResponse(ctx context.Context, body CancelTranscodesJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelTranscodesResponse, error) {
+	rsp, err := c.CancelTranscodes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelTranscodesResponse(rsp)
+}
+
+// CreateDirectoryTranscodeWithBodyWithResponse request with arbitrary body returning *CreateDirectoryTranscodeResponse
+func (c *ClientWithResponses) CreateDirectoryTranscodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDirectoryTranscodeResponse, error) {
+	rsp, err := c.CreateDirectoryTranscodeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDirectoryTranscodeResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDirectoryTranscodeWithResponse(ctx context.Context, body CreateDirectoryTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDirectoryTranscodeResponse, error) {
+	rsp, err := c.CreateDirectoryTranscode(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDirectoryTranscodeResponse(rsp)
+}
+
+// ExportTranscodesWithResponse request returning *ExportTranscodesResponse
+func (c *ClientWithResponses) ExportTranscodesWithResponse(ctx context.Context, params *ExportTranscodesParams, reqEditors ...RequestEditorFn) (*ExportTranscodesResponse, error) {
+	rsp, err := c.ExportTranscodes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportTranscodesResponse(rsp)
+}
+
+// RetryTranscodesWithBodyWithResponse request with arbitrary body returning *RetryTranscodesResponse
+func (c *ClientWithResponses) RetryTranscodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RetryTranscodesResponse, error) {
+	rsp, err := c.RetryTranscodesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRetryTranscodesResponse(rsp)
+}
+
+func (c *ClientWithResponses) RetryTranscodesWithResponse(ctx context.Context, body RetryTranscodesJSONRequestBody, reqEditors ...RequestEditorFn) (*RetryTranscodesResponse, error) {
+	rsp, err := c.RetryTranscodes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRetryTranscodesResponse(rsp)
+}
+
+// GetTranscodeStatusesWithBodyWithResponse request with arbitrary body returning *GetTranscodeStatusesResponse
+func (c *ClientWithResponses) GetTranscodeStatusesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetTranscodeStatusesResponse, error) {
+	rsp, err := c.GetTranscodeStatusesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTranscodeStatusesResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetTranscodeStatusesWithResponse(ctx context.Context, body GetTranscodeStatusesJSONRequestBody, reqEditors ...RequestEditorFn) (*GetTranscodeStatusesResponse, error) {
+	rsp, err := c.GetTranscodeStatuses(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTranscodeStatusesResponse(rsp)
+}
+
+// ValidateTranscodeWithBodyWithResponse request with arbitrary body returning *ValidateTranscodeResponse
+func (c *ClientWithResponses) ValidateTranscodeWithBodyWithResponse(ctx context.Context, params *ValidateTranscodeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateTranscodeResponse, error) {
+	rsp, err := c.ValidateTranscodeWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateTranscodeResponse(rsp)
+}
+
+func (c *ClientWithResponses) ValidateTranscodeWithResponse(ctx context.Context, params *ValidateTranscodeParams, body ValidateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateTranscodeResponse, error) {
+	rsp, err := c.ValidateTranscode(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateTranscodeResponse(rsp)
+}
+
+// GetTranscodeStatusWithResponse request returning *GetTranscodeStatusResponse
+func (c *ClientWithResponses) GetTranscodeStatusWithResponse(ctx context.Context, uuid openapi_types.UUID, params *GetTranscodeStatusParams, reqEditors ...RequestEditorFn) (*GetTranscodeStatusResponse, error) {
+	rsp, err := c.GetTranscodeStatus(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTranscodeStatusResponse(rsp)
+}
+
+// UpdateTranscodeWithBodyWithResponse request with arbitrary body returning *UpdateTranscodeResponse
+func (c *ClientWithResponses) UpdateTranscodeWithBodyWithResponse(ctx context.Context, uuid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTranscodeResponse, error) {
+	rsp, err := c.UpdateTranscodeWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTranscodeResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTranscodeWithResponse(ctx context.Context, uuid openapi_types.UUID, body UpdateTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTranscodeResponse, error) {
+	rsp, err := c.UpdateTranscode(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTranscodeResponse(rsp)
+}
+
+// CancelTranscodeWithResponse request returning *CancelTranscodeResponse
+func (c *ClientWithResponses) CancelTranscodeWithResponse(ctx context.Context, uuid openapi_types.UUID, params *CancelTranscodeParams, reqEditors ...RequestEditorFn) (*CancelTranscodeResponse, error) {
+	rsp, err := c.CancelTranscode(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelTranscodeResponse(rsp)
+}
+
+// CloneTranscodeWithBodyWithResponse request with arbitrary body returning *CloneTranscodeResponse
+func (c *ClientWithResponses) CloneTranscodeWithBodyWithResponse(ctx context.Context, uuid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneTranscodeResponse, error) {
+	rsp, err := c.CloneTranscodeWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneTranscodeResponse(rsp)
+}
+
+func (c *ClientWithResponses) CloneTranscodeWithResponse(ctx context.Context, uuid openapi_types.UUID, body CloneTranscodeJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneTranscodeResponse, error) {
+	rsp, err := c.CloneTranscode(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneTranscodeResponse(rsp)
+}
+
+// GetTranscodeEventsWithResponse request returning *GetTranscodeEventsResponse
+func (c *ClientWithResponses) GetTranscodeEventsWithResponse(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTranscodeEventsResponse, error) {
+	rsp, err := c.GetTranscodeEvents(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTranscodeEventsResponse(rsp)
+}
+
+// GetTranscodeLogWithResponse request returning *GetTranscodeLogResponse
+func (c *ClientWithResponses) GetTranscodeLogWithResponse(ctx context.Context, uuid openapi_types.UUID, params *GetTranscodeLogParams, reqEditors ...RequestEditorFn) (*GetTranscodeLogResponse, error) {
+	rsp, err := c.GetTranscodeLog(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTranscodeLogResponse(rsp)
+}
+
+// GetTranscodeOutputWithResponse request returning *GetTranscodeOutputResponse
+func (c *ClientWithResponses) GetTranscodeOutputWithResponse(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTranscodeOutputResponse, error) {
+	rsp, err := c.GetTranscodeOutput(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTranscodeOutputResponse(rsp)
+}
+
+// DownloadTranscodeOutputWithResponse request returning *DownloadTranscodeOutputResponse
+func (c *ClientWithResponses) DownloadTranscodeOutputWithResponse(ctx context.Context, uuid openapi_types.UUID, params *DownloadTranscodeOutputParams, reqEditors ...RequestEditorFn) (*DownloadTranscodeOutputResponse, error) {
+	rsp, err := c.DownloadTranscodeOutput(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadTranscodeOutputResponse(rsp)
+}
+
+// PurgeTranscodeWithResponse request returning *PurgeTranscodeResponse
+func (c *ClientWithResponses) PurgeTranscodeWithResponse(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*PurgeTranscodeResponse, error) {
+	rsp, err := c.PurgeTranscode(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePurgeTranscodeResponse(rsp)
+}
+
+// ListTranscodeWebhooksWithResponse request returning *ListTranscodeWebhooksResponse
+func (c *ClientWithResponses) ListTranscodeWebhooksWithResponse(ctx context.Context, uuid openapi_types.UUID, params *ListTranscodeWebhooksParams, reqEditors ...RequestEditorFn) (*ListTranscodeWebhooksResponse, error) {
+	rsp, err := c.ListTranscodeWebhooks(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTranscodeWebhooksResponse(rsp)
+}
+
+// ReplayTranscodeWebhookWithResponse request returning *ReplayTranscodeWebhookResponse
+func (c *ClientWithResponses) ReplayTranscodeWebhookWithResponse(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*ReplayTranscodeWebhookResponse, error) {
+	rsp, err := c.ReplayTranscodeWebhook(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplayTranscodeWebhookResponse(rsp)
+}
+
+// ListWorkersWithResponse request returning *ListWorkersResponse
+func (c *ClientWithResponses) ListWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWorkersResponse, error) {
+	rsp, err := c.ListWorkers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWorkersResponse(rsp)
+}
+
+// CreateWorkflowWithBodyWithResponse request with arbitrary body returning *CreateWorkflowResponse
+func (c *ClientWithResponses) CreateWorkflowWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWorkflowResponse, error) {
+	rsp, err := c.CreateWorkflowWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWorkflowResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWorkflowWithResponse(ctx context.Context, body CreateWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWorkflowResponse, error) {
+	rsp, err := c.CreateWorkflow(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWorkflowResponse(rsp)
+}
+
+// GetWorkflowStatusWithResponse request returning *GetWorkflowStatusResponse
+func (c *ClientWithResponses) GetWorkflowStatusWithResponse(ctx context.Context, uuid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetWorkflowStatusResponse, error) {
+	rsp, err := c.GetWorkflowStatus(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkflowStatusResponse(rsp)
+}
+
+// ParseListWebhookKeysResponse parses an HTTP response from a ListWebhookKeysWithResponse call
+func ParseListWebhookKeysResponse(rsp *http.Response) (*ListWebhookKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhookKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookKeySet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListApiTokensResponse parses an HTTP response from a ListApiTokensWithResponse call
+func ParseListApiTokensResponse(rsp *http.Response) (*ListApiTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListApiTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiTokenList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateApiTokenResponse parses an HTTP response from a CreateApiTokenWithResponse call
+func ParseCreateApiTokenResponse(rsp *http.Response) (*CreateApiTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateApiTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ApiTokenSecret
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeApiTokenResponse parses an HTTP response from a RevokeApiTokenWithResponse call
+func ParseRevokeApiTokenResponse(rsp *http.Response) (*RevokeApiTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeApiTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRotateApiTokenResponse parses an HTTP response from a RotateApiTokenWithResponse call
+func ParseRotateApiTokenResponse(rsp *http.Response) (*RotateApiTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RotateApiTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiTokenSecret
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateComparisonResponse parses an HTTP response from a CreateComparisonWithResponse call
+func ParseCreateComparisonResponse(rsp *http.Response) (*CreateComparisonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateComparisonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Comparison
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetComparisonResponse parses an HTTP response from a GetComparisonWithResponse call
+func ParseGetComparisonResponse(rsp *http.Response) (*GetComparisonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetComparisonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Comparison
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEstimateTranscodeResponse parses an HTTP response from a EstimateTranscodeWithResponse call
+func ParseEstimateTranscodeResponse(rsp *http.Response) (*EstimateTranscodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EstimateTranscodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Estimate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetGroupStatusResponse parses an HTTP response from a GetGroupStatusWithResponse call
+func ParseGetGroupStatusResponse(rsp *http.Response) (*GetGroupStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetGroupStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JobGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListQueuesResponse parses an HTTP response from a ListQueuesWithResponse call
+func ParseListQueuesResponse(rsp *http.Response) (*ListQueuesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListQueuesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QueueList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetQueueScalingResponse parses an HTTP response from a GetQueueScalingWithResponse call
+func ParseGetQueueScalingResponse(rsp *http.Response) (*GetQueueScalingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetQueueScalingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QueueScaling
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReadinessResponse parses an HTTP response from a GetReadinessWithResponse call
+func ParseGetReadinessResponse(rsp *http.Response) (*GetReadinessResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReadinessResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Readiness
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Readiness
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTranscodesResponse parses an HTTP response from a ListTranscodesWithResponse call
+func ParseListTranscodesResponse(rsp *http.Response) (*ListTranscodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTranscodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TranscodeJobList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTranscodeResponse parses an HTTP response from a CreateTranscodeWithResponse call
+func ParseCreateTranscodeResponse(rsp *http.Response) (*CreateTranscodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTranscodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TranscodeJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelTranscodesResponse parses an HTTP response from a CancelTranscodesWithResponse call
+func ParseCancelTranscodesResponse(rsp *http.Response) (*CancelTranscodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelTranscodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkTranscodeResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDirectoryTranscodeResponse parses an HTTP response from a CreateDirectoryTranscodeWithResponse call
+func ParseCreateDirectoryTranscodeResponse(rsp *http.Response) (*CreateDirectoryTranscodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDirectoryTranscodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DirectoryTranscode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportTranscodesResponse parses an HTTP response from a ExportTranscodesWithResponse call
+func ParseExportTranscodesResponse(rsp *http.Response) (*ExportTranscodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportTranscodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRetryTranscodesResponse parses an HTTP response from a RetryTranscodesWithResponse call
+func ParseRetryTranscodesResponse(rsp *http.Response) (*RetryTranscodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RetryTranscodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkTranscodeResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTranscodeStatusesResponse parses an HTTP response from a GetTranscodeStatusesWithResponse call
+func ParseGetTranscodeStatusesResponse(rsp *http.Response) (*GetTranscodeStatusesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTranscodeStatusesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TranscodeStatusList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseValidateTranscodeResponse parses an HTTP response from a ValidateTranscodeWithResponse call
+func ParseValidateTranscodeResponse(rsp *http.Response) (*ValidateTranscodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateTranscodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TranscodeValidation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTranscodeStatusResponse parses an HTTP response from a GetTranscodeStatusWithResponse call
+func ParseGetTranscodeStatusResponse(rsp *http.Response) (*GetTranscodeStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTranscodeStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TranscodeJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTranscodeResponse parses an HTTP response from a UpdateTranscodeWithResponse call
+func ParseUpdateTranscodeResponse(rsp *http.Response) (*UpdateTranscodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTranscodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TranscodeJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelTranscodeResponse parses an HTTP response from a CancelTranscodeWithResponse call
+func ParseCancelTranscodeResponse(rsp *http.Response) (*CancelTranscodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelTranscodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest TranscodeJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCloneTranscodeResponse parses an HTTP response from a CloneTranscodeWithResponse call
+func ParseCloneTranscodeResponse(rsp *http.Response) (*CloneTranscodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloneTranscodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TranscodeJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTranscodeEventsResponse parses an HTTP response from a GetTranscodeEventsWithResponse call
+func ParseGetTranscodeEventsResponse(rsp *http.Response) (*GetTranscodeEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTranscodeEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TranscodeEventList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTranscodeLogResponse parses an HTTP response from a GetTranscodeLogWithResponse call
+func ParseGetTranscodeLogResponse(rsp *http.Response) (*GetTranscodeLogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTranscodeLogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTranscodeOutputResponse parses an HTTP response from a GetTranscodeOutputWithResponse call
+func ParseGetTranscodeOutputResponse(rsp *http.Response) (*GetTranscodeOutputResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTranscodeOutputResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TranscodeOutput
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDownloadTranscodeOutputResponse parses an HTTP response from a DownloadTranscodeOutputWithResponse call
+func ParseDownloadTranscodeOutputResponse(rsp *http.Response) (*DownloadTranscodeOutputResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DownloadTranscodeOutputResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePurgeTranscodeResponse parses an HTTP response from a PurgeTranscodeWithResponse call
+func ParsePurgeTranscodeResponse(rsp *http.Response) (*PurgeTranscodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PurgeTranscodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTranscodeWebhooksResponse parses an HTTP response from a ListTranscodeWebhooksWithResponse call
+func ParseListTranscodeWebhooksResponse(rsp *http.Response) (*ListTranscodeWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTranscodeWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReplayTranscodeWebhookResponse parses an HTTP response from a ReplayTranscodeWebhookWithResponse call
+func ParseReplayTranscodeWebhookResponse(rsp *http.Response) (*ReplayTranscodeWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReplayTranscodeWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest WebhookDeliveryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWorkersResponse parses an HTTP response from a ListWorkersWithResponse call
+func ParseListWorkersResponse(rsp *http.Response) (*ListWorkersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWorkersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkerList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWorkflowResponse parses an HTTP response from a CreateWorkflowWithResponse call
+func ParseCreateWorkflowResponse(rsp *http.Response) (*CreateWorkflowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWorkflowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Workflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWorkflowStatusResponse parses an HTTP response from a GetWorkflowStatusWithResponse call
+func ParseGetWorkflowStatusResponse(rsp *http.Response) (*GetWorkflowStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWorkflowStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Workflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List webhook signing keys
+	// (GET /.well-known/vt-webhook-keys)
+	ListWebhookKeys(w http.ResponseWriter, r *http.Request)
+	// List API tokens
+	// (GET /admin/tokens)
+	ListApiTokens(w http.ResponseWriter, r *http.Request, params ListApiTokensParams)
+	// Create an API token
+	// (POST /admin/tokens)
+	CreateApiToken(w http.ResponseWriter, r *http.Request)
+	// Revoke an API token
+	// (DELETE /admin/tokens/{id})
+	RevokeApiToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Rotate an API token
+	// (POST /admin/tokens/{id}/rotate)
+	RotateApiToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Compare an output against its source
+	// (POST /comparisons)
+	CreateComparison(w http.ResponseWriter, r *http.Request)
+	// Get comparison status
+	// (GET /comparisons/{uuid})
+	GetComparison(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID)
+	// Estimate a transcode
+	// (POST /estimate)
+	EstimateTranscode(w http.ResponseWriter, r *http.Request)
+	// Get job group status
+	// (GET /groups/{groupId})
+	GetGroupStatus(w http.ResponseWriter, r *http.Request, groupId string)
+	// List queues
+	// (GET /queues)
+	ListQueues(w http.ResponseWriter, r *http.Request)
+	// Get queue autoscaling signal
+	// (GET /queues/{name}/scaling)
+	GetQueueScaling(w http.ResponseWriter, r *http.Request, name string)
+	// Check readiness
+	// (GET /readyz)
+	GetReadiness(w http.ResponseWriter, r *http.Request)
+	// List transcode jobs
+	// (GET /transcodes)
+	ListTranscodes(w http.ResponseWriter, r *http.Request, params ListTranscodesParams)
+	// Start a new transcode job
+	// (POST /transcodes)
+	CreateTranscode(w http.ResponseWriter, r *http.Request)
+	// Cancel several transcode jobs
+	// (POST /transcodes/cancel)
+	CancelTranscodes(w http.ResponseWriter, r *http.Request)
+	// Transcode a directory
+	// (POST /transcodes/directory)
+	CreateDirectoryTranscode(w http.ResponseWriter, r *http.Request)
+	// Export transcode job history
+	// (GET /transcodes/export)
+	ExportTranscodes(w http.ResponseWriter, r *http.Request, params ExportTranscodesParams)
+	// Retry several failed transcode jobs
+	// (POST /transcodes/retry)
+	RetryTranscodes(w http.ResponseWriter, r *http.Request)
+	// Get the status of several transcode jobs
+	// (POST /transcodes/status)
+	GetTranscodeStatuses(w http.ResponseWriter, r *http.Request)
+	// Validate a transcode job without creating it
+	// (POST /transcodes/validate)
+	ValidateTranscode(w http.ResponseWriter, r *http.Request, params ValidateTranscodeParams)
+	// Get transcode job status
+	// (GET /transcodes/{uuid})
+	GetTranscodeStatus(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params GetTranscodeStatusParams)
+	// Update a pending transcode job
+	// (PATCH /transcodes/{uuid})
+	UpdateTranscode(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID)
+	// Cancel a transcode job
+	// (POST /transcodes/{uuid}/cancel)
+	CancelTranscode(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params CancelTranscodeParams)
+	// Clone a transcode job
+	// (POST /transcodes/{uuid}/clone)
+	CloneTranscode(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID)
+	// Get the state history of a transcode job
+	// (GET /transcodes/{uuid}/events)
+	GetTranscodeEvents(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID)
+	// Get the encoder log of a transcode job
+	// (GET /transcodes/{uuid}/log)
+	GetTranscodeLog(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params GetTranscodeLogParams)
+	// Get a download URL for the transcode output
+	// (GET /transcodes/{uuid}/output)
+	GetTranscodeOutput(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID)
+	// Download the transcode output
+	// (GET /transcodes/{uuid}/output/download)
+	DownloadTranscodeOutput(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params DownloadTranscodeOutputParams)
+	// Purge a transcode job
+	// (DELETE /transcodes/{uuid}/purge)
+	PurgeTranscode(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID)
+	// List webhook deliveries for a transcode job
+	// (GET /transcodes/{uuid}/webhooks)
+	ListTranscodeWebhooks(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params ListTranscodeWebhooksParams)
+	// Resend the completion webhooks
+	// (POST /transcodes/{uuid}/webhooks/replay)
+	ReplayTranscodeWebhook(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID)
+	// List workers
+	// (GET /workers)
+	ListWorkers(w http.ResponseWriter, r *http.Request)
+	// Start a new workflow
+	// (POST /workflows)
+	CreateWorkflow(w http.ResponseWriter, r *http.Request)
+	// Get workflow status
+	// (GET /workflows/{uuid})
+	GetWorkflowStatus(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListWebhookKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookKeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListApiTokens operation middleware
+func (siw *ServerInterfaceWrapper) ListApiTokens(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApiTokensParams
+
+	// ------------- Optional query parameter "tenant" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenant", r.URL.Query(), &params.Tenant)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApiTokens(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateApiToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateApiToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateApiToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeApiToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokeApiToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeApiToken(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateApiToken operation middleware
+func (siw *ServerInterfaceWrapper) RotateApiToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateApiToken(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateComparison operation middleware
+func (siw *ServerInterfaceWrapper) CreateComparison(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateComparison(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetComparison operation middleware
+func (siw *ServerInterfaceWrapper) GetComparison(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uuid", r.PathValue("uuid"), &uuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComparison(w, r, uuid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EstimateTranscode operation middleware
+func (siw *ServerInterfaceWrapper) EstimateTranscode(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EstimateTranscode(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetGroupStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetGroupStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", r.PathValue("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGroupStatus(w, r, groupId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListQueues operation middleware
+func (siw *ServerInterfaceWrapper) ListQueues(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListQueues(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetQueueScaling operation middleware
+func (siw *ServerInterfaceWrapper) GetQueueScaling(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQueueScaling(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReadiness operation middleware
+func (siw *ServerInterfaceWrapper) GetReadiness(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReadiness(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTranscodes operation middleware
+func (siw *ServerInterfaceWrapper) ListTranscodes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTranscodesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTranscodes(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTranscode operation middleware
+func (siw *ServerInterfaceWrapper) CreateTranscode(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTranscode(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelTranscodes operation middleware
+func (siw *ServerInterfaceWrapper) CancelTranscodes(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelTranscodes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateDirectoryTranscode operation middleware
+func (siw *ServerInterfaceWrapper) CreateDirectoryTranscode(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDirectoryTranscode(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportTranscodes operation middleware
+func (siw *ServerInterfaceWrapper) ExportTranscodes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportTranscodesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportTranscodes(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RetryTranscodes operation middleware
+func (siw *ServerInterfaceWrapper) RetryTranscodes(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryTranscodes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTranscodeStatuses operation middleware
+func (siw *ServerInterfaceWrapper) GetTranscodeStatuses(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTranscodeStatuses(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ValidateTranscode operation middleware
+func (siw *ServerInterfaceWrapper) ValidateTranscode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ValidateTranscodeParams
+
+	// ------------- Optional query parameter "probeSource" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "probeSource", r.URL.Query(), &params.ProbeSource)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "probeSource", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ValidateTranscode(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTranscodeStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetTranscodeStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uuid", r.PathValue("uuid"), &uuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTranscodeStatusParams
+
+	// ------------- Optional query parameter "waitForChange" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "waitForChange", r.URL.Query(), &params.WaitForChange)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "waitForChange", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "timeoutSeconds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeoutSeconds", r.URL.Query(), &params.TimeoutSeconds)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timeoutSeconds", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTranscodeStatus(w, r, uuid, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTranscode operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTranscode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uuid", r.PathValue("uuid"), &uuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTranscode(w, r, uuid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelTranscode operation middleware
+func (siw *ServerInterfaceWrapper) CancelTranscode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uuid", r.PathValue("uuid"), &uuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelTranscodeParams
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", r.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelTranscode(w, r, uuid, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloneTranscode operation middleware
+func (siw *ServerInterfaceWrapper) CloneTranscode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uuid", r.PathValue("uuid"), &uuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloneTranscode(w, r, uuid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTranscodeEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetTranscodeEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uuid", r.PathValue("uuid"), &uuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTranscodeEvents(w, r, uuid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTranscodeLog operation middleware
+func (siw *ServerInterfaceWrapper) GetTranscodeLog(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uuid", r.PathValue("uuid"), &uuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTranscodeLogParams
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", r.URL.Query(), &params.Follow)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTranscodeLog(w, r, uuid, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTranscodeOutput operation middleware
+func (siw *ServerInterfaceWrapper) GetTranscodeOutput(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uuid", r.PathValue("uuid"), &uuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTranscodeOutput(w, r, uuid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DownloadTranscodeOutput operation middleware
+func (siw *ServerInterfaceWrapper) DownloadTranscodeOutput(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uuid", r.PathValue("uuid"), &uuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadTranscodeOutputParams
+
+	// ------------- Required query parameter "expires" -------------
+
+	if paramValue := r.URL.Query().Get("expires"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "expires"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "expires", r.URL.Query(), &params.Expires)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expires", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "signature" -------------
+
+	if paramValue := r.URL.Query().Get("signature"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "signature"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "signature", r.URL.Query(), &params.Signature)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadTranscodeOutput(w, r, uuid, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PurgeTranscode operation middleware
+func (siw *ServerInterfaceWrapper) PurgeTranscode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uuid", r.PathValue("uuid"), &uuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PurgeTranscode(w, r, uuid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTranscodeWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListTranscodeWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uuid", r.PathValue("uuid"), &uuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTranscodeWebhooksParams
+
+	// ------------- Optional query parameter "includeHeartbeats" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeHeartbeats", r.URL.Query(), &params.IncludeHeartbeats)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeHeartbeats", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTranscodeWebhooks(w, r, uuid, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplayTranscodeWebhook operation middleware
+func (siw *ServerInterfaceWrapper) ReplayTranscodeWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uuid", r.PathValue("uuid"), &uuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplayTranscodeWebhook(w, r, uuid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWorkers operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkers(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) CreateWorkflow(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWorkflow(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWorkflowStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflowStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uuid", r.PathValue("uuid"), &uuid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkflowStatus(w, r, uuid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
 	ParamName string
 	Err       error
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/.well-known/vt-webhook-keys", wrapper.ListWebhookKeys)
+	m.HandleFunc("GET "+options.BaseURL+"/admin/tokens", wrapper.ListApiTokens)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/tokens", wrapper.CreateApiToken)
+	m.HandleFunc("DELETE "+options.BaseURL+"/admin/tokens/{id}", wrapper.RevokeApiToken)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/tokens/{id}/rotate", wrapper.RotateApiToken)
+	m.HandleFunc("POST "+options.BaseURL+"/comparisons", wrapper.CreateComparison)
+	m.HandleFunc("GET "+options.BaseURL+"/comparisons/{uuid}", wrapper.GetComparison)
+	m.HandleFunc("POST "+options.BaseURL+"/estimate", wrapper.EstimateTranscode)
+	m.HandleFunc("GET "+options.BaseURL+"/groups/{groupId}", wrapper.GetGroupStatus)
+	m.HandleFunc("GET "+options.BaseURL+"/queues", wrapper.ListQueues)
+	m.HandleFunc("GET "+options.BaseURL+"/queues/{name}/scaling", wrapper.GetQueueScaling)
+	m.HandleFunc("GET "+options.BaseURL+"/readyz", wrapper.GetReadiness)
+	m.HandleFunc("GET "+options.BaseURL+"/transcodes", wrapper.ListTranscodes)
+	m.HandleFunc("POST "+options.BaseURL+"/transcodes", wrapper.CreateTranscode)
+	m.HandleFunc("POST "+options.BaseURL+"/transcodes/cancel", wrapper.CancelTranscodes)
+	m.HandleFunc("POST "+options.BaseURL+"/transcodes/directory", wrapper.CreateDirectoryTranscode)
+	m.HandleFunc("GET "+options.BaseURL+"/transcodes/export", wrapper.ExportTranscodes)
+	m.HandleFunc("POST "+options.BaseURL+"/transcodes/retry", wrapper.RetryTranscodes)
+	m.HandleFunc("POST "+options.BaseURL+"/transcodes/status", wrapper.GetTranscodeStatuses)
+	m.HandleFunc("POST "+options.BaseURL+"/transcodes/validate", wrapper.ValidateTranscode)
+	m.HandleFunc("GET "+options.BaseURL+"/transcodes/{uuid}", wrapper.GetTranscodeStatus)
+	m.HandleFunc("PATCH "+options.BaseURL+"/transcodes/{uuid}", wrapper.UpdateTranscode)
+	m.HandleFunc("POST "+options.BaseURL+"/transcodes/{uuid}/cancel", wrapper.CancelTranscode)
+	m.HandleFunc("POST "+options.BaseURL+"/transcodes/{uuid}/clone", wrapper.CloneTranscode)
+	m.HandleFunc("GET "+options.BaseURL+"/transcodes/{uuid}/events", wrapper.GetTranscodeEvents)
+	m.HandleFunc("GET "+options.BaseURL+"/transcodes/{uuid}/log", wrapper.GetTranscodeLog)
+	m.HandleFunc("GET "+options.BaseURL+"/transcodes/{uuid}/output", wrapper.GetTranscodeOutput)
+	m.HandleFunc("GET "+options.BaseURL+"/transcodes/{uuid}/output/download", wrapper.DownloadTranscodeOutput)
+	m.HandleFunc("DELETE "+options.BaseURL+"/transcodes/{uuid}/purge", wrapper.PurgeTranscode)
+	m.HandleFunc("GET "+options.BaseURL+"/transcodes/{uuid}/webhooks", wrapper.ListTranscodeWebhooks)
+	m.HandleFunc("POST "+options.BaseURL+"/transcodes/{uuid}/webhooks/replay", wrapper.ReplayTranscodeWebhook)
+	m.HandleFunc("GET "+options.BaseURL+"/workers", wrapper.ListWorkers)
+	m.HandleFunc("POST "+options.BaseURL+"/workflows", wrapper.CreateWorkflow)
+	m.HandleFunc("GET "+options.BaseURL+"/workflows/{uuid}", wrapper.GetWorkflowStatus)
+
+	return m
+}
+
+type ListWebhookKeysRequestObject struct {
+}
+
+type ListWebhookKeysResponseObject interface {
+	VisitListWebhookKeysResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookKeys200JSONResponse WebhookKeySet
+
+func (response ListWebhookKeys200JSONResponse) VisitListWebhookKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookKeys500ApplicationProblemPlusJSONResponse Error
+
+func (response ListWebhookKeys500ApplicationProblemPlusJSONResponse) VisitListWebhookKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiTokensRequestObject struct {
+	Params ListApiTokensParams
+}
+
+type ListApiTokensResponseObject interface {
+	VisitListApiTokensResponse(w http.ResponseWriter) error
+}
+
+type ListApiTokens200JSONResponse ApiTokenList
+
+func (response ListApiTokens200JSONResponse) VisitListApiTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiTokens403ApplicationProblemPlusJSONResponse Error
+
+func (response ListApiTokens403ApplicationProblemPlusJSONResponse) VisitListApiTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiTokens500ApplicationProblemPlusJSONResponse Error
+
+func (response ListApiTokens500ApplicationProblemPlusJSONResponse) VisitListApiTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiTokenRequestObject struct {
+	Body *CreateApiTokenJSONRequestBody
+}
+
+type CreateApiTokenResponseObject interface {
+	VisitCreateApiTokenResponse(w http.ResponseWriter) error
+}
+
+type CreateApiToken201JSONResponse ApiTokenSecret
+
+func (response CreateApiToken201JSONResponse) VisitCreateApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiToken400ApplicationProblemPlusJSONResponse Error
+
+func (response CreateApiToken400ApplicationProblemPlusJSONResponse) VisitCreateApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiToken403ApplicationProblemPlusJSONResponse Error
+
+func (response CreateApiToken403ApplicationProblemPlusJSONResponse) VisitCreateApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiToken500ApplicationProblemPlusJSONResponse Error
+
+func (response CreateApiToken500ApplicationProblemPlusJSONResponse) VisitCreateApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiTokenRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type RevokeApiTokenResponseObject interface {
+	VisitRevokeApiTokenResponse(w http.ResponseWriter) error
+}
+
+type RevokeApiToken200JSONResponse ApiToken
+
+func (response RevokeApiToken200JSONResponse) VisitRevokeApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiToken403ApplicationProblemPlusJSONResponse Error
+
+func (response RevokeApiToken403ApplicationProblemPlusJSONResponse) VisitRevokeApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiToken404ApplicationProblemPlusJSONResponse Error
+
+func (response RevokeApiToken404ApplicationProblemPlusJSONResponse) VisitRevokeApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiToken500ApplicationProblemPlusJSONResponse Error
+
+func (response RevokeApiToken500ApplicationProblemPlusJSONResponse) VisitRevokeApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateApiTokenRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type RotateApiTokenResponseObject interface {
+	VisitRotateApiTokenResponse(w http.ResponseWriter) error
+}
+
+type RotateApiToken200JSONResponse ApiTokenSecret
+
+func (response RotateApiToken200JSONResponse) VisitRotateApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateApiToken403ApplicationProblemPlusJSONResponse Error
+
+func (response RotateApiToken403ApplicationProblemPlusJSONResponse) VisitRotateApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateApiToken404ApplicationProblemPlusJSONResponse Error
+
+func (response RotateApiToken404ApplicationProblemPlusJSONResponse) VisitRotateApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateApiToken500ApplicationProblemPlusJSONResponse Error
+
+func (response RotateApiToken500ApplicationProblemPlusJSONResponse) VisitRotateApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateComparisonRequestObject struct {
+	Body *CreateComparisonJSONRequestBody
+}
+
+type CreateComparisonResponseObject interface {
+	VisitCreateComparisonResponse(w http.ResponseWriter) error
+}
+
+type CreateComparison201JSONResponse Comparison
+
+func (response CreateComparison201JSONResponse) VisitCreateComparisonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateComparison400ApplicationProblemPlusJSONResponse Error
+
+func (response CreateComparison400ApplicationProblemPlusJSONResponse) VisitCreateComparisonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateComparison409ApplicationProblemPlusJSONResponse Error
+
+func (response CreateComparison409ApplicationProblemPlusJSONResponse) VisitCreateComparisonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateComparison500ApplicationProblemPlusJSONResponse Error
+
+func (response CreateComparison500ApplicationProblemPlusJSONResponse) VisitCreateComparisonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetComparisonRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+type GetComparisonResponseObject interface {
+	VisitGetComparisonResponse(w http.ResponseWriter) error
+}
+
+type GetComparison200JSONResponse Comparison
+
+func (response GetComparison200JSONResponse) VisitGetComparisonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetComparison404ApplicationProblemPlusJSONResponse Error
+
+func (response GetComparison404ApplicationProblemPlusJSONResponse) VisitGetComparisonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetComparison500ApplicationProblemPlusJSONResponse Error
+
+func (response GetComparison500ApplicationProblemPlusJSONResponse) VisitGetComparisonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EstimateTranscodeRequestObject struct {
+	Body *EstimateTranscodeJSONRequestBody
+}
+
+type EstimateTranscodeResponseObject interface {
+	VisitEstimateTranscodeResponse(w http.ResponseWriter) error
+}
+
+type EstimateTranscode200JSONResponse Estimate
+
+func (response EstimateTranscode200JSONResponse) VisitEstimateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EstimateTranscode400ApplicationProblemPlusJSONResponse Error
+
+func (response EstimateTranscode400ApplicationProblemPlusJSONResponse) VisitEstimateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EstimateTranscode500ApplicationProblemPlusJSONResponse Error
+
+func (response EstimateTranscode500ApplicationProblemPlusJSONResponse) VisitEstimateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroupStatusRequestObject struct {
+	GroupId string `json:"groupId"`
+}
+
+type GetGroupStatusResponseObject interface {
+	VisitGetGroupStatusResponse(w http.ResponseWriter) error
+}
+
+type GetGroupStatus200JSONResponse JobGroup
+
+func (response GetGroupStatus200JSONResponse) VisitGetGroupStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroupStatus404ApplicationProblemPlusJSONResponse Error
+
+func (response GetGroupStatus404ApplicationProblemPlusJSONResponse) VisitGetGroupStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroupStatus500ApplicationProblemPlusJSONResponse Error
+
+func (response GetGroupStatus500ApplicationProblemPlusJSONResponse) VisitGetGroupStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQueuesRequestObject struct {
+}
+
+type ListQueuesResponseObject interface {
+	VisitListQueuesResponse(w http.ResponseWriter) error
+}
+
+type ListQueues200JSONResponse QueueList
+
+func (response ListQueues200JSONResponse) VisitListQueuesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQueues500ApplicationProblemPlusJSONResponse Error
+
+func (response ListQueues500ApplicationProblemPlusJSONResponse) VisitListQueuesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQueueScalingRequestObject struct {
+	Name string `json:"name"`
+}
+
+type GetQueueScalingResponseObject interface {
+	VisitGetQueueScalingResponse(w http.ResponseWriter) error
+}
+
+type GetQueueScaling200JSONResponse QueueScaling
+
+func (response GetQueueScaling200JSONResponse) VisitGetQueueScalingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQueueScaling500ApplicationProblemPlusJSONResponse Error
+
+func (response GetQueueScaling500ApplicationProblemPlusJSONResponse) VisitGetQueueScalingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReadinessRequestObject struct {
+}
+
+type GetReadinessResponseObject interface {
+	VisitGetReadinessResponse(w http.ResponseWriter) error
+}
+
+type GetReadiness200JSONResponse Readiness
+
+func (response GetReadiness200JSONResponse) VisitGetReadinessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReadiness503JSONResponse Readiness
+
+func (response GetReadiness503JSONResponse) VisitGetReadinessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTranscodesRequestObject struct {
+	Params ListTranscodesParams
+}
+
+type ListTranscodesResponseObject interface {
+	VisitListTranscodesResponse(w http.ResponseWriter) error
+}
+
+type ListTranscodes200JSONResponse TranscodeJobList
+
+func (response ListTranscodes200JSONResponse) VisitListTranscodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTranscodes400ApplicationProblemPlusJSONResponse Error
+
+func (response ListTranscodes400ApplicationProblemPlusJSONResponse) VisitListTranscodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTranscodes500ApplicationProblemPlusJSONResponse Error
+
+func (response ListTranscodes500ApplicationProblemPlusJSONResponse) VisitListTranscodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTranscodeRequestObject struct {
+	Body *CreateTranscodeJSONRequestBody
+}
+
+type CreateTranscodeResponseObject interface {
+	VisitCreateTranscodeResponse(w http.ResponseWriter) error
+}
+
+type CreateTranscode201JSONResponse TranscodeJob
+
+func (response CreateTranscode201JSONResponse) VisitCreateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTranscode400ApplicationProblemPlusJSONResponse Error
+
+func (response CreateTranscode400ApplicationProblemPlusJSONResponse) VisitCreateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTranscode409ApplicationProblemPlusJSONResponse Error
+
+func (response CreateTranscode409ApplicationProblemPlusJSONResponse) VisitCreateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTranscode429ApplicationProblemPlusJSONResponse Error
+
+func (response CreateTranscode429ApplicationProblemPlusJSONResponse) VisitCreateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTranscode500ApplicationProblemPlusJSONResponse Error
+
+func (response CreateTranscode500ApplicationProblemPlusJSONResponse) VisitCreateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelTranscodesRequestObject struct {
+	Body *CancelTranscodesJSONRequestBody
+}
+
+type CancelTranscodesResponseObject interface {
+	VisitCancelTranscodesResponse(w http.ResponseWriter) error
+}
+
+type CancelTranscodes200JSONResponse BulkTranscodeResult
+
+func (response CancelTranscodes200JSONResponse) VisitCancelTranscodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelTranscodes400ApplicationProblemPlusJSONResponse Error
+
+func (response CancelTranscodes400ApplicationProblemPlusJSONResponse) VisitCancelTranscodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelTranscodes500ApplicationProblemPlusJSONResponse Error
+
+func (response CancelTranscodes500ApplicationProblemPlusJSONResponse) VisitCancelTranscodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectoryTranscodeRequestObject struct {
+	Body *CreateDirectoryTranscodeJSONRequestBody
+}
+
+type CreateDirectoryTranscodeResponseObject interface {
+	VisitCreateDirectoryTranscodeResponse(w http.ResponseWriter) error
+}
+
+type CreateDirectoryTranscode201JSONResponse DirectoryTranscode
+
+func (response CreateDirectoryTranscode201JSONResponse) VisitCreateDirectoryTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectoryTranscode400ApplicationProblemPlusJSONResponse Error
+
+func (response CreateDirectoryTranscode400ApplicationProblemPlusJSONResponse) VisitCreateDirectoryTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectoryTranscode429ApplicationProblemPlusJSONResponse Error
+
+func (response CreateDirectoryTranscode429ApplicationProblemPlusJSONResponse) VisitCreateDirectoryTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectoryTranscode500ApplicationProblemPlusJSONResponse Error
+
+func (response CreateDirectoryTranscode500ApplicationProblemPlusJSONResponse) VisitCreateDirectoryTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTranscodesRequestObject struct {
+	Params ExportTranscodesParams
+}
+
+type ExportTranscodesResponseObject interface {
+	VisitExportTranscodesResponse(w http.ResponseWriter) error
+}
+
+type ExportTranscodes200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportTranscodes200ApplicationxNdjsonResponse) VisitExportTranscodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportTranscodes200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportTranscodes200TextcsvResponse) VisitExportTranscodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportTranscodes400ApplicationProblemPlusJSONResponse Error
+
+func (response ExportTranscodes400ApplicationProblemPlusJSONResponse) VisitExportTranscodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTranscodes500ApplicationProblemPlusJSONResponse Error
+
+func (response ExportTranscodes500ApplicationProblemPlusJSONResponse) VisitExportTranscodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryTranscodesRequestObject struct {
+	Body *RetryTranscodesJSONRequestBody
+}
+
+type RetryTranscodesResponseObject interface {
+	VisitRetryTranscodesResponse(w http.ResponseWriter) error
+}
+
+type RetryTranscodes200JSONResponse BulkTranscodeResult
+
+func (response RetryTranscodes200JSONResponse) VisitRetryTranscodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryTranscodes400ApplicationProblemPlusJSONResponse Error
+
+func (response RetryTranscodes400ApplicationProblemPlusJSONResponse) VisitRetryTranscodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryTranscodes429ApplicationProblemPlusJSONResponse Error
+
+func (response RetryTranscodes429ApplicationProblemPlusJSONResponse) VisitRetryTranscodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryTranscodes500ApplicationProblemPlusJSONResponse Error
+
+func (response RetryTranscodes500ApplicationProblemPlusJSONResponse) VisitRetryTranscodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeStatusesRequestObject struct {
+	Body *GetTranscodeStatusesJSONRequestBody
+}
+
+type GetTranscodeStatusesResponseObject interface {
+	VisitGetTranscodeStatusesResponse(w http.ResponseWriter) error
+}
+
+type GetTranscodeStatuses200JSONResponse TranscodeStatusList
+
+func (response GetTranscodeStatuses200JSONResponse) VisitGetTranscodeStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeStatuses400ApplicationProblemPlusJSONResponse Error
+
+func (response GetTranscodeStatuses400ApplicationProblemPlusJSONResponse) VisitGetTranscodeStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeStatuses500ApplicationProblemPlusJSONResponse Error
+
+func (response GetTranscodeStatuses500ApplicationProblemPlusJSONResponse) VisitGetTranscodeStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateTranscodeRequestObject struct {
+	Params ValidateTranscodeParams
+	Body   *ValidateTranscodeJSONRequestBody
+}
+
+type ValidateTranscodeResponseObject interface {
+	VisitValidateTranscodeResponse(w http.ResponseWriter) error
+}
+
+type ValidateTranscode200JSONResponse TranscodeValidation
+
+func (response ValidateTranscode200JSONResponse) VisitValidateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateTranscode400ApplicationProblemPlusJSONResponse Error
+
+func (response ValidateTranscode400ApplicationProblemPlusJSONResponse) VisitValidateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateTranscode500ApplicationProblemPlusJSONResponse Error
+
+func (response ValidateTranscode500ApplicationProblemPlusJSONResponse) VisitValidateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeStatusRequestObject struct {
+	Uuid   openapi_types.UUID `json:"uuid"`
+	Params GetTranscodeStatusParams
+}
+
+type GetTranscodeStatusResponseObject interface {
+	VisitGetTranscodeStatusResponse(w http.ResponseWriter) error
+}
+
+type GetTranscodeStatus200ResponseHeaders struct {
+	ETag string
+}
+
+type GetTranscodeStatus200JSONResponse struct {
+	Body    TranscodeJob
+	Headers GetTranscodeStatus200ResponseHeaders
+}
+
+func (response GetTranscodeStatus200JSONResponse) VisitGetTranscodeStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTranscodeStatus304ResponseHeaders struct {
+	ETag string
+}
+
+type GetTranscodeStatus304Response struct {
+	Headers GetTranscodeStatus304ResponseHeaders
+}
+
+func (response GetTranscodeStatus304Response) VisitGetTranscodeStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetTranscodeStatus400ApplicationProblemPlusJSONResponse Error
+
+func (response GetTranscodeStatus400ApplicationProblemPlusJSONResponse) VisitGetTranscodeStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeStatus404ApplicationProblemPlusJSONResponse Error
+
+func (response GetTranscodeStatus404ApplicationProblemPlusJSONResponse) VisitGetTranscodeStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeStatus500ApplicationProblemPlusJSONResponse Error
+
+func (response GetTranscodeStatus500ApplicationProblemPlusJSONResponse) VisitGetTranscodeStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTranscodeRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+	Body *UpdateTranscodeJSONRequestBody
+}
+
+type UpdateTranscodeResponseObject interface {
+	VisitUpdateTranscodeResponse(w http.ResponseWriter) error
+}
+
+type UpdateTranscode200JSONResponse TranscodeJob
+
+func (response UpdateTranscode200JSONResponse) VisitUpdateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTranscode400ApplicationProblemPlusJSONResponse Error
+
+func (response UpdateTranscode400ApplicationProblemPlusJSONResponse) VisitUpdateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTranscode404ApplicationProblemPlusJSONResponse Error
+
+func (response UpdateTranscode404ApplicationProblemPlusJSONResponse) VisitUpdateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTranscode409ApplicationProblemPlusJSONResponse Error
+
+func (response UpdateTranscode409ApplicationProblemPlusJSONResponse) VisitUpdateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTranscode500ApplicationProblemPlusJSONResponse Error
+
+func (response UpdateTranscode500ApplicationProblemPlusJSONResponse) VisitUpdateTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelTranscodeRequestObject struct {
+	Uuid   openapi_types.UUID `json:"uuid"`
+	Params CancelTranscodeParams
+}
+
+type CancelTranscodeResponseObject interface {
+	VisitCancelTranscodeResponse(w http.ResponseWriter) error
+}
+
+type CancelTranscode202JSONResponse TranscodeJob
+
+func (response CancelTranscode202JSONResponse) VisitCancelTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelTranscode400ApplicationProblemPlusJSONResponse Error
+
+func (response CancelTranscode400ApplicationProblemPlusJSONResponse) VisitCancelTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelTranscode404ApplicationProblemPlusJSONResponse Error
+
+func (response CancelTranscode404ApplicationProblemPlusJSONResponse) VisitCancelTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelTranscode409ApplicationProblemPlusJSONResponse Error
+
+func (response CancelTranscode409ApplicationProblemPlusJSONResponse) VisitCancelTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelTranscode500ApplicationProblemPlusJSONResponse Error
+
+func (response CancelTranscode500ApplicationProblemPlusJSONResponse) VisitCancelTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneTranscodeRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+	Body *CloneTranscodeJSONRequestBody
+}
+
+type CloneTranscodeResponseObject interface {
+	VisitCloneTranscodeResponse(w http.ResponseWriter) error
+}
+
+type CloneTranscode201JSONResponse TranscodeJob
+
+func (response CloneTranscode201JSONResponse) VisitCloneTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneTranscode400ApplicationProblemPlusJSONResponse Error
+
+func (response CloneTranscode400ApplicationProblemPlusJSONResponse) VisitCloneTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneTranscode404ApplicationProblemPlusJSONResponse Error
+
+func (response CloneTranscode404ApplicationProblemPlusJSONResponse) VisitCloneTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneTranscode409ApplicationProblemPlusJSONResponse Error
+
+func (response CloneTranscode409ApplicationProblemPlusJSONResponse) VisitCloneTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneTranscode429ApplicationProblemPlusJSONResponse Error
+
+func (response CloneTranscode429ApplicationProblemPlusJSONResponse) VisitCloneTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneTranscode500ApplicationProblemPlusJSONResponse Error
+
+func (response CloneTranscode500ApplicationProblemPlusJSONResponse) VisitCloneTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeEventsRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+type GetTranscodeEventsResponseObject interface {
+	VisitGetTranscodeEventsResponse(w http.ResponseWriter) error
+}
+
+type GetTranscodeEvents200JSONResponse TranscodeEventList
+
+func (response GetTranscodeEvents200JSONResponse) VisitGetTranscodeEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeEvents404ApplicationProblemPlusJSONResponse Error
+
+func (response GetTranscodeEvents404ApplicationProblemPlusJSONResponse) VisitGetTranscodeEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeEvents500ApplicationProblemPlusJSONResponse Error
+
+func (response GetTranscodeEvents500ApplicationProblemPlusJSONResponse) VisitGetTranscodeEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeLogRequestObject struct {
+	Uuid   openapi_types.UUID `json:"uuid"`
+	Params GetTranscodeLogParams
+}
+
+type GetTranscodeLogResponseObject interface {
+	VisitGetTranscodeLogResponse(w http.ResponseWriter) error
+}
+
+type GetTranscodeLog200TextResponse string
+
+func (response GetTranscodeLog200TextResponse) VisitGetTranscodeLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type GetTranscodeLog404ApplicationProblemPlusJSONResponse Error
+
+func (response GetTranscodeLog404ApplicationProblemPlusJSONResponse) VisitGetTranscodeLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeLog500ApplicationProblemPlusJSONResponse Error
+
+func (response GetTranscodeLog500ApplicationProblemPlusJSONResponse) VisitGetTranscodeLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeOutputRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+type GetTranscodeOutputResponseObject interface {
+	VisitGetTranscodeOutputResponse(w http.ResponseWriter) error
+}
+
+type GetTranscodeOutput200JSONResponse TranscodeOutput
+
+func (response GetTranscodeOutput200JSONResponse) VisitGetTranscodeOutputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeOutput404ApplicationProblemPlusJSONResponse Error
+
+func (response GetTranscodeOutput404ApplicationProblemPlusJSONResponse) VisitGetTranscodeOutputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeOutput409ApplicationProblemPlusJSONResponse Error
+
+func (response GetTranscodeOutput409ApplicationProblemPlusJSONResponse) VisitGetTranscodeOutputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTranscodeOutput500ApplicationProblemPlusJSONResponse Error
+
+func (response GetTranscodeOutput500ApplicationProblemPlusJSONResponse) VisitGetTranscodeOutputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadTranscodeOutputRequestObject struct {
+	Uuid   openapi_types.UUID `json:"uuid"`
+	Params DownloadTranscodeOutputParams
+}
+
+type DownloadTranscodeOutputResponseObject interface {
+	VisitDownloadTranscodeOutputResponse(w http.ResponseWriter) error
+}
+
+type DownloadTranscodeOutput200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response DownloadTranscodeOutput200ApplicationoctetStreamResponse) VisitDownloadTranscodeOutputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DownloadTranscodeOutput403ApplicationProblemPlusJSONResponse Error
+
+func (response DownloadTranscodeOutput403ApplicationProblemPlusJSONResponse) VisitDownloadTranscodeOutputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadTranscodeOutput404ApplicationProblemPlusJSONResponse Error
+
+func (response DownloadTranscodeOutput404ApplicationProblemPlusJSONResponse) VisitDownloadTranscodeOutputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadTranscodeOutput500ApplicationProblemPlusJSONResponse Error
+
+func (response DownloadTranscodeOutput500ApplicationProblemPlusJSONResponse) VisitDownloadTranscodeOutputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeTranscodeRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+type PurgeTranscodeResponseObject interface {
+	VisitPurgeTranscodeResponse(w http.ResponseWriter) error
+}
+
+type PurgeTranscode204Response struct {
+}
+
+func (response PurgeTranscode204Response) VisitPurgeTranscodeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PurgeTranscode403ApplicationProblemPlusJSONResponse Error
+
+func (response PurgeTranscode403ApplicationProblemPlusJSONResponse) VisitPurgeTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeTranscode404ApplicationProblemPlusJSONResponse Error
+
+func (response PurgeTranscode404ApplicationProblemPlusJSONResponse) VisitPurgeTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeTranscode409ApplicationProblemPlusJSONResponse Error
+
+func (response PurgeTranscode409ApplicationProblemPlusJSONResponse) VisitPurgeTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeTranscode500ApplicationProblemPlusJSONResponse Error
+
+func (response PurgeTranscode500ApplicationProblemPlusJSONResponse) VisitPurgeTranscodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTranscodeWebhooksRequestObject struct {
+	Uuid   openapi_types.UUID `json:"uuid"`
+	Params ListTranscodeWebhooksParams
+}
+
+type ListTranscodeWebhooksResponseObject interface {
+	VisitListTranscodeWebhooksResponse(w http.ResponseWriter) error
+}
+
+type ListTranscodeWebhooks200JSONResponse WebhookDeliveryList
+
+func (response ListTranscodeWebhooks200JSONResponse) VisitListTranscodeWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTranscodeWebhooks404ApplicationProblemPlusJSONResponse Error
+
+func (response ListTranscodeWebhooks404ApplicationProblemPlusJSONResponse) VisitListTranscodeWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTranscodeWebhooks500ApplicationProblemPlusJSONResponse Error
+
+func (response ListTranscodeWebhooks500ApplicationProblemPlusJSONResponse) VisitListTranscodeWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayTranscodeWebhookRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+type ReplayTranscodeWebhookResponseObject interface {
+	VisitReplayTranscodeWebhookResponse(w http.ResponseWriter) error
+}
+
+type ReplayTranscodeWebhook202JSONResponse WebhookDeliveryList
+
+func (response ReplayTranscodeWebhook202JSONResponse) VisitReplayTranscodeWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayTranscodeWebhook404ApplicationProblemPlusJSONResponse Error
+
+func (response ReplayTranscodeWebhook404ApplicationProblemPlusJSONResponse) VisitReplayTranscodeWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayTranscodeWebhook409ApplicationProblemPlusJSONResponse Error
+
+func (response ReplayTranscodeWebhook409ApplicationProblemPlusJSONResponse) VisitReplayTranscodeWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayTranscodeWebhook500ApplicationProblemPlusJSONResponse Error
+
+func (response ReplayTranscodeWebhook500ApplicationProblemPlusJSONResponse) VisitReplayTranscodeWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkersRequestObject struct {
+}
+
+type ListWorkersResponseObject interface {
+	VisitListWorkersResponse(w http.ResponseWriter) error
+}
+
+type ListWorkers200JSONResponse WorkerList
+
+func (response ListWorkers200JSONResponse) VisitListWorkersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkers500ApplicationProblemPlusJSONResponse Error
+
+func (response ListWorkers500ApplicationProblemPlusJSONResponse) VisitListWorkersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowRequestObject struct {
+	Body *CreateWorkflowJSONRequestBody
+}
+
+type CreateWorkflowResponseObject interface {
+	VisitCreateWorkflowResponse(w http.ResponseWriter) error
+}
+
+type CreateWorkflow201JSONResponse Workflow
+
+func (response CreateWorkflow201JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflow400ApplicationProblemPlusJSONResponse Error
+
+func (response CreateWorkflow400ApplicationProblemPlusJSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflow409ApplicationProblemPlusJSONResponse Error
+
+func (response CreateWorkflow409ApplicationProblemPlusJSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflow429ApplicationProblemPlusJSONResponse Error
+
+func (response CreateWorkflow429ApplicationProblemPlusJSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflow500ApplicationProblemPlusJSONResponse Error
+
+func (response CreateWorkflow500ApplicationProblemPlusJSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowStatusRequestObject struct {
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+type GetWorkflowStatusResponseObject interface {
+	VisitGetWorkflowStatusResponse(w http.ResponseWriter) error
+}
+
+type GetWorkflowStatus200JSONResponse Workflow
+
+func (response GetWorkflowStatus200JSONResponse) VisitGetWorkflowStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowStatus404ApplicationProblemPlusJSONResponse Error
+
+func (response GetWorkflowStatus404ApplicationProblemPlusJSONResponse) VisitGetWorkflowStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowStatus500ApplicationProblemPlusJSONResponse Error
+
+func (response GetWorkflowStatus500ApplicationProblemPlusJSONResponse) VisitGetWorkflowStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List webhook signing keys
+	// (GET /.well-known/vt-webhook-keys)
+	ListWebhookKeys(ctx context.Context, request ListWebhookKeysRequestObject) (ListWebhookKeysResponseObject, error)
+	// List API tokens
+	// (GET /admin/tokens)
+	ListApiTokens(ctx context.Context, request ListApiTokensRequestObject) (ListApiTokensResponseObject, error)
+	// Create an API token
+	// (POST /admin/tokens)
+	CreateApiToken(ctx context.Context, request CreateApiTokenRequestObject) (CreateApiTokenResponseObject, error)
+	// Revoke an API token
+	// (DELETE /admin/tokens/{id})
+	RevokeApiToken(ctx context.Context, request RevokeApiTokenRequestObject) (RevokeApiTokenResponseObject, error)
+	// Rotate an API token
+	// (POST /admin/tokens/{id}/rotate)
+	RotateApiToken(ctx context.Context, request RotateApiTokenRequestObject) (RotateApiTokenResponseObject, error)
+	// Compare an output against its source
+	// (POST /comparisons)
+	CreateComparison(ctx context.Context, request CreateComparisonRequestObject) (CreateComparisonResponseObject, error)
+	// Get comparison status
+	// (GET /comparisons/{uuid})
+	GetComparison(ctx context.Context, request GetComparisonRequestObject) (GetComparisonResponseObject, error)
+	// Estimate a transcode
+	// (POST /estimate)
+	EstimateTranscode(ctx context.Context, request EstimateTranscodeRequestObject) (EstimateTranscodeResponseObject, error)
+	// Get job group status
+	// (GET /groups/{groupId})
+	GetGroupStatus(ctx context.Context, request GetGroupStatusRequestObject) (GetGroupStatusResponseObject, error)
+	// List queues
+	// (GET /queues)
+	ListQueues(ctx context.Context, request ListQueuesRequestObject) (ListQueuesResponseObject, error)
+	// Get queue autoscaling signal
+	// (GET /queues/{name}/scaling)
+	GetQueueScaling(ctx context.Context, request GetQueueScalingRequestObject) (GetQueueScalingResponseObject, error)
+	// Check readiness
+	// (GET /readyz)
+	GetReadiness(ctx context.Context, request GetReadinessRequestObject) (GetReadinessResponseObject, error)
+	// List transcode jobs
+	// (GET /transcodes)
+	ListTranscodes(ctx context.Context, request ListTranscodesRequestObject) (ListTranscodesResponseObject, error)
+	// Start a new transcode job
+	// (POST /transcodes)
+	CreateTranscode(ctx context.Context, request CreateTranscodeRequestObject) (CreateTranscodeResponseObject, error)
+	// Cancel several transcode jobs
+	// (POST /transcodes/cancel)
+	CancelTranscodes(ctx context.Context, request CancelTranscodesRequestObject) (CancelTranscodesResponseObject, error)
+	// Transcode a directory
+	// (POST /transcodes/directory)
+	CreateDirectoryTranscode(ctx context.Context, request CreateDirectoryTranscodeRequestObject) (CreateDirectoryTranscodeResponseObject, error)
+	// Export transcode job history
+	// (GET /transcodes/export)
+	ExportTranscodes(ctx context.Context, request ExportTranscodesRequestObject) (ExportTranscodesResponseObject, error)
+	// Retry several failed transcode jobs
+	// (POST /transcodes/retry)
+	RetryTranscodes(ctx context.Context, request RetryTranscodesRequestObject) (RetryTranscodesResponseObject, error)
+	// Get the status of several transcode jobs
+	// (POST /transcodes/status)
+	GetTranscodeStatuses(ctx context.Context, request GetTranscodeStatusesRequestObject) (GetTranscodeStatusesResponseObject, error)
+	// Validate a transcode job without creating it
+	// (POST /transcodes/validate)
+	ValidateTranscode(ctx context.Context, request ValidateTranscodeRequestObject) (ValidateTranscodeResponseObject, error)
+	// Get transcode job status
+	// (GET /transcodes/{uuid})
+	GetTranscodeStatus(ctx context.Context, request GetTranscodeStatusRequestObject) (GetTranscodeStatusResponseObject, error)
+	// Update a pending transcode job
+	// (PATCH /transcodes/{uuid})
+	UpdateTranscode(ctx context.Context, request UpdateTranscodeRequestObject) (UpdateTranscodeResponseObject, error)
+	// Cancel a transcode job
+	// (POST /transcodes/{uuid}/cancel)
+	CancelTranscode(ctx context.Context, request CancelTranscodeRequestObject) (CancelTranscodeResponseObject, error)
+	// Clone a transcode job
+	// (POST /transcodes/{uuid}/clone)
+	CloneTranscode(ctx context.Context, request CloneTranscodeRequestObject) (CloneTranscodeResponseObject, error)
+	// Get the state history of a transcode job
+	// (GET /transcodes/{uuid}/events)
+	GetTranscodeEvents(ctx context.Context, request GetTranscodeEventsRequestObject) (GetTranscodeEventsResponseObject, error)
+	// Get the encoder log of a transcode job
+	// (GET /transcodes/{uuid}/log)
+	GetTranscodeLog(ctx context.Context, request GetTranscodeLogRequestObject) (GetTranscodeLogResponseObject, error)
+	// Get a download URL for the transcode output
+	// (GET /transcodes/{uuid}/output)
+	GetTranscodeOutput(ctx context.Context, request GetTranscodeOutputRequestObject) (GetTranscodeOutputResponseObject, error)
+	// Download the transcode output
+	// (GET /transcodes/{uuid}/output/download)
+	DownloadTranscodeOutput(ctx context.Context, request DownloadTranscodeOutputRequestObject) (DownloadTranscodeOutputResponseObject, error)
+	// Purge a transcode job
+	// (DELETE /transcodes/{uuid}/purge)
+	PurgeTranscode(ctx context.Context, request PurgeTranscodeRequestObject) (PurgeTranscodeResponseObject, error)
+	// List webhook deliveries for a transcode job
+	// (GET /transcodes/{uuid}/webhooks)
+	ListTranscodeWebhooks(ctx context.Context, request ListTranscodeWebhooksRequestObject) (ListTranscodeWebhooksResponseObject, error)
+	// Resend the completion webhooks
+	// (POST /transcodes/{uuid}/webhooks/replay)
+	ReplayTranscodeWebhook(ctx context.Context, request ReplayTranscodeWebhookRequestObject) (ReplayTranscodeWebhookResponseObject, error)
+	// List workers
+	// (GET /workers)
+	ListWorkers(ctx context.Context, request ListWorkersRequestObject) (ListWorkersResponseObject, error)
+	// Start a new workflow
+	// (POST /workflows)
+	CreateWorkflow(ctx context.Context, request CreateWorkflowRequestObject) (CreateWorkflowResponseObject, error)
+	// Get workflow status
+	// (GET /workflows/{uuid})
+	GetWorkflowStatus(ctx context.Context, request GetWorkflowStatusRequestObject) (GetWorkflowStatusResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListWebhookKeys operation middleware
+func (sh *strictHandler) ListWebhookKeys(w http.ResponseWriter, r *http.Request) {
+	var request ListWebhookKeysRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhookKeys(ctx, request.(ListWebhookKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhookKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWebhookKeysResponseObject); ok {
+		if err := validResponse.VisitListWebhookKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListApiTokens operation middleware
+func (sh *strictHandler) ListApiTokens(w http.ResponseWriter, r *http.Request, params ListApiTokensParams) {
+	var request ListApiTokensRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListApiTokens(ctx, request.(ListApiTokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListApiTokens")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListApiTokensResponseObject); ok {
+		if err := validResponse.VisitListApiTokensResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateApiToken operation middleware
+func (sh *strictHandler) CreateApiToken(w http.ResponseWriter, r *http.Request) {
+	var request CreateApiTokenRequestObject
+
+	var body CreateApiTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateApiToken(ctx, request.(CreateApiTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateApiToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateApiTokenResponseObject); ok {
+		if err := validResponse.VisitCreateApiTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeApiToken operation middleware
+func (sh *strictHandler) RevokeApiToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RevokeApiTokenRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeApiToken(ctx, request.(RevokeApiTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeApiToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeApiTokenResponseObject); ok {
+		if err := validResponse.VisitRevokeApiTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RotateApiToken operation middleware
+func (sh *strictHandler) RotateApiToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RotateApiTokenRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RotateApiToken(ctx, request.(RotateApiTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RotateApiToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RotateApiTokenResponseObject); ok {
+		if err := validResponse.VisitRotateApiTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateComparison operation middleware
+func (sh *strictHandler) CreateComparison(w http.ResponseWriter, r *http.Request) {
+	var request CreateComparisonRequestObject
+
+	var body CreateComparisonJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateComparison(ctx, request.(CreateComparisonRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateComparison")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateComparisonResponseObject); ok {
+		if err := validResponse.VisitCreateComparisonResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetComparison operation middleware
+func (sh *strictHandler) GetComparison(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request GetComparisonRequestObject
+
+	request.Uuid = uuid
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetComparison(ctx, request.(GetComparisonRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetComparison")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetComparisonResponseObject); ok {
+		if err := validResponse.VisitGetComparisonResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// EstimateTranscode operation middleware
+func (sh *strictHandler) EstimateTranscode(w http.ResponseWriter, r *http.Request) {
+	var request EstimateTranscodeRequestObject
+
+	var body EstimateTranscodeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EstimateTranscode(ctx, request.(EstimateTranscodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EstimateTranscode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EstimateTranscodeResponseObject); ok {
+		if err := validResponse.VisitEstimateTranscodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetGroupStatus operation middleware
+func (sh *strictHandler) GetGroupStatus(w http.ResponseWriter, r *http.Request, groupId string) {
+	var request GetGroupStatusRequestObject
+
+	request.GroupId = groupId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGroupStatus(ctx, request.(GetGroupStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGroupStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetGroupStatusResponseObject); ok {
+		if err := validResponse.VisitGetGroupStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListQueues operation middleware
+func (sh *strictHandler) ListQueues(w http.ResponseWriter, r *http.Request) {
+	var request ListQueuesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListQueues(ctx, request.(ListQueuesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListQueues")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListQueuesResponseObject); ok {
+		if err := validResponse.VisitListQueuesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetQueueScaling operation middleware
+func (sh *strictHandler) GetQueueScaling(w http.ResponseWriter, r *http.Request, name string) {
+	var request GetQueueScalingRequestObject
+
+	request.Name = name
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetQueueScaling(ctx, request.(GetQueueScalingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetQueueScaling")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetQueueScalingResponseObject); ok {
+		if err := validResponse.VisitGetQueueScalingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReadiness operation middleware
+func (sh *strictHandler) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	var request GetReadinessRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReadiness(ctx, request.(GetReadinessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReadiness")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReadinessResponseObject); ok {
+		if err := validResponse.VisitGetReadinessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListTranscodes operation middleware
+func (sh *strictHandler) ListTranscodes(w http.ResponseWriter, r *http.Request, params ListTranscodesParams) {
+	var request ListTranscodesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTranscodes(ctx, request.(ListTranscodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTranscodes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTranscodesResponseObject); ok {
+		if err := validResponse.VisitListTranscodesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+// CreateTranscode operation middleware
+func (sh *strictHandler) CreateTranscode(w http.ResponseWriter, r *http.Request) {
+	var request CreateTranscodeRequestObject
+
+	var body CreateTranscodeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTranscode(ctx, request.(CreateTranscodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTranscode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTranscodeResponseObject); ok {
+		if err := validResponse.VisitCreateTranscodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RequiredParamError struct {
-	ParamName string
+// CancelTranscodes operation middleware
+func (sh *strictHandler) CancelTranscodes(w http.ResponseWriter, r *http.Request) {
+	var request CancelTranscodesRequestObject
+
+	var body CancelTranscodesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelTranscodes(ctx, request.(CancelTranscodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelTranscodes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelTranscodesResponseObject); ok {
+		if err := validResponse.VisitCancelTranscodesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+// CreateDirectoryTranscode operation middleware
+func (sh *strictHandler) CreateDirectoryTranscode(w http.ResponseWriter, r *http.Request) {
+	var request CreateDirectoryTranscodeRequestObject
+
+	var body CreateDirectoryTranscodeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateDirectoryTranscode(ctx, request.(CreateDirectoryTranscodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateDirectoryTranscode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateDirectoryTranscodeResponseObject); ok {
+		if err := validResponse.VisitCreateDirectoryTranscodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+// ExportTranscodes operation middleware
+func (sh *strictHandler) ExportTranscodes(w http.ResponseWriter, r *http.Request, params ExportTranscodesParams) {
+	var request ExportTranscodesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportTranscodes(ctx, request.(ExportTranscodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportTranscodes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportTranscodesResponseObject); ok {
+		if err := validResponse.VisitExportTranscodesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+// RetryTranscodes operation middleware
+func (sh *strictHandler) RetryTranscodes(w http.ResponseWriter, r *http.Request) {
+	var request RetryTranscodesRequestObject
+
+	var body RetryTranscodesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RetryTranscodes(ctx, request.(RetryTranscodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RetryTranscodes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RetryTranscodesResponseObject); ok {
+		if err := validResponse.VisitRetryTranscodesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+// GetTranscodeStatuses operation middleware
+func (sh *strictHandler) GetTranscodeStatuses(w http.ResponseWriter, r *http.Request) {
+	var request GetTranscodeStatusesRequestObject
+
+	var body GetTranscodeStatusesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTranscodeStatuses(ctx, request.(GetTranscodeStatusesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTranscodeStatuses")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTranscodeStatusesResponseObject); ok {
+		if err := validResponse.VisitGetTranscodeStatusesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+// ValidateTranscode operation middleware
+func (sh *strictHandler) ValidateTranscode(w http.ResponseWriter, r *http.Request, params ValidateTranscodeParams) {
+	var request ValidateTranscodeRequestObject
+
+	request.Params = params
+
+	var body ValidateTranscodeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ValidateTranscode(ctx, request.(ValidateTranscodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ValidateTranscode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ValidateTranscodeResponseObject); ok {
+		if err := validResponse.VisitValidateTranscodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+// GetTranscodeStatus operation middleware
+func (sh *strictHandler) GetTranscodeStatus(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params GetTranscodeStatusParams) {
+	var request GetTranscodeStatusRequestObject
+
+	request.Uuid = uuid
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTranscodeStatus(ctx, request.(GetTranscodeStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTranscodeStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTranscodeStatusResponseObject); ok {
+		if err := validResponse.VisitGetTranscodeStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+// UpdateTranscode operation middleware
+func (sh *strictHandler) UpdateTranscode(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request UpdateTranscodeRequestObject
+
+	request.Uuid = uuid
+
+	var body UpdateTranscodeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateTranscode(ctx, request.(UpdateTranscodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateTranscode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateTranscodeResponseObject); ok {
+		if err := validResponse.VisitUpdateTranscodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+// CancelTranscode operation middleware
+func (sh *strictHandler) CancelTranscode(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params CancelTranscodeParams) {
+	var request CancelTranscodeRequestObject
+
+	request.Uuid = uuid
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelTranscode(ctx, request.(CancelTranscodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelTranscode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelTranscodeResponseObject); ok {
+		if err := validResponse.VisitCancelTranscodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+// CloneTranscode operation middleware
+func (sh *strictHandler) CloneTranscode(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request CloneTranscodeRequestObject
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
-}
+	request.Uuid = uuid
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
-}
+	var body CloneTranscodeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CloneTranscode(ctx, request.(CloneTranscodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CloneTranscode")
+	}
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CloneTranscodeResponseObject); ok {
+		if err := validResponse.VisitCloneTranscodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+// GetTranscodeEvents operation middleware
+func (sh *strictHandler) GetTranscodeEvents(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request GetTranscodeEventsRequestObject
 
-	if m == nil {
-		m = http.NewServeMux()
+	request.Uuid = uuid
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTranscodeEvents(ctx, request.(GetTranscodeEventsRequestObject))
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTranscodeEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTranscodeEventsResponseObject); ok {
+		if err := validResponse.VisitGetTranscodeEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+// GetTranscodeLog operation middleware
+func (sh *strictHandler) GetTranscodeLog(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params GetTranscodeLogParams) {
+	var request GetTranscodeLogRequestObject
+
+	request.Uuid = uuid
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTranscodeLog(ctx, request.(GetTranscodeLogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTranscodeLog")
 	}
 
-	m.HandleFunc("POST "+options.BaseURL+"/transcodes", wrapper.CreateTranscode)
-	m.HandleFunc("GET "+options.BaseURL+"/transcodes/{uuid}", wrapper.GetTranscodeStatus)
+	response, err := handler(r.Context(), w, r, request)
 
-	return m
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTranscodeLogResponseObject); ok {
+		if err := validResponse.VisitGetTranscodeLogResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateTranscodeRequestObject struct {
-	Body *CreateTranscodeJSONRequestBody
-}
+// GetTranscodeOutput operation middleware
+func (sh *strictHandler) GetTranscodeOutput(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request GetTranscodeOutputRequestObject
 
-type CreateTranscodeResponseObject interface {
-	VisitCreateTranscodeResponse(w http.ResponseWriter) error
-}
+	request.Uuid = uuid
 
-type CreateTranscode201JSONResponse TranscodeJob
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTranscodeOutput(ctx, request.(GetTranscodeOutputRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTranscodeOutput")
+	}
 
-func (response CreateTranscode201JSONResponse) VisitCreateTranscodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTranscodeOutputResponseObject); ok {
+		if err := validResponse.VisitGetTranscodeOutputResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateTranscode400JSONResponse Error
-
-func (response CreateTranscode400JSONResponse) VisitCreateTranscodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// DownloadTranscodeOutput operation middleware
+func (sh *strictHandler) DownloadTranscodeOutput(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params DownloadTranscodeOutputParams) {
+	var request DownloadTranscodeOutputRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Uuid = uuid
+	request.Params = params
 
-type CreateTranscode409JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DownloadTranscodeOutput(ctx, request.(DownloadTranscodeOutputRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DownloadTranscodeOutput")
+	}
 
-func (response CreateTranscode409JSONResponse) VisitCreateTranscodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DownloadTranscodeOutputResponseObject); ok {
+		if err := validResponse.VisitDownloadTranscodeOutputResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateTranscode500JSONResponse Error
+// PurgeTranscode operation middleware
+func (sh *strictHandler) PurgeTranscode(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request PurgeTranscodeRequestObject
 
-func (response CreateTranscode500JSONResponse) VisitCreateTranscodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Uuid = uuid
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PurgeTranscode(ctx, request.(PurgeTranscodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PurgeTranscode")
+	}
 
-type GetTranscodeStatusRequestObject struct {
-	Uuid openapi_types.UUID `json:"uuid"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetTranscodeStatusResponseObject interface {
-	VisitGetTranscodeStatusResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PurgeTranscodeResponseObject); ok {
+		if err := validResponse.VisitPurgeTranscodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTranscodeStatus200JSONResponse TranscodeJob
-
-func (response GetTranscodeStatus200JSONResponse) VisitGetTranscodeStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListTranscodeWebhooks operation middleware
+func (sh *strictHandler) ListTranscodeWebhooks(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID, params ListTranscodeWebhooksParams) {
+	var request ListTranscodeWebhooksRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Uuid = uuid
+	request.Params = params
 
-type GetTranscodeStatus404JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTranscodeWebhooks(ctx, request.(ListTranscodeWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTranscodeWebhooks")
+	}
 
-func (response GetTranscodeStatus404JSONResponse) VisitGetTranscodeStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTranscodeWebhooksResponseObject); ok {
+		if err := validResponse.VisitListTranscodeWebhooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTranscodeStatus500JSONResponse Error
+// ReplayTranscodeWebhook operation middleware
+func (sh *strictHandler) ReplayTranscodeWebhook(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request ReplayTranscodeWebhookRequestObject
 
-func (response GetTranscodeStatus500JSONResponse) VisitGetTranscodeStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Uuid = uuid
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Start a new transcode job
-	// (POST /transcodes)
-	CreateTranscode(ctx context.Context, request CreateTranscodeRequestObject) (CreateTranscodeResponseObject, error)
-	// Get transcode job status
-	// (GET /transcodes/{uuid})
-	GetTranscodeStatus(ctx context.Context, request GetTranscodeStatusRequestObject) (GetTranscodeStatusResponseObject, error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplayTranscodeWebhook(ctx, request.(ReplayTranscodeWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplayTranscodeWebhook")
+	}
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	response, err := handler(r.Context(), w, r, request)
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReplayTranscodeWebhookResponseObject); ok {
+		if err := validResponse.VisitReplayTranscodeWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+// ListWorkers operation middleware
+func (sh *strictHandler) ListWorkers(w http.ResponseWriter, r *http.Request) {
+	var request ListWorkersRequestObject
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWorkers(ctx, request.(ListWorkersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWorkers")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWorkersResponseObject); ok {
+		if err := validResponse.VisitListWorkersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// CreateTranscode operation middleware
-func (sh *strictHandler) CreateTranscode(w http.ResponseWriter, r *http.Request) {
-	var request CreateTranscodeRequestObject
+// CreateWorkflow operation middleware
+func (sh *strictHandler) CreateWorkflow(w http.ResponseWriter, r *http.Request) {
+	var request CreateWorkflowRequestObject
 
-	var body CreateTranscodeJSONRequestBody
+	var body CreateWorkflowJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -822,18 +10380,18 @@ func (sh *strictHandler) CreateTranscode(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateTranscode(ctx, request.(CreateTranscodeRequestObject))
+		return sh.ssi.CreateWorkflow(ctx, request.(CreateWorkflowRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateTranscode")
+		handler = middleware(handler, "CreateWorkflow")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateTranscodeResponseObject); ok {
-		if err := validResponse.VisitCreateTranscodeResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateWorkflowResponseObject); ok {
+		if err := validResponse.VisitCreateWorkflowResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -841,25 +10399,25 @@ func (sh *strictHandler) CreateTranscode(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// GetTranscodeStatus operation middleware
-func (sh *strictHandler) GetTranscodeStatus(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
-	var request GetTranscodeStatusRequestObject
+// GetWorkflowStatus operation middleware
+func (sh *strictHandler) GetWorkflowStatus(w http.ResponseWriter, r *http.Request, uuid openapi_types.UUID) {
+	var request GetWorkflowStatusRequestObject
 
 	request.Uuid = uuid
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTranscodeStatus(ctx, request.(GetTranscodeStatusRequestObject))
+		return sh.ssi.GetWorkflowStatus(ctx, request.(GetWorkflowStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTranscodeStatus")
+		handler = middleware(handler, "GetWorkflowStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTranscodeStatusResponseObject); ok {
-		if err := validResponse.VisitGetTranscodeStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWorkflowStatusResponseObject); ok {
+		if err := validResponse.VisitGetWorkflowStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -870,27 +10428,309 @@ func (sh *strictHandler) GetTranscodeStatus(w http.ResponseWriter, r *http.Reque
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xXXW/bNhT9KwS3RzlSOqcfesvaovNQrFkSdw9FUNDitcVW/Ch5adco/N8HkrJsV3Ic",
-	"rO22pyjy5b2X555zSH2hlZZGK1DoaPmFuqoGyeLjS2u1DQ/GagMWBcTXleYQ/nJwlRUGhVa0TMEk/pZR",
-	"+MykaYCWdPLH28vXkxfvr1/+OX15c0szimsTfnBohVrQTUYlOMcWAyl/85KpkQXG2awBArHCNnq/yG0N",
-	"xGlvKyCGYU2EI0ItWSN4v94moxY+eWGB0/IdbRveZr3r4vXsA1QY+ru1TLkQ97ueDaBhgSHwS+z3fysk",
-	"OGTSkFUNimAN5IOekRVzpF1FMzrXVjKkJeUMYYRCwhBGHBwKxULiK4Z1v1Z4S+baxiq47ZgT7dF4JHPR",
-	"DOaF7YiHhtmCQsT8MCuZM9EAH8pnrI6l+li0i4VakDaIeBeT7OZoLCwFrI4kXlhw7mTmGEUM2AoUJqLs",
-	"INZ+FnGQ7LOQXtLyvCgyKoVK/xVdYeXlDGwonHh1D+ioIzot/5aCgz4Kt0OGPu7hZwtzWtKf8p368lZ6",
-	"eUe4mxS+yag3/B+wrGEOSbv0wVTzXvB+lakSnzwQwUGhmAuwfbKFsvtVYqJT+muDWmAO4O6zfsevPUJk",
-	"exLcB+peKV/DJw8O+3L+TkLbcTqPjHB5+j2Xeing/ZNHhTmTZjyEfw3M4gwY/gWzWuuPUyv6XbyJD6wh",
-	"0+tJYODVm5tb0q0kq7SUKB2GVcXtOLISWO8kkpByhPtQudtK6GK//xrRuDLP2zdnlZZ5V+hg3FZ8syOg",
-	"DqZw9lBT+BZt9ickVDegY7MZ1sbzRoDCkbE6ZOJkOp28OCqPXd2LiwKejotiBI+ezUbjcz4esSfnj0fj",
-	"8ePHFxfjcVEUxWk9ZbQd9q3+COoepmjDgoIxhAVghKoaz4EI1dHFsHWjGY+9M4910Hoiz34fszXCPX08",
-	"nK9DLE0mFgwsGGMDCO4kHds8J8l4xHoe5jj32slN5+tfMcNbCwpJsjei58OUUOHweUcNqFZ+1iuVnrYo",
-	"RP9Ox+5df2ObjAo11/0GLq8mcZqSKbYISksa2BN76CFAjAIjwG9jQLczSy6vJjSjS7AupTw/K86KsHtt",
-	"QDEjaEl/ia8yGi5fEYW822L812g3cHA9j7btCCMKVsONJctipDqmMMFBGo2gqjWNHdk4uwnv8nc7oWn6",
-	"4PBXzdfpIqsQVOyMGdO0DMw/OK12N+EHH9bbI2VzyDO0HuILZ7RyCY9Hxfn3rx9up7H2sM2mW0F7VBLn",
-	"qwqcm/umWYdZjoviu3WUPhsGWpmkOzmxW6RC3Wc/vu7loeISqbAWLvGINeETY03gs3AYb1sX/w4aCDZY",
-	"ogO7BJs+b6JJOS8ls2ta0htkFluBHLpGiNtTWf4luNkmNLOAAa1dA3qrXLSfqmdKrGdJh0p6Bfi11QWx",
-	"WyYBwTpavusd7jUkcI9YnghBJjmsYhJ2x9uhcrI9lE9dLO96Kiv+I5W57t4+LsY/nkmHxZVGMtde8f8V",
-	"k18BfqXCLUgxLi4cItJrXbGGcFhCo42MtI2x4apvm/ZCUOZ5E+Jq7bB8Wjwt6OZu83cAAAD//w+JEojZ",
-	"EAAA",
+	"H4sIAAAAAAACA+19DXPbRpLoX0Hp3SvHe6REfdqWK3UlS3KsRJa8kpzsXZTnAglQRAQCDABKYlL+76+/",
+	"ZjADDEhQlm3l1ltbDkUCMz09PT393X+tDNLxJE3CpMhXdv9ayQejcOzTx70kGvtFlCanE/yXvgvCfJBF",
+	"9PfK7sp+mgyjq2kW5l4xCj2fXggDb5KlwygOO97tKBqMvCxMgjDLPb/w1nveMPPH8MIkzLw8HKRJsNJZ",
+	"gRfg7yIKeZJpRvOe08+OeY/D5KoYeenQmBZ+eekF4dCfxgWAk3qbMnwO44d3/ngShyu7m/h5EE/z6CZ8",
+	"G8GL0/HKbpFNw87KMM1gGBg9SKd9eLazMvbv+IHNHvyhnobPxWwCY60k03E/zFY+dlbyws+KRnB/GYVZ",
+	"6EUJQZun02wQ2oB79H5uw+97BWyKXuWtP4MhVj1vP4a1AJLztDIIYDmHR/IoCI2ZVs3lr2/0nAudt7bb",
+	"KChGjkXh17ioSXQXxhXYNzd6AOkFADEKo6tR4Q3TOE5vcxMDfj4JB4VHW20BuYlAatyvv9gwsb++o0GM",
+	"kiK8Qhg/6q/S/u8wJkK9N4ku0uswQcBt6hpkIRLpXuHcKN6kAl8FlOeePL1iog2+6BbRGDEn8+ZFFiVX",
+	"OG8U1IdFPBwdqI2ksc3xptMocA2VwDlxDxb7/TD2roCGAch5MNfGzMIbeKzt4uXpjhcNvajwRvBVP7SB",
+	"n4uMLC3aofpJ7l2HM5oz9nMgCn6RJg5vYI/bzghHxk8KN9b4N2OJ/hQ+J0U0gBGBO+X1AQljf0yjDLC5",
+	"++sK7xNPIfvTMejptzl0eBzlRZ0WCQ76FBXhmD78RxYOYYT/s1by5TVhymuaqEuK97PMn9UAlXHnAXQG",
+	"j4cumNxktydEB4e7COOYMQhImwDj6nj5FNg8bN7tCHj8NJf7QFG6PtkrCTKDeNYF5OLcn2EDy7n8gYtE",
+	"qoji6eYhCth6FjrwBATrBnPv3RFRM6AqB56MePHh3PgZ3HgEN7DGo8Ib+MmTAr6HUwawAZXDk1c+sHhr",
+	"FTfFh80/NrrPbv85+p9se9yb/Gvwan32Y/HT8/xkeBwcbk1/9n+I3qS/9C/+/O9rJ0YVG2xHWS5KgmFx",
+	"tU4sTYMobRQQTuHsZnAfMT2IWACH3ce34MIapAFCWRUA+lEB10L4U3/iGPPCz65CQBw/AzdL5sVpns88",
+	"GCwcVC4inJamAdzL94j9qySF9Xm3EVxhw9gfwC0awPuTmX1bvtgwL6LtzR3jItrcqF9EwAwQBgcepsVk",
+	"Wsiy6ZmOB3DjjAjlxM/tq5GeK0ZZOr0ayUV6G/bHCoNemsQzOHSTSQpig5dOprm9ggQh/HXF9wfwlz/Y",
+	"xO/4P/gsctOYfsIXjG0Voums3HVxiO6NnyE3yHEs2uh9BH2PXjX+poHLvw/9yhenPGf5xeu4MsQ+wQH4",
+	"C9LbZBzd1TH4Jr2FBWeAETxRhJ8o9+BR2EY8aAWIWGmHqMGXv4BjzVLAOnxFuOUvkfhB5OhHcVQA8jN/",
+	"cG2TTB7R7pdY1F8Ek3hjGWwd8GLO1fvmlwc0FqyYgWwkmcHITxLgu/xYnbiFYvjnKmlX6WGcJukKSquI",
+	"CfiwvboO/z6Df5dY1TFN9ZaHMr45V6Ma322v238/W6c1MwAXiPv6wn8KwwktbeyjyIwPPcnVXiKV+0FQ",
+	"7rFjOz1/CL+BwCInR57k3/TlRKPTURTpBsiJ+EiHJtnb2/e+Q8IlksLD99RL4b3sNspJphZ09dM0Dv06",
+	"32RG4OKYr6bxNSw9yfER4xa2sXAexvB87v2e9nMvjHBmrz/z3r9HQZI++h6wAlwoyJWgPxTCA+iazjt4",
+	"6yDkPxxeeGuFmi6vsVp+HD8BWiOc3I/f2TJK9UKp0CmyIh8EedBACNgBiCQzeNTzQUxgmTcXkdW6oEHX",
+	"HKW3MMJrwNkwnq24xHhe2KLbS6PznB+HF1GodlweiL9cSeIELmpaBD3ApoWwhbI53ApH/PB6r9dzSGOL",
+	"tj2HQ1yXKRAit1BBsAKgcLTThHYXVgCIB8WSyP3Wz0jTbSVHajh+TPt1WRIk27R4jYzWDUrGNAuQMDYL",
+	"FPqCFKUZQBoKgskM4V0KoVUQ8utoMgkdEJzp2Xn7cPJbOOA4fYkfYn8FAevn9t7OQ4y1SecCwiJhmzat",
+	"hNhA38Ljf16usqKkwo8upWmmCJd0JZmy44WrV6vej6evPrw+Ojk6f3NIPAL/Pjm9+PB67+j48MCSKs1H",
+	"neQd5rl/5YDgzXTsJ11AauD3Y4VdF0yuUWnvnUdSnUh5WahnAdFUtkEeIsyVK3Btwb6fDML4rUYx3ajw",
+	"xAjO0ErHIXiQgJFOgOVm0yRB3gYA7l4m//DwFa/r/RShSlTKmvhTng4L+Ok4LCwpdBgBh43+ZBNNypf8",
+	"bRYVoImgSWfoZ3wBXeM1GBWXiXF/C4A4cv3GxoWN/AnwgmZ7Hf/ONwNLDnKCRv5N6CVwGuDOPJcfWIRQ",
+	"r1zLtRzBhXOLKsypIYPClsGNJNKpOpSwKGBOl0kO0MV6rYMUdAJEIRm1RnB7CiQdXD4cHR4pvInC2w+D",
+	"OJp0tF2xQ5ZEuqTwgutnJERP4ukVmqFk8lXCmH2arsIkRGXB2u6hH+dhdbcPwgKNUvkA3iDZC/RUZbmT",
+	"BeDm8No0bsh8R1ce7fVYGT4L/xr10j5JLihe4CM5yHCs91wm5bCA0JO0HBH5uqKKSM6GBRM+UKTwBsgs",
+	"+AlUohHpl8CDr0MEBMSSHMC5TRkjVXmFVBk2pFqGS8HOJt5qFZFkhDsNY/qeQmigIIZZZ6DLWkbTnZ5p",
+	"uNvptTPc7QOWQpd8VLERw7dwkhC0d77LPonfEp0j7pLwFo8syJEa2wcVQTrNois8mk9yvXvlix4SLW16",
+	"bqvnazeg3ab5Gg+7Nk6BbD/42WAU3YSr4+sbFxssRa55V9ExP/WRaBkp23EZC5aQ8pRmWFkxSQpAv1WE",
+	"zkWAvURZTXuGvh9HsJIuQITIYSnBgkuLo8Lny7meDV6EOzvPXnSfbW1sd7d6Qdh9sbXV74a9Z8PB+vBF",
+	"zw+f3e9icN4DqDtk4Rz3Bv1uHX600OSFqXqliZjzy2XhdsYhEgtrIFmITBI1DP/Gj2K6OodZOr5MSDwn",
+	"HSaL4CbN1/5CaD+W8oseFGgXEdme8XpuvstM5wsx3puxP1zMdPdiAAAWaaEa1juJBsUUvvxj6pN2R0j5",
+	"+e3e65b81eunyAMQOLfC1kARuBNL+Q0uIhA3CiDi0hpf7um93AhhlqVZfaJD/NoT2UZdDMZUQ6Aut+xF",
+	"A+6LyDOP7xzqB+Et3oo5DFZ4B7EehkOZMa1D4nZFIAEvAqfckTN+HnUDGnMxVHJAiUUTiC4w7q9hToL7",
+	"0wN5OWSE1kTRID8nEbB0DxaZFNEwQhFTeG05Zxt3U3lWK34aFizsNdBpzS0ZVh3OxbYRgUAwb+2nRXIC",
+	"k+liMdH+29wDfKbJqy7P+4mCOoiGQxKIgRuDtJ0rp6nnkV2STSpMQDkI7Sx3hXfouWTDI8tjHeWNBXSG",
+	"/lhbHoFTXybEeRlX7FVFbYfG7LAlkwX+fNovoiJWY/DUhHY2ASrzTnI19VEChEHYDirPu/gvLw9Uj9Bx",
+	"vR36wELRraxOcC7oYDPqJEwnrOIFSlyXI2vc2L/KfpFvcN0GqOP1p9ZNic+QAVXr4gvNACoUIH+LloX5",
+	"5KmfZTNEB7cHqRLuKF9iAfDaWv+/WtsksLTAh45GtgImV+RyrIvMvNhFzOJtGET++XQ89rNZya+Wfouw",
+	"KNZWXFCYz1+/RXuCBOcq1EmfBw2e5HM85LXTqzm6IKO2SW7QF53WBjl/qRuoSNUl5L6DFsjtzzZ6k9Xx",
+	"ZMt5TXzCnVOfNkr0rE0T3kO2tvh9Oen2di98vtXrdcONF/3u1nqw1fWfre90t7Z2dra3t+CXXm+5C+JR",
+	"CnOuG6bhYnHR4oFf+H0/D9+EfsybbBOiltznH0K62CcIrzCZQMZFH/kgDANTPDPOZIPApyx+5bAs4qEa",
+	"MEbzQFCKGHomU89YdRJzlAzCOTEZeiSath8O/HFoqC5AbtNE/0m6gAYiDzNko2QRAeB04AiaZ/mubCvv",
+	"VLa0xL+C37mL8PigSLOZFtgaBfn6+n8k9wX/isoQXB25sna3tSDXAWiwsYd3EVowrhrAwDNNHJz2vFTu",
+	"QHQJ/SxGMU8gK3XFHLeJOIIf47U9M9SOhwX+KkunkyMHCn/AH0rnChrm0V9HstL9TMIl/u9lDlaQaiFy",
+	"xUB9OwpCHLSxPy24MxrXfq91WaytCky7dTXeuSTFLYwYMQM/Plow6KnqO6t/IuGMDBXlLYGmqI6Ok0Ri",
+	"Bpk4kDciivARC7TYrudd6UPxKrp0YYwAdflXfojTvjfxga1maOig8wekG0fXGL5JL3WQLuU8pmhjvSHo",
+	"V+YJtaWz0AgaaX2WUnJ4G/5Kh+1OsQI8OFoXgU29AiVNuzvZ5hPoHaiZo9hZTPDka38JXB/xBhGcAFD/",
+	"79e97v/43T973RerH7q//bXe2dn6+B/OSMikDZbhwkBHt9IwNGa13asMLvsHGlRh8XvqZRB4kgLEPHx5",
+	"jcJBZMNM4Q+eHV0mWRgDad6QiMjEoymRtS/0WZJ/Xw9uDkLSJYZBVHCPKsOMfmXt6740sKxJmGn88K4I",
+	"kzxiY1VFt1M/IZRXtHDjmKHVLvbRdllVhBSuUSqtrBUdQGqb1AsV2ri8XC3JA2njuZs0lrJow9TTnA3b",
+	"jO45Zm2LIYgts1mab8un8hBt4IbTjMkUraKDaYah5MRnmsT8OZxI6fwLt/5cHjQY7j0UBGETHT7tpZdD",
+	"3bchWgTQ3RGh3J3BwoJWigGudqFCiQ9p8OddauW2NNwqJQG5LrpDtyS9l3hnr/e9Z897z5C0gOeNYY3A",
+	"PmJgkPQy0DtZ75EdKBMrm3vUWRqHaL5BX+ikIKQOCNu5dtX1wyEqPpXxX5YW27y820i3wd9Xa2Ybt/ef",
+	"jb/i3y6J7ejk573jo4MPZ4f/fH94fuHaIJ5noTc/vAOuwMhmzgDgpgMgcbIgKWYhi7NguCg1X+S3uM4o",
+	"uQFFr9kW7TJI0fFWyBtSqJ8RyMFXXD8NZmygovEZWlSKMA1F/C1w4nJ2t6AyylYrVEaScoNL1LeSjF9H",
+	"YRwwZTnEYbwnfKdK9f7sSFlmZ8w85+EULWZRXPDxNBd9ZIdvTLNklw5dV1+T2a48u7s5XB+88Hthd6f/",
+	"LOhuDbY3ui+G8Oe6v9HfHGwF2+HO0DrVWfQpISCh6ZL4BKIo7fCV6S4u3qn4Nto9rRfksEm5NeVWr+cK",
+	"yiXO6QixQ3823HhkcVPDXkdoJRw6qfyVH3jlRdNgu1xMAbVJOorZ8sbXTrhzu+XdXUFp16UnuXbWEae4",
+	"IFzm0HQd2Yt766OCGpbUIAeRd0r8U6VflG0Xu5dJ13t7+v7k4sP7k72f946O914dH+56PlBREMG/cPAL",
+	"8pKMoxydmB3yTiKP1fYGNBkEKM9433H4fPCURj18e3r23x+Oj94eXXw4/Nf+4eHB4cGuFX8Dwj9ZYVgk",
+	"TrPrMHuSI2fHyz6Oxhhy0/XOT9+f7R9y+BRAKmMYt78XpCCoIlykTeI7ihEfnbx7f2G9MEinccAu1pAN",
+	"WmGAbxwcnf/04fX742N+2rjsWMKY5cCbvIzdFRTnOUGpzVry4cn+6cHhGYF6dHJ+sXd8jEseDseT8ApR",
+	"9QbY3asMg0IiBpi4VRxTpJyBBRxsf+9k/5AHMGO6BhQwFZOxCdcuUVD4xuvXb98d/vDh8Ozs9EzPyvvM",
+	"wdgJS9USKWaB/mbv5ODV2d5Ph+r1EtSWI+jlCrSwk7wYUHNCsvajT0crQhjEheFlfnADK8LTaIxmxFnV",
+	"iBN+c5IWfF+lFPjKIgT4W28zfHZuF3yvMQ+fTZzCnxU04Zzy2m8ml3AB3SKmW5/ut3js3iemna38jY7H",
+	"MZ6OwzttxdQ/c8TYiQp7NH45Yu50lLCnQH9/EOXXr6dxbH53yCcUhjlSFGr+vK+I0PzyNREcX8zG10hI",
+	"fSSk2i/nMjCGoR/CcRtLWJgtgIXyS8AwNWZ2qhHgYMCw3UGcDq6JN5FySO/aoSLwf3XeqibcnDaSjH9w",
+	"UHurK65MzVp2poaU8wXOoz/DV7MinAtrkQISyJ+pbj1RDJcBCe7XHcNxYVy3jdrdO6XRITsWaHDgYZo5",
+	"JQI9e32oE+1InaCJmmzreT6cxuVtkxvClXNatHAHnu0cb16V6CaLUpPVA7Zq3WYvKxezwmLTzBZ+nNe2",
+	"LLXRzLesFq5w9zDK9xdzpTndl2I4natH3qHa8Fq2zYwTToLf2cdmw86Pkl8+QV1KXOVyp+iXBvmNM3gX",
+	"TTbAlmOl6tc3TPn+62g7Oj/1djZfdDd0fIAlK1Pqi4W9kLPuDLOe3/3zt782G+w1c/cKVruaYxboqp/n",
+	"JJ2tgjDJVjHPezslF5JKTIeHfUwFh5NXWiJJ48bnUDwRox56M0HzWl246WGCsy/c90mTYdxQ7RxZr0ob",
+	"JT0UmfcQH6dNtlTSh9Hh994fHJ26doBmdbiKzk9PvEmKbCqr2oIRKoFWK9Fa4WC/3ABjF9EIq4MFeR4L",
+	"5eQNWON8ps+iJ4qMYGZHepcr48nm5cpDqC9aqGyM89RP7B8feXlYoDWaMs7CjC6IIq2krwJzg8ecoZj4",
+	"oB6vy1eMDs/8bgiX1XrveW+y2etcJhJZywboUZA95U+U3InjAFpgJ0EpoXQXimqAa24Cf7hifeBYnZvm",
+	"xPluesqyY3uqDjwiNtHBigzszfYTWTeu9ok21adUhUJlL8Mx7YMKKESmYpG0YXPV6e2mrRZ5b85+mHnC",
+	"FJaE6rF/88H3B4CqwWYHLZbwLya1djygmA5l867v8H83tjqUAsn/7tJb/Alfok/0PoZoweegyOVb+DQK",
+	"5DMWB8E/JF13FwcWMxOl7wpw+fwkXgAI9ofCGDALsUg5DFejVmeHoMJDLwKHYb2H05qbUU6PM0GUZ0ot",
+	"02l+47ne0dx17N9t7GzhauG/2wKgYB6oKfORhNFPAJTHH5GG+BP+S9tEf6EyPwVWkwO3539DjhvGF/BP",
+	"C2D5ognYi2kSLgC1gEcUiaiaKB3vKoO7pONN8gQmz/NozOCxEk7g/BlmaQxiTTKYNSOZ7p1VGgxBqUTI",
+	"q+lcC5B4F2fxHLQaFjoi5uz1S4+wRMJpiFfzIoBgEy7wmFnwbPRWt+eWs9len1vyxRUYDVImuSNdmWpT",
+	"KR00z36q3t/npz92lgynZosuZWKwSEPeyXuFVjf6W+lIs/sEeCehmmc5cubIuXM1OT6YnCnKWaU82jQY",
+	"+beBO5JpC9WELKBYwwfJ21SGDoehDhhvzSFMAD3hHGPkdrA1HG2SRPlIJVhiXKcdH1YnqPVeiwJJnzvK",
+	"2neTxz0irSsyRhlCoiOWNaI7iv47Kg20faxy5UzM0XFpI0wqwj/IWWdCZB1KTkAJDF+0octKmoDztwlm",
+	"enBYUv1HMfy5fqyK2zJM+U7HgEqD4MLL8aempO9lVBwETl/lOPMLkovugfLjU7iAyHeVZKS/VnLOHd5d",
+	"wSoGLZLVrQjeesTQsqYDLqGG7EKr/C0MQnmzBejcsPjowfv0bDsTCAt3rcsVndPzRkjz3DTqoG7g0Esp",
+	"53YRDJu+Tm8TEMNG0cRVTKGQXAV8hoRs2BhhwbltBJMQFJXVNePUWCx7pby8cClT6LxoglaJG+VQQJFS",
+	"auMFqmbHS35DBfKBOD9NQLjii+KWasThl3E4LCTSSYuFKigRk0udCa5RY/zQ0QHSNyzcMvRZZW56Zr21",
+	"rY0XWy92nsG/TpZukMPYqeG+04jFEj3EttJB4ce2yNTbIRozDBC9//q1133WaIJwxwnmgJtWC7zPCl1H",
+	"/J0IXPNKImLZvi5ovRMKOFJFjnbJ9AGsbJZHoiyMgb9Q8URgLZxJbqgPUa7C2enKJpqNBtdMF/tnryW1",
+	"gfSIHAgULe85xX5xvRqyowAk3hUSfz5Gs3cmxM21gvCpO/0Yhvfk5C4RebRJvb1MTC3W00osKLCsv5a5",
+	"glrzfWl+Wcqvr8o6T2Z+uZR2chE6bOJ+Zkehbzyv8v9jEKLzUq7+bhRdjfALwNpTPnwVFEVKAQMtu2gh",
+	"KZuHIEqqAK3XAHplgRMzeCU01a24Nzguiv3nNJyGbau8nWBgirDDP+hFx1FMY3RUvuMLfu+q2TuCqVYU",
+	"xk8R7CnVfOve+hFJmSIgkNCmCgt6HLUMdA9CQ9UZgW5AfAFPD7/b0kViSDSOQGsy187YJa8ZswkHJ/wL",
+	"1Fxfirm8845Ef+LMnSbwo65XIt5NijvERH8ZHObT5eAC9+il/OUYux/iKASc6/XKfSvlC12CWrmI35oo",
+	"yl3PkGimMbKHfmUMkNM8R/Ub936aWDpHh7Ui5jgCZiuBg2l9kaAhQDYu7Xzgx4LkSlk6f3Adp1dvo2S6",
+	"wMM25kcMb5+XhGFALB3/ZrtaeQIocKl0XFHUmR5MRwOBIJKhaZ+ZA3D+SJ9W4JqGebLRJSYGRrIrkVJR",
+	"GxsJ3hiX9wzlmSwcAK7jmU5uF/1Q+bmQc+AhYq7d/li6/XvlWfm04/iH4n734HNyHuYAuODAgdSS6G10",
+	"D/POoAGW/CTSw6Y1ysHBKNiCSwnnmhvOsLxM6ZsjCZF2dTEDUAs3N8JedadK8vUVuU7RGaWMih2iogRJ",
+	"ZtHCTBQ7G4sgBxpYkADJuUcDP+GPyhXSImGMR++U8LnXJTJMfV1c5rixip9UQdbVklXO21icY6ACJC9Z",
+	"gaCyygvKJZvC7TOrWvLWpl0teWvLRZbuy18SyRPjbGipDRjHBOmk1JarwUqKURvFPDZ6k6bcAU4d2Nxw",
+	"C/tV4dAhZvsTxg57h0VifIkXhshhNvy2TIWSlmV7tcpP29oCKg+WeWu912t7twpVzKWlc20Zu0+Bm+o+",
+	"6Qo3jfUOFgt+ejCn8EejLx0GYQBlWDQ6ZfEUJQaaWZItLpF0UdTLeS3KRQNBlo+2IMwNd2kwuh4mZRAF",
+	"G15hf/EWxYvWgexPsLC66a9KQwawLpoUe43y8s0r58vsS1iTLlgAVxOGxnN49hgOYdT1ya3MDn78W8cn",
+	"SAoXiAvnxuu8HiX0oGvG88dKhVDzmCIHLqLDGVocfAgnoDv2J15v1989gdH3aF+HFOdPmqcVnaeLa/FK",
+	"MKgT3S1k8/HF4cmGQ5jZ6XVVuXIVE6RZCEFlz5C9TYiQJFxRcVSKi+1kVnxMe11fUlzJeFLMyCXsBQBI",
+	"TlU22QVo1WVY72yYhRYWKLHNyUkYM3iVdvG7Llbn66YTtsh2JexA/MvVZJbKKazWtmjCCNwzI7iKlVYW",
+	"SkyMV/qTL5MHQZkiXWNcuHCQcPRXJEiQM77PdI3Ajq9vtI3JTvn6EijWmTba2FCr0vYzFyvhWhA6ChEo",
+	"rV1UgmXPeWoVmuYAwGXtD7YRuO4+lOImbn+DLqdtOFt9ZxhKKYDVRwGtJHSUlD7CrzUzYYRFibaOO5m9",
+	"GYJVjaZqmRBwAd9WZv3OrgajKLBDifjkpGZfxRjujqe265nYj2Nu3bVjgaTCuJEBnJdCJfPMafHM0tgq",
+	"BlSyglpp92mWvE7h6DnMua/gNyu9EO+RQRhUIlSAYNMMtjspL5Mg8kFLeYqJFAVzbBAE0ZYpAwRRPklz",
+	"lgSHsX+FfEfkWHakl4Wz7QsB5YEkVcPQ9O6IloHfhJ9fUIuFyQKuRuP1s9QPBqiiU+3GwFOvet/tH+51",
+	"d3rP1571nj/1MNeMMuftxjFCKnwB45WJPG3CKxauQAkWFLkBStAuCrtwb3NlStVY5q6oItUqcokDYLje",
+	"wM92kQlnWCK5fH91MMCYO5H7cTBVQ1DeNuIeFRzkz6ERWxYb32e0YJnWd+UYxrfnajhK6eaLwiUA0lPl",
+	"cnWK8Xh6V5KBqu+Xe6XTkTGT8yW1jJ++Fsq5IAHYxTcvgLZ+DrNcEdU9faFqCMVxlDsCi4eiHnodzsSy",
+	"D3+rFOdT8aZ3pNcGnm5JpKAtpyLqVvII22q4dwvcmlGxartSzRA7dKiuPlvdQImXRDb4Ymd1nWrRD4cY",
+	"ohjqb5yYUeasQ9CaXeULQN+EK98d4CE/qkpcvrQTMSxeZC1jVvFdT2V0ljEo2TSxWPC665poqOaCAHAc",
+	"JNv90aQTUCBpgRlomUqfovhMkH+cChgnDy5oqlOuYUSK+xLxMRhS0NB3aIp9vGJKY2NbLj2rmFHAVTWm",
+	"aMjNuSS1WhpHZZUYtO6w0tr8cOX5qkHfutQcLa6jacTC528Lic1t9UbrTdHeLV6h30Xmahl9LnDOEiVz",
+	"u6jsUwZqofie6qYCnO46ilPy3JYd2jqS96W0ZCyLo50FLbR0CuV22gkuTCc3PwWSeiLedAzLw3rDcmbx",
+	"MkYLsCqTra4sZM3sq5eyQ5Sh8O70/OhfHtAiSPsKgMsENdMsBEQF04FKx5REnT76+hMsrfTOz3Pga0Eu",
+	"lbhnKvpmLOZoqp4gkXhnhwd7+xeHB2xbJ/ZFuizokZeJFMEIKvGZvwrn80jg/0At8UDOwK5B3chrSG3w",
+	"uoPdG8BOn8Ieu/7AW8evfA9EYq/bhw+bG9ded+Y5i5tJQgblSCxVgm+5QD2dMrhkVN5y9Zy1RyNYZO5a",
+	"ppBqNVH14eqoapu5GMzOwjHXLZnnRBIjmYdheXEVQPaX5XNPpm0jbnFOh/M4hZYcFKOoNXL8VDZBA86L",
+	"g5MZlSFX8YE2szbb79rVsiKiVpWs5t3PS1f3xtLxLsH1IpsSZfrcSYBzcHUKq5XS7GfxTJcL1UVeCt1r",
+	"IOBCXGjDcCovExJE5koUur2DzK8cUnhRiAiYj7CgKjcHeKcsnsQTRfUS4mefNzuyCjXgSw4tkQ1UrlEm",
+	"fZUBLPNwGEyEUZ0dWXVECYqDOPQ5Y2NAQ6M1r+qOnMeDKnC6AxqA4kqtwWjmqTYDL6mrtOhYZu5oaD3D",
+	"FSlwDS1dpVmUZs4g8nfyi9HS5qXgKaeKipaXWRIGJMpcRGASyhZKtEslCyI1tc0GbDafV0auWtA/NSS5",
+	"jEiaG8XtSwPbSLkzfDOWiZQkRW/kPGAJpK2mWHUDOe7fLER0vkaX7txCeZZfHot95UZdNTwh+DUPRiUA",
+	"xMxaKoSGrxFLX7Wp/CP9Wg4baxkqJmaxEIx+57geShmCMY6GP3MKGJxm5CgKblXHkMJFJGIGQ+fgI1az",
+	"H2LnR8tRZTC1h60STg+dj/yN7R0HubzZ68IP1XJaKqArZ4cIncDK4mk/9o246PLMvBg+3wl6z9efP98a",
+	"PAt2tl/4G8PQ93uD7W0/6K1v+5v94dZwvb/R7/Wfb2wMgvXtYGewvt3vDXs9v/fcuYxJ6DK8aUcV/c4N",
+	"K8ldg0FysKgMvfDANNtJGxvu9JOHywkoKqaRua+bzy5dtV2R7Bcp115ti3G/upDOoupOR6DQupnL0Dp3",
+	"wdQ83UqxSo95mIZk4V0BcmjuEuX5e8LiMJSCqdxp5K4AZnGFBq29fo4irNpXibROUm+Mxh0KwFqI4N+b",
+	"wl008Ke6OnjFPnA3gUHy+TTHTQuNmmxnxxznI/XIPVU5qSXxZU5b6BWmQeDQiC6U1eK0LO9uqFKAszOj",
+	"ZGIhbW0lwmaaxCTbeRM429FAwVqGvFeztteNFoRrbepRK91VgfhfgsPv15/15H+X015vYyeHJflYWPp7",
+	"v7++sfiUZDGBpjZk7n42F0jVCX+LiqSqB426ffeqrqoaQy1sl2F3AGPbCxZEb9dnI3QXdP0UjfwTaq43",
+	"aman4iB1p+vZFVO5YOqFke7mXwG/u0IlVsqKLVEItVyNVHXs5r31exZIHakiNYv2ppbBTv5OkDP7wK6P",
+	"0EMMvKFRadHIiuRJzC29xWhrLVQzr2dlTQ+MTU9HaXqdO+rN6rpZRpaLGh40rje1MYjfsjOZrI4oMevp",
+	"UW6mSn1cl6lMpHdNIzK9RzXAy9ro2LrOtrFtN/cic2o4euG/MMzvs2gOMrGsGyDj3en5RR1lGJ2phVPR",
+	"umrIDqbcBq/Uciz6GhXFJN9dW5NvVoEu1vRELer33a+k7KLnq6lWbMDA3BIgwKtx6Exk1Egr259chzMy",
+	"5nThUqM7KZe3jcJJGC0pYyvjBpX8xW7vCUX7mc2Q143OhUhs+STGpr4J1W+VsVF7K+DOx6q+MJZEaZvO",
+	"VArzKA1uwsqQQYDSMQb+EFCKjfJr8KWtIpC9QebnKNPnUzRT6dhqdnsIFFTjBKN2xYplgJ1Tgz2pvOCD",
+	"NHNbgii2ZaMSPHdcVbEZ1V3ocBC5WMoiLjw1icIBB2ORmkq5aywUSdKBxeK2THV6Z9HxuU9N3+/ELtBp",
+	"33fMHauC/ZklAwmmgA34AP+5m1HoRJDcjbIPcf/pKgaxa82dKwDktU5uiB1GW87Kv12HPrTym4BxvVlF",
+	"a7y0jH6pzE7G1lwm1b1hNZfiDYwg1k7ZN8dsB4Tt3WkyAB8wABs9uKb+P0gjkmTWVe6bGC2UGatsGkiq",
+	"W0xDUy1FhPn4nLe9cscToSfe6nhz+twbUzUHgD72ZxQ8BcMc7J2/4Te5YTY9PAGmDDs2pMrCZTMCtVhV",
+	"G9dHORZDADDYApFAIXIqAlqX9LhMsNAzfKLH6G7QwX6ALyDTgcZ9uUhAjklCHjt4EEveZk81/9kCsvHo",
+	"Zy6dJVGGOSZgAneiaKvcDrdApAsxmmPSSQXBPKWK2D8cvaYYHfUgXCDvOt4ALTCJusqqmNZFhTvm5YZt",
+	"vZXICFOfSONvoaTcdcphXdQZhemJczk4jKgtVb1TbEW3MaVNvOtaLKQ/K2U81fsvb6olKbNhmoay4Soe",
+	"iWNL8RPuH1gaEVhOmy3gbNVSJ3Nsi/NMfKdiYqrG4eooB3UtiPqQlz1VuU0u5QBHJX2rOJjKTl8mRglj",
+	"ZUakGPtqRL2Oopd+zraAzBpbhYq0bxCQ8lokcWISagksHxFTgNM8yTuap/HiOCupGm9fuVmR3eLqt0Hr",
+	"8q7hdzikCBZ+t6m/4+MFX61v6a+QCED2oq+fy7eVsMlW1lE7gOZ5g5F036x6ML/8ETbeLk2f5GUhXYEy",
+	"jqiDEdzQ9qCeIwNK7Onq/hiKWWeAqom2/ZFjg/hXZfs62mdDv2pw5Gh26uZQlGsK7DWtKviLaoOw66aj",
+	"muL8QmqiZtRuPpfsXGVO9riG1UUZxAPMdqQS2Y0GoSzAUE88K/ULwMErFau1kc89IZccRs1SGiPOw5bP",
+	"jS1vBCPlTZ2IDRPwPbbOdHpZBuMOx3HbMcJs56XAUgJZmt1ZohV17CvRALsnjVIt664qWODag9K0bZjH",
+	"BeEnaVGvGWacWrhL8NZ1NFd9WCP3vdqSGTUgWpR+0KkEX77XQXOT4Qfrh7Z824POimiIF+l1mMzRlNKJ",
+	"j1biAh/DPZQ4Ejz3SsecgFiW+lIjeYrKUVG6QzTwmOTijA824AB13VmHrdTbJDvIR1U34FKDINsEnjmK",
+	"h3W2MLR8Z6srQkPHdDRxMxaCuSPa+xhj/JGtRtgh8zKJ0yuzfGFEBf2PMOXT24MVpln0J2cVKTBGCkUq",
+	"Ye5y5RX6gDP4UJUTrBHmoKS93u/S9lk6tnjBQrVexmmh1CtziiPhpIxaVWAZt42694kr0Y2C5TIIc6Tv",
+	"YIgZsoWifYyrWEku/OwqLOz7GZXFuSFtbXpYzS/OWvUKNUat5NqT62QKKkh52WpF5ZZUIJnvDKkHv/2u",
+	"E50pRIOaXtQj26KMmJs0VNOq6EPVDUtU5W4niKXmyzAY1gdquESZOoxPDc1C5jmXPqSUlgarBQU02uoR",
+	"gNztOXeTBTf2wgM0nSy1JOMAqIxR9XeL87DAt/Szbnbi8C99Qj+Vh2mAclOKbi7vP/sV9VpA3MR+CFzM",
+	"tALMgjxt5QSTJbtQ9p7sq4udOAv9GyfhLTcuUSIFC9O67e0Cz4YYp7BpmTvupSmmh+aVX+H++2X5aJ7V",
+	"SjhPmR5+byseAlXULXmtaoELJpa70M44yJaz9UrsC1emm1be7Yh0RKpTElK7M7K7lBc6uoBVIiARLVAc",
+	"7BLrOuPVz3Xn1Wiz7KpcWy/+ZCcqY/4TWY5vzJS+emNj7NtGhqwea+u9jjfB48O1yVSPukzKvdhlC/xs",
+	"nCbRAMtDWjyuOWoDltz2SRlUE8KL7dWNVpEhQKOt5qgwB5qQ32Y4O/YCXdzCks8dUtXv01xqx1VVSPgb",
+	"C6yw3Uplj4k2JzYpsdrbdknW5+qt2sMkjXIHYRzwDyKekXdzMqGkVMpMUZrwS2/0R5BsBtRQgQrwJjEi",
+	"hNybXH2X+rFznS+Ke5C2YKUYxCPgzcuvtszUEgjfqLfl7xM1CDmT6atjIEZXXAKQdnJVljMIrCXb1igu",
+	"K2wAHVPiJ5Kb/ACgplZz1xawE2DHMpL53Vs1qvnluczArdVQyY1UeeJ5losDSk70Nnc3vMk0jjG2wfuO",
+	"gnupDhLWf5OxiK0Cizu5ON9HLIy9g58P8qdiwMkL3V07i64wxtfb2Fx98WzHG07KbjcYusEB05hjLy4m",
+	"vDKwwoye3zTxYiWmfEoeXKexQZc/XrxUfMoKecXiPWQp4uXQUFal8RyIBdv1NFZVd3t+pHS6dbAcAc5S",
+	"tm8Rg6+W93MycbkFDsIY1dZZYwLa3JKugbytkluweWkQSki7M07dysNoFwSk47/nhnZrUHThC+rojU75",
+	"PqA3XSZ5rPRQz6nUw9HavuE3NwnBd9zxzk1Fv//hvPQO7YCVPCJVdo0xPj+IPy9Yr3D3Pas1pQPanmZJ",
+	"SazKqKHcInYmk+q/wD3sgobiUXCD7bWgJE1AZgSZ3tPI3kVXUdc2UZgVmjfqM7usJRcUv3bEFlSxRkSU",
+	"kXXR0Am8FqYVrZjEZMQ16sNlI8g8H78tPrRuNV2wJn8tIxJqZrCwym05RQswm6wbB/rI0gO7l8k/OCMz",
+	"8LpkX8YAA8FUyM0LnEUG8T2BiF69MElXU6dSNDbu7mRCfE+TFbyn4UGuwfL3VIU0hncjf8r2b7Q8qf2z",
+	"ksQZdrICCTC405puXXYXwZTOAa42bkETHNu1fJdFjKuETfv4Uj9kmlTQOCw/JjG2EyxM+MwAb/P712pw",
+	"88s35UTlMn8KZ86WtmGwsb29/kIFYmICIUVvU2MYeNeDF73vsPHt897ms6eOpiGOoP29+Naf5d5hcHC+",
+	"5+KOg+xmzksEkOu1a5eJAOHDnuWYF0C1T5lf/qv780UXfuseHShjr5YN1QGi7KHoKsmdk7k0a4Hx9Kd3",
+	"zpBZl/Qtr8A8rlfu3Lyv3A1lEZ9msTKKlzIYpvS7KodUWMY1WTyuqUoZoh6nncM7AGvnoYO5YRrrsmwN",
+	"yW4RR6Nx58Dzjm3+Dc2LqEuRvhgMlb3jOQLyJKxABRWaFu56pZEHS39WIimVsmAadURJtkvlWqqsmx2Q",
+	"XYXBYQpZbW6h0hgf+kulc2E+wfENW4+ZytYejDa9C/935+Y+JNl8Ymbug4Jyryzd5SFozti9X2jrA9Us",
+	"bHcCOOiuLMGrUky+REnDB4LwHg0+m3JBP4F53Ts99BNI/iskkT5ovihZ4F15E//qinm+W6aNYlrPgG3s",
+	"GPlSNppAsNBuztFQie5JYQ3CstnqZ8g1fEiWVbijLS5K9ZQjGHQkSZtyFk2RFQ1pf0am7gMk+s2Rt8Q7",
+	"MadKTc1LKHH3IlpzzBDo66DC27bXMvANxTB9ES8jUTbUvGnco3tExIgmYayizbYVnxYJU0gITGPEiy/+",
+	"OkfgzVJRKk6Dy6KolFwU3AcJQ3HYapzkyFXZ6xUf/Ynfj+LI8MO62ANXHSOmlJGqrm4q4An6eKrKE6rs",
+	"Dzw1QkxLdR2kUqvy2FJ1b1i6ktJKLU+zCVUZ2GyYGhdGEYzSvHDXaH6TituvNr7nJpU56ccl+KqoBnuz",
+	"moyh2igxP4nVGvOJ5E07s5bmF+JovPTfVVrYySZxHYY6ku/Zqi5rnyFuU2DrFd4/hb1av5NsU4pmOvbp",
+	"qsxjrq2+rc1n2G0slUh/h8lkQGnDOhPAaOZhANru0mAessgEoUBpWsIQm4vWGdFSha1uZZx7Vbe6d6kD",
+	"Ctpvb7URGM/hrWYBcZmWiji/eao1Br5IRQQ146cXQyA0LlPgQKGyMXzo/jtTrwY6J1JsqdhnQeFMJfR/",
+	"Ov5wjfPQQ+tx9ISlZHKlUFvuYuyn3GlwDfpc1WXGZEd5GIA/bnomlTp1aIyKvucYRCuR70nu9BcGIUZP",
+	"5aeJu/1BWVceV80zUnaaknptGUQXBo24zQ+x1nwpCWNxKwYEpeNN+YBww7fqxpYynRZEKvnonIz+269l",
+	"541eZ3PdnZM+cdokX0vOJhU3pNIQTCoqdaIvSHPqgYXWR5rXWcpQtPNm8aIKUTA9SMoFDy2JiNjSLRpg",
+	"/iXts/RztkA1BmqAVaOwLatW/MEo992WD2Ah8IdJCTD9rKJwLJED8H6RRuF7CmzbpM+lFSwMKyg0ipdS",
+	"JUQ0aKxF7rjfHD5c45S3P4v36s7K1Ch5m3IQbDJbvn/rMkZxOhES1SAhG1wm8EEN5O3YlFPsad+LtiUq",
+	"l+pWe9/yUkszASusflkGMOcM6EUsOgwXzk4DVHneL+U2IhcqiojsEyguoa4oRpAipxja3BiDwpRAoVOc",
+	"ie/X4xpXrcLvWMi7Y11LvJ0rmvW09aVXlvpOhq5+f2FMVf3tZzV19YdfFCgGTpd2XZbRxoIzshEZ13Rd",
+	"eJx/pMzRGs1Vy5g0lUwtAy6+HAT2owXGzHvLmMYELGk6qByPcQg6flTMzvEASdDCJGqKh0BrNgZBEPqx",
+	"TEhSYH1GKVxlFZLhTAh6JOceG1hQYYwu4BDphE4siZCUUVauB21nABo1Fhm6ev8ADNQAyU/8Kzw5HC9s",
+	"Rs+Ts+UyuUwkRUNqLxCUAZdZJ+Su3azjORtGd5gIwPIffKXs9lRQBzuoXGHWL3cIuAnjGdUo4dpIOcuw",
+	"Uh2AQ3ooslY6Uet0+wwuqasEuPJLrw9a0jWAeZnI2MQVKD6RQfO9hHIUEDAFtC7vAACKeEZZzbg2HXcK",
+	"QKrXqHVFCH8MiKH4ceRjVwES09YJN+fz90wl5lN2oGw7eRN4v1hQ4oABQPwtlrLY6q3r6AsjiR/f7IcE",
+	"v+qPwYTD4bbcQ1BVL+IKIaxIch0Dow1mlOFgoJ3wCFg5SQqYYY00ILM1Sk/+k4deW72Fq7p7nWAgLuOJ",
+	"9uIyScg1gmPBED9h/XG4SsIyoJHJWc+TSzt3JOKMSYNWnzMNkj8nS6dXUnhhjckcxCQueVGpdEDqjLrZ",
+	"1UT6ZDDdnims6H62ZotKT7VRRKKeJmVxLZ+2CzdDbeZ2b7PDPREuuerPwd7F3qu988MP70/2ft47Ot57",
+	"dXwouwiiZpHNunsUVsb2944VOrqNuQ+qq27uD1Fapgx17K5gFGnlEqIS5sxxwdI36aIscQFY5Bsr52O9",
+	"vtpb7ZGDFgRMYEHw1SZ9xVoTcSZzR9duiq6w4q6KvXEqQmcUO5p3HJFb52HBSef1OC+VNSjZQRgIZcS5",
+	"6RgpRDKWGKIqS5eJ8csAZF8OVtXMnMkX5Swj/ooBeJ/AmaX4EOIZlIeYc+km9bg2Hum6BpcJRz95ukqf",
+	"VviMZ70nq0/KUghG/m8Jybl6H5ci/EYyx9KUPJOId/jvZcIcao14yAptFsvteI+toCWzDG2idqfCEWl7",
+	"Nno9Nl9Q6Qa+alCppAHWfs/ZlMGiXPsIKozGouuiIp2pGx7WhncC0Qg8tT0XCMnm+8/lgJHEvToQVD2O",
+	"GqxxZcdQHsR0fenYRUgr5RELWniOmcka85tGGj+OVBqNwZxKZzMxJ5Ywq6yJu41zJpSZ6QXSZ3pNdWdE",
+	"nhXqlbIUzK0LSsqhshZcuIuIl5sz6JjtUofNLRmgiYD2JtEFr5aLn41Drsz4a011RxiodJJOVJeLIlKc",
+	"G+1F+CjQfIZCB+taK/rHcnurwtRvn5F21QrJ8O+gmnIPkWC3eptfjmD3jA0iD5iSKB/tybGRNUlzx/HY",
+	"p5OQm6IMt7ZmQvBUgUux9Zi3vshagg4ie52MQI8TkphQpMi+QEyngxs74QlZ5hgwvIpMVnTQyauUezQ/",
+	"KBVqE5utQaAh+WPtEKw/+PTnIfCp+cdA+4PoNHxRIqSEaHVpkgRYEggQBPI5IZ5vR3XeUWWKVgeQteva",
+	"9bb2VxR8lESR0NUk64wupdwahu4dPGTX1KCIGpAa5VowEgpUQ+oP7t/6bLPmq01ujLLqU0xV8I2DitXp",
+	"5p9UHss4qXNvrAtTGjQwIbfUhAtzyCVFOr59Hs0La5E14EtcYPNPrYgQj+pgbPW2viAgGhVYlJQLROC1",
+	"I0W0DPw8uvPKdN3mvK5lqepo5758daWBQu5Raj5cjsuKFlyRyEz7YUk4cvWChNrybJOWwzo4GlBa3dnL",
+	"XMtntNJ/18Pe5qJmWvh25KtH/lEfddozx1HnMIMolxgq9+k+J7+8Wd9RohP4vOswAq6KTCU2Yn9GFsKh",
+	"uKApJUfV3SiLVnH1wGjo3Yz9IZtvi84lmo+UWD2JBmT0+GMKUhoVczmIhsMQ1E9kN6oMu3KB5dJccIAB",
+	"U1rW515RL3WLH14wMw3qan+ZUPlKdqL5zKMwijpoFtz39TifSXQvJ/hKwruxQgfVlb9qG4QZ2/G1xXie",
+	"/8UXZAomZUmFKIycRjePVVHzcRqopHcFcggVMyKHFQ8hH9gax1j7C6+qj4ussuIzU5kcvokpOv+mXVnH",
+	"x3doZj66UjdVpqUGpqpsfOlo1YVK9VdPGP+rtSP8Q1hY53fhHV9tEqbfdFzzcn0/zou+9aHOtS//i96t",
+	"BgT6cn2UBwZIyKTjvGyavKayIZsv1HfS4VY3i8MCkvJW7o3SW2pYZFToLVT9AJUSdou6LJUzo+ot+AYG",
+	"clmFfOmZfsiVrVhkFjPxhK9muyTTBMNRjWy2st0Qqd+ItWAakzfRp9LJidfnuEFTvi49XuQ4yke+1NnB",
+	"2kK+N4YNLcoKeuJ6cV2zKlHUDEj4HPesmmepW7b34NO76LD87ZHYxIRcB1h7UKQkClF5nEdUoc+8JfiE",
+	"VtsStbrBaj2PpJ+ITqbgKnF2LkulVyGOw7U9VGMmx9VETXPPVdz1wrvJ2cDp6MB9OZXTNt9PX/I++jHt",
+	"03Jdewy/yWq+0mV0knJgAkkWZLWobSeIeBrhj/Wa+r2GRzwDVC8mb0X5fX9wjXWlNY3jiPR+B4M9RmE8",
+	"wV4wGLhCOQ9UGU951alLIJXEcnoC/8lgfEYSoxma3HD0o1rgI/Ydy24ZO7f2Fx7pjzAHsGqutLBwI7nk",
+	"qVQxijA5hcaSAB8tguBGayEEI1lU3xGqOUtSB9YZgS9Nv/OYoy8uk2Hsg3hB4WeqsnqKUFKMhWQX/nR4",
+	"sAcyxxhrKA3yrj+JPH6Eq9z5GKoCd9CUA3Ywh1pAVbFHtBRpAn2Z/BlmaW7FsQCjILFFkaG0VKBJqEwK",
+	"vuKSO+DEEFGcC14XcGAz4JAgdPNdCUl9HEzXWl/joVDbRiZYDB6JHy2H+6MRYDwvHC8254BwY6BbXVxP",
+	"S7BU4Ao/6hA2FecVFWIoKrv52gFbGRYswIAtbo6B8WsYyhNjbHnGskOaofCMVXPSjKLm4CgVpL4Oh9HA",
+	"68/EOG4OjM2SSsMW8i3k7ImOzJDQMG/sYzwexgpiCzRVaiWNg0XRP97c4B9A9lmI3U45z/WzUWg5iYMq",
+	"LsoNIjMw7C5T5uZXmJ+jYAgE26CCsfj0ixoHKLFUqRaza6ueegedHUYMTyoZLWjApAKvHD9tCKZS4MV1",
+	"5V6UULSJvmG3ilEum6QenRfoir0pkwZbYbuWQPCxsxASCr3jmPoo59V2RLT2yU/8PV0fQO5v6SjgXUFm",
+	"ypf8PoX0cu19lmloCLs/aQ6X4PevuUlpw0rpLWuhbfNl6mt8ywnWRotHVUqfF94EQgQiqQWCThTkTG0j",
+	"b3tBB08H3jljaiANojF41ue0hXRaOtjgJi/bS5NMSG2k7R7SDeDz0F8tTqvWhdt14K2z+BhM249TSC1q",
+	"eFoQqkUuXGdov7J3DZr6E8FfsIICUxQbPDSf23BUz1/8sv4ZO3VqAdV+c9Kwk8a2ziz002xtfEHoLsqo",
+	"M2rnnHNzJ59rQXC7OHaK/jFNCx+7OqS3j1NnJY+xnG+7VFNFDlrjZMtmU/kbPwu6/BDrsNNEigNKBz3d",
+	"qoJ9NBlf6shG/Jo8JMISKaqYdXaZmKCwN0sgoiQ/4jSoAFNtFGpaPAbS8NH3T7ZvpVb6fa6zLk2Chb5B",
+	"+PhR9yZCIxLI40Jieg0UmcY97kjLlRYFehFjzpCHafD65iWT1IvqtbJDXZyefni7d/LfH348fXXu9FnT",
+	"mizB73OwxFfT+Pp+bLH3uWDAro3O1AHK/PApXYjMGVRgLqdue0xa3+55l5+YD4fq91u98SuHO4AtH4B2",
+	"O2s+34d3Ex9VU+0L0+9w43HaHYtpT8zjIV1mlW1dub7GEcKuUm71kJcJyJRTDigpMwTNktb6UT670q6L",
+	"E+2y6Eb1OzazbPjqQA8eUpA407Iw9pFtY+dq7FKap6KAMGNS73L2oYhDdLaR40gkS0ClP7HLX6ODzav4",
+	"1y6T9g42lpIO1II/t7hUn+gryU2OFbudD/ljiUn/Joncn2GVUrBfHu4aowrv0AbYaJThVqy508OnWZHE",
+	"oBVcsMtKuRpSeCBOUZblI5v7NAAkiplP3gUZgU40lW8Zej8cXngGpBTElpEpMEk9Uv2pkABlSWNj3ANK",
+	"fozR6ERsy9QSxCiPE+6f/8xbe5lIFcYs5V5LJL/AZ2SzJPYQL2FzEpeYH0eDNE6Tbh6i8QhvS21uAUCi",
+	"Bnc+YXhJ0xNvy2MwPZmQ/C80Pb2mkCQSg3ih2lsUNZmdJIqpLaJ5/3mepU06d90kqPOMem2EIrwr1gb5",
+	"zfznnOxOH+NvJp6mcAqhDIv7wQlwMlRKGG+W+t761yLKSOmd+6pyXpkYj3ZSDAzEuMUO9WgTrhxllwnJ",
+	"cTV9DI33qqORpYdxExfq7yJnnsSqIr0FdTQ38sOeqCsQAxvL6/ElRRQbpSGwOvQwwo5yyF85SJmG/4xK",
+	"H6X6f9P5/m4631eS9BoomaSEBH2T6VgXwDGPxiNNbcJ6FUpLlSO+QFkti1015TiVgRSDWmvs6UQ6h/aq",
+	"rZBtmxALg6qwDDw6uKYTLw2gsbkTxUO7XK8VYeWzHemGvtBf+FC7uoI7yOHc6k7u6gX+7TZvCJuw4/Jb",
+	"2nRuuIv2vGTAacIth3n6LlU0MouQuntZA7WjTOl9p2KcaaaowJQ/1C/hBFORmI4XTBl1HJf/VMcEhQkF",
+	"grDAoEy26qhR0HIWdofU7FTCpSWAuXbYpFW45UOaq65QiweptKcLrYpFixbDPoXWhpymQOkGYZyicLlB",
+	"qdsTLB1Ga63Bf3tMvrHPwDuMnu+Og1L+iq5skhq+sYoaq1CHwcq2Ue4zPHd0njlPocYulkgRql+qfq3P",
+	"x6I7cdlknur4nyOfp1PvQhDbhZqwHJbRB4vbtCs0ZGWQeUCpjznboHVZdTUKaPdooS4rWETjkJvk5bkq",
+	"8EP7RqqE5EqHEQW9YdWEBt6C8aKgt+9THbvluItj5RJVag3qjVKppGdhpKmwDy/rXFeEdQC0aca97Cwd",
+	"9XJ44V81Brtgq3ItbEhVCVVAGzU839vsbVk4VufEp4pcUobQRkHHWj28PArjwCYJMvgB7pSiSNPpTRup",
+	"PiiCpaNh9wSYQPctPvooomsWxyho2x0vhqDBraizjSNV6D6vHZiOPi5cEI1r1HtST3+OVQiA2+TsgjrL",
+	"qO80FX0zcewRpF8P9q8fyPEFMzNsunn8mYKFi865VvzAUSx+X2qGVryTHZWthx+iNCPZuKwYLlemsAqJ",
+	"2Uf1XVqWYEdjYD6vuW5pHA6pd+dlgqYyKV1IhuvKVaEE/oBNa6VMqiubIn7x3lH1B4VsaxYr39vqvcC6",
+	"cpjy6AJQzoNZo86X/mGOzssum9f7yVJi+9cQBz6TyF1Z+dcWvJsYviaPoBoY9I2FlcaKLxoTZxxEFZ5U",
+	"OZCPkq8ywaOEhg0dkTnMDTSzoruajRf7EmpmFDuRskUqZIss9qCtB9IoANsdS2wa92vNiBEGUT7QzgLp",
+	"A+nl6bBQryGzvkxU+zGczc+v2auuxkG7QJFOuFUgXAIxVvw3epZKzYTbDHMSE2kTq1NU0Bc5kbInOLyU",
+	"OiFWvL93sn94fHzIkSvAI4uIsF2YxRgwVwQAlvC7uGTHhBbJfRD4VYgevBlHmJZOKLpM+PuOdL7UnmtZ",
+	"ACwQb58WIWt/E8XuVu+Zwno0RJyUjeYatJoxr7FlFQfCzVsOXanL7xtfjJ0zILEyoRAn5ep6JVnnjA+i",
+	"K5YyhOFgd8UBSBvfWP9XYv1KyDM5v+Jyjzn60G/J6kFbnmOmtrMQjHBwZbhVaVagjHH4C0u4lrSdsGGX",
+	"G0iGVmKWiLyBlKsqsHJ4GWjIZh0W5imwWEcEYwCgdoqrAGPgT5SIK5YEtFZSJjpOTEEk2NfgSgR3Wc9l",
+	"YknvwpbxR3o5x6LsLz3ZRgmwkRV7g3QSmcGOoGxc4QUkrusJhzuznTvKuBcCzJd4VQEUQOIu4mUxLmWt",
+	"CiXEsUitKZ4AnjOqJ6iiqlgrYXzPkJsKsV4mfEkZa6awbbyXfk9lvnJYSYJPM91ww3nvINU8zLVD/X6J",
+	"CP9WmoSNgW/ZLd+ulQfJsuEz+i3R5mFvxJjiidpdiGX78rm+GA5KzKliJA0SmU3byD7T1FGAAmo49mue",
+	"r4b7pT9a48znto3Q8ufFM5h4N0MbvtlZW0Y0hCow0eVFbDgdcdquiIpSzkWF1UVxsF3oeFIY24VcBOQY",
+	"FETCu8I+NcoLNEyRuXyPdCrikbSXokBnDDtXllXknkrvxzBsCQ+gMi4DEGzikKM4pNTD0AIWTQRSSc52",
+	"KonYmSunIYdLkPUiTRKKFp97lI/Tq7+Fav6TGLlLBJPT1ShZa+DXjaLGQOiYe7ctG3Yxl81QKDMRz5LB",
+	"zIcWeXYo9gzTASigHz+oll2KXrnrrlJX6FHVVBLDcS5Xvv/+e/3wiQd/Xa6sfuNELTiROnxSO6slH+KN",
+	"W8iKfHILdikFhPLEqJHU+7NjibFivXGas4IVqB5qhvWQ4pJ0QVYqurpEvMUpg/nveofL8l0XOO9EYHSt",
+	"04GzBub/fa1OdgIBhRcXBglWdbhHebx99/6W5J0q+phzxtfUGAsTz5Y5tVa3A7NtXL/wqUIkGXWuakdZ",
+	"GgSqkERkIhSUmLeKSrQZhWrZ+DfgFjVJQbfpo+Z6UmiaXSrUxQ+2W7LvOBiHlP4G2SC8m+A+tAOwqQ+z",
+	"w88QYodfRExQ7wtY5iPj5E3ZY/q1z1iVLh0UYdFl8co+m2Xr3CjxCaxWSVo19vkF20/o/om4+5GYelJ2",
+	"m/I2B1+ZpaeZxSMet4x0YAokbdnmZJpdhfOaRx3Q98p763OoIXIr8qqS1aFjimUdR79P1rbK2t5knwdk",
+	"ciJaP2W/a5Rj4MyEuSaOTsfOlLq4+5zYpcuQmie5uUucpEuxOAhtSF5i0ydMyaJYyU93pdf6Y+ueNu8Q",
+	"bY8/JsbiK3MC8TCDiyjh37gHzWNzIRr+7cfIbegEtFXAlINvsaEU1egKA9F2IKNfvMNkqplSjD5ICsTD",
+	"9tTz6zX+ogD7O4hRlBFDnVtHoZ8V/dDXHXCxPsKwFKmoMTolyqMZvkFeYRtz+EaNlT+0weWT2xQfCAE0",
+	"GXZ/qXeWNgjkm0GlbfvkCv6WPNRr5FqeV5CIk8hUXIA+1kb3F5Ax0CGiAMJEYnrE9O/n0z6O2i/92/ru",
+	"x4MOUsjq1aooF2yWHIQ4keQn3yZSNphUDEoFV4m3GFmQBGRxrOZ748qq3OLvcd1vPILTKOmD3y5zZZNR",
+	"ISkdpedgWLlqYm7RN95k6gp7nPngeSjB6fUDLDmuYsxo6R6VGDYVIMr+GN+46/BAJ0U8M6qT2W5xKyqQ",
+	"muDx7VcXAH4RyD7nHUZTNDYrZ8+4wtDjvSIUgGo/h+S4bxECph4mF1oRTnJKQ8bmSXrTOpLfa0WBPeWa",
+	"Izn6Ta6lIoJysOA4yrtC0Y/KsQ5fR9hTGgOX8Se78ZllAH3JaX4+v0URvB3xciKQPAZHn1FgVBZ6WlFc",
+	"bSj59ous9TNVD1DDf6XAIb06F9NXu/ytGq6O/Cd82IVwid/7TVE8SlhI2VFI/QGRGDvfwno+S/3c25Kg",
+	"Ta52v+aLBgsiH4KmACr9NtRWff6OOOMIdCiXJ1AdpvslXt+WTOjv5gNsxWG+UssqPf/j95DfVlFFj4SD",
+	"KeY1IgWhTcGfRD+F+NdvuKU8oou8jtMBzBgAecfpZExVBOhZII1pFmN+dFFMdtfWYnxuBMLA7vPe897a",
+	"zfrKx98+/n+f3hpiwVcBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,13 @@
+package vtrest
+
+// BasePath is the URL prefix under which the server serves this version of the API.
+//
+// The API evolves additively within a version: new endpoints, new optional request
+// fields, and new response fields are added to v1 in place, and clients must ignore
+// response fields they don't know.  A change that would break an existing client, such
+// as removing or renaming a field or tightening what a request accepts, goes into a new
+// version with its own prefix, served alongside v1 until v1 clients have moved.
+//
+// The unversioned paths served before versioning are aliases for v1.  Responses to
+// them carry a Deprecation header pointing clients at BasePath.
+const BasePath = "/v1"
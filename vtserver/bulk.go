@@ -0,0 +1,268 @@
+package vtserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+var (
+	// unfinishedStates are the states of the jobs a bulk cancel acts on.
+	unfinishedStates = []rivertype.JobState{rivertype.JobStateAvailable, rivertype.JobStateScheduled, rivertype.JobStateRetryable, rivertype.JobStatePending, rivertype.JobStateRunning}
+	// failedStates are the states of the jobs a bulk retry acts on.
+	failedStates = []rivertype.JobState{rivertype.JobStateDiscarded, rivertype.JobStateCancelled}
+)
+
+// bulkSelection is the jobs selected by a bulk request.
+type bulkSelection struct {
+	// jobs are the selected jobs in a state the request acts on.
+	jobs     []*rivertype.JobRow
+	skipped  []vtrest.BulkTranscodeSkipped
+	notFound []uuid.UUID
+}
+
+// CancelTranscodes handles POST /transcodes/cancel requests.
+func (s *Server) CancelTranscodes(ctx context.Context, request vtrest.CancelTranscodesRequestObject) (vtrest.CancelTranscodesResponseObject, error) {
+	selection, problem, err := s.selectBulkJobs(ctx, request.Body, unfinishedStates, vtrest.BulkTranscodeSkipped{Code: "JOB_FINISHED", Message: "The job has already finished"})
+	if err != nil {
+		return vtrest.CancelTranscodes500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	} else if problem != nil {
+		return vtrest.CancelTranscodes400ApplicationProblemPlusJSONResponse(*problem), nil
+	}
+
+	result := selection.result()
+	for _, job := range selection.jobs {
+		cancelled, err := s.riverClient.JobCancel(ctx, job.ID)
+		if err != nil {
+			return vtrest.CancelTranscodes500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: fmt.Sprintf("failed to cancel job: %v", err),
+			}, nil
+		}
+		// A job that finished after it was selected is left as it is
+		if cancelled.State == rivertype.JobStateCompleted || cancelled.State == rivertype.JobStateDiscarded {
+			result.Skipped = append(result.Skipped, vtrest.BulkTranscodeSkipped{Uuid: jobUUID(cancelled), Code: "JOB_FINISHED", Message: "The job has already finished"})
+			continue
+		}
+		if err := result.add(cancelled); err != nil {
+			return vtrest.CancelTranscodes500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: err.Error(),
+			}, nil
+		}
+	}
+	return vtrest.CancelTranscodes200JSONResponse(*result), nil
+}
+
+// RetryTranscodes handles POST /transcodes/retry requests.
+func (s *Server) RetryTranscodes(ctx context.Context, request vtrest.RetryTranscodesRequestObject) (vtrest.RetryTranscodesResponseObject, error) {
+	selection, problem, err := s.selectBulkJobs(ctx, request.Body, failedStates, vtrest.BulkTranscodeSkipped{Code: "JOB_NOT_FAILED", Message: "The job hasn't failed"})
+	if err != nil {
+		return vtrest.RetryTranscodes500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	} else if problem != nil {
+		return vtrest.RetryTranscodes400ApplicationProblemPlusJSONResponse(*problem), nil
+	}
+
+	if quota, err := s.checkQuota(ctx, len(selection.jobs)); err != nil {
+		return vtrest.RetryTranscodes500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	} else if quota != nil {
+		return vtrest.RetryTranscodes429ApplicationProblemPlusJSONResponse(*quota), nil
+	}
+
+	result := selection.result()
+	for _, job := range selection.jobs {
+		if err := internal.ClearSoftCancel(ctx, s.pool, job.ID); err != nil {
+			return vtrest.RetryTranscodes500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: err.Error(),
+			}, nil
+		}
+		retried, err := s.riverClient.JobRetry(ctx, job.ID)
+		if err != nil {
+			return vtrest.RetryTranscodes500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: fmt.Sprintf("failed to retry job: %v", err),
+			}, nil
+		}
+		if err := result.add(retried); err != nil {
+			return vtrest.RetryTranscodes500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: err.Error(),
+			}, nil
+		}
+	}
+	return vtrest.RetryTranscodes200JSONResponse(*result), nil
+}
+
+// selectBulkJobs returns the jobs body selects, from the primary so they can be acted
+// on.  Jobs given by UUID that aren't in one of states are skipped as skip describes;
+// a filter only matches jobs in one of states, and no more than maxStatusUUIDs of them.
+func (s *Server) selectBulkJobs(ctx context.Context, body *vtrest.BulkTranscodeRequest, states []rivertype.JobState, skip vtrest.BulkTranscodeSkipped) (*bulkSelection, *vtrest.Error, error) {
+	params, problem := bulkListParams(body, states)
+	if problem != nil {
+		return nil, problem, nil
+	} else if params == nil {
+		return &bulkSelection{}, nil, nil
+	}
+
+	jobs, problem, err := listBulkJobs(params, func(params *river.JobListParams) (*river.JobListResult, error) {
+		return s.riverClient.JobList(ctx, scopeToTenant(ctx, params))
+	})
+	if err != nil || problem != nil {
+		return nil, problem, err
+	}
+	if len(body.Uuids) == 0 {
+		return &bulkSelection{jobs: jobs}, nil, nil
+	}
+	return selectByUUID(body.Uuids, jobs, states, skip), nil, nil
+}
+
+// bulkListParams returns the parameters listing the jobs body selects, or nil if its
+// filter can't match any job in one of states.
+func bulkListParams(body *vtrest.BulkTranscodeRequest, states []rivertype.JobState) (*river.JobListParams, *vtrest.Error) {
+	if body == nil {
+		return nil, &vtrest.Error{Code: "INVALID_REQUEST", Message: "Request body is required"}
+	}
+	filtered := body.Status != nil || len(body.Labels) > 0
+	switch {
+	case len(body.Uuids) > 0 && filtered:
+		return nil, &vtrest.Error{Code: "INVALID_REQUEST", Message: "Select jobs either by uuids or by status and labels, not both"}
+	case len(body.Uuids) == 0 && !filtered:
+		return nil, &vtrest.Error{Code: "INVALID_REQUEST", Message: "At least one UUID, or a status or label filter, is required"}
+	case len(body.Uuids) > maxStatusUUIDs:
+		return nil, &vtrest.Error{Code: "INVALID_REQUEST", Message: fmt.Sprintf("At most %d UUIDs may be given at once", maxStatusUUIDs)}
+	}
+
+	params := river.NewJobListParams().
+		Kinds(internal.TranscodeJobArgs{}.Kind()).
+		OrderBy(river.JobListOrderByID, river.SortOrderAsc).
+		First(maxListLimit)
+	if len(body.Uuids) > 0 {
+		ids := make([]string, len(body.Uuids))
+		for i, id := range body.Uuids {
+			ids[i] = id.String()
+		}
+		return params.
+			States(rivertype.JobStates()...).
+			Where("args->>'uuid' = any(@uuids::text[])", river.NamedArgs{"uuids": ids}), nil
+	}
+
+	if body.Status != nil {
+		statusStates := riverStatesForTranscodeStatus(*body.Status)
+		if statusStates == nil {
+			return nil, &vtrest.Error{Code: "INVALID_STATUS", Message: fmt.Sprintf("Invalid status: %q", *body.Status)}
+		}
+		states = slices.DeleteFunc(slices.Clone(states), func(state rivertype.JobState) bool {
+			return !slices.Contains(statusStates, state)
+		})
+		if len(states) == 0 {
+			return nil, nil
+		}
+	}
+	params = params.States(states...)
+	if len(body.Labels) > 0 {
+		return whereLabels(params, body.Labels)
+	}
+	return params, nil
+}
+
+// listBulkJobs pages through the jobs params matches with list.  A filter may match
+// more jobs than fit in a page, but not more than maxStatusUUIDs, so one request
+// can't act on every job the server has kept.
+func listBulkJobs(params *river.JobListParams, list func(*river.JobListParams) (*river.JobListResult, error)) ([]*rivertype.JobRow, *vtrest.Error, error) {
+	var jobs []*rivertype.JobRow
+	for {
+		result, err := list(params)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list river jobs: %w", err)
+		}
+		jobs = append(jobs, result.Jobs...)
+		if len(jobs) > maxStatusUUIDs {
+			return nil, &vtrest.Error{
+				Code:    "TOO_MANY_JOBS",
+				Message: fmt.Sprintf("More than %d jobs match; narrow the filter or select the jobs by UUID", maxStatusUUIDs),
+			}, nil
+		}
+		if len(result.Jobs) < maxListLimit || result.LastCursor == nil {
+			return jobs, nil, nil
+		}
+		params = params.After(result.LastCursor)
+	}
+}
+
+// selectByUUID sorts the jobs found for uuids into those in one of states, those
+// skipped as skip describes, and the UUIDs with no job.  Each is answered in request
+// order, once per UUID even if it was given more than once.
+func selectByUUID(uuids []uuid.UUID, jobs []*rivertype.JobRow, states []rivertype.JobState, skip vtrest.BulkTranscodeSkipped) *bulkSelection {
+	found := make(map[uuid.UUID]*rivertype.JobRow, len(jobs))
+	for _, job := range jobs {
+		found[jobUUID(job)] = job
+	}
+	selection := &bulkSelection{}
+	seen := make(map[uuid.UUID]bool, len(uuids))
+	for _, id := range uuids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		job, ok := found[id]
+		switch {
+		case !ok:
+			selection.notFound = append(selection.notFound, id)
+		case !slices.Contains(states, job.State):
+			skipped := skip
+			skipped.Uuid = id
+			selection.skipped = append(selection.skipped, skipped)
+		default:
+			selection.jobs = append(selection.jobs, job)
+		}
+	}
+	return selection
+}
+
+// result returns the response to a bulk request that starts with the selection's
+// skipped and missing jobs.
+func (b *bulkSelection) result() *bulkResult {
+	return &bulkResult{
+		Jobs:     make([]vtrest.TranscodeJob, 0, len(b.jobs)),
+		Skipped:  append([]vtrest.BulkTranscodeSkipped{}, b.skipped...),
+		NotFound: append([]uuid.UUID{}, b.notFound...),
+	}
+}
+
+// bulkResult collects the response to a bulk request.
+type bulkResult vtrest.BulkTranscodeResult
+
+// add records that the bulk request acted on job.
+func (r *bulkResult) add(job *rivertype.JobRow) error {
+	transcodeJob, err := transcodeJobFromRiver(job)
+	if err != nil {
+		return err
+	}
+	r.Jobs = append(r.Jobs, *transcodeJob)
+	return nil
+}
+
+// jobUUID returns the UUID in a transcode job's args, or the zero UUID if they can't
+// be decoded.
+func jobUUID(job *rivertype.JobRow) uuid.UUID {
+	var args internal.TranscodeJobArgs
+	_ = json.Unmarshal(job.EncodedArgs, &args)
+	return args.UUID
+}
@@ -0,0 +1,125 @@
+package vtserver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// bulkTestJob returns a transcode job row for id in state.
+func bulkTestJob(jobID int64, id uuid.UUID, state rivertype.JobState) *rivertype.JobRow {
+	args, _ := json.Marshal(internal.TranscodeJobArgs{UUID: id})
+	return &rivertype.JobRow{ID: jobID, EncodedArgs: args, State: state}
+}
+
+func TestBulkListParams(t *testing.T) {
+	failed := vtrest.Failed
+	running := vtrest.Running
+	invalid := vtrest.TranscodeStatus("bogus")
+	tooMany := make([]uuid.UUID, maxStatusUUIDs+1)
+
+	tests := []struct {
+		loc         exam.Loc
+		name        string
+		body        *vtrest.BulkTranscodeRequest
+		wantCode    string
+		wantNoMatch bool
+	}{
+		{loc: exam.Here(), name: "no body", wantCode: "INVALID_REQUEST"},
+		{loc: exam.Here(), name: "empty selection", body: &vtrest.BulkTranscodeRequest{}, wantCode: "INVALID_REQUEST"},
+		{
+			loc:      exam.Here(),
+			name:     "uuids and filter",
+			body:     &vtrest.BulkTranscodeRequest{Uuids: []uuid.UUID{uuid.New()}, Status: &failed},
+			wantCode: "INVALID_REQUEST",
+		},
+		{loc: exam.Here(), name: "too many uuids", body: &vtrest.BulkTranscodeRequest{Uuids: tooMany}, wantCode: "INVALID_REQUEST"},
+		{loc: exam.Here(), name: "invalid status", body: &vtrest.BulkTranscodeRequest{Status: &invalid}, wantCode: "INVALID_STATUS"},
+		{loc: exam.Here(), name: "uuids", body: &vtrest.BulkTranscodeRequest{Uuids: []uuid.UUID{uuid.New()}}},
+		{loc: exam.Here(), name: "status", body: &vtrest.BulkTranscodeRequest{Status: &failed}},
+		{loc: exam.Here(), name: "labels", body: &vtrest.BulkTranscodeRequest{Labels: map[string]string{"show": "Firefly"}}},
+		{loc: exam.Here(), name: "status outside states", body: &vtrest.BulkTranscodeRequest{Status: &running}, wantNoMatch: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			e.Log("Running test at", tt.loc)
+			env := deep.NewEnv()
+			params, problem := bulkListParams(tt.body, failedStates)
+			var gotCode string
+			if problem != nil {
+				gotCode = problem.Code
+			}
+			exam.Equal(e, env, tt.wantCode, gotCode)
+			exam.Equal(e, env, tt.wantCode == "" && !tt.wantNoMatch, params != nil)
+		})
+	}
+}
+
+func TestListBulkJobs(t *testing.T) {
+	// pages lists jobs maxListLimit at a time, as River would
+	pages := func(total int) func(*river.JobListParams) (*river.JobListResult, error) {
+		listed := 0
+		return func(*river.JobListParams) (*river.JobListResult, error) {
+			result := &river.JobListResult{}
+			for ; listed < total && len(result.Jobs) < maxListLimit; listed++ {
+				result.Jobs = append(result.Jobs, bulkTestJob(int64(listed+1), uuid.New(), rivertype.JobStateDiscarded))
+			}
+			if len(result.Jobs) > 0 {
+				result.LastCursor = river.JobListCursorFromJob(result.Jobs[len(result.Jobs)-1])
+			}
+			return result, nil
+		}
+	}
+
+	t.Run("within the cap", func(t *testing.T) {
+		e := exam.New(t)
+		env := deep.NewEnv()
+		jobs, problem, err := listBulkJobs(river.NewJobListParams(), pages(maxStatusUUIDs))
+		exam.Nil(e, env, err).Log(err).Must()
+		exam.Nil(e, env, problem).Must()
+		exam.Equal(e, env, maxStatusUUIDs, len(jobs))
+	})
+
+	t.Run("over the cap", func(t *testing.T) {
+		e := exam.New(t)
+		env := deep.NewEnv()
+		jobs, problem, err := listBulkJobs(river.NewJobListParams(), pages(maxStatusUUIDs+maxListLimit))
+		exam.Nil(e, env, err).Log(err).Must()
+		exam.Equal(e, env, 0, len(jobs))
+		exam.NotNil(e, env, problem).Must()
+		exam.Equal(e, env, "TOO_MANY_JOBS", problem.Code)
+	})
+}
+
+func TestSelectByUUID(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	failedID, finishedID, missingID, otherMissingID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	jobs := []*rivertype.JobRow{
+		bulkTestJob(1, finishedID, rivertype.JobStateCompleted),
+		bulkTestJob(2, failedID, rivertype.JobStateDiscarded),
+	}
+	skip := vtrest.BulkTranscodeSkipped{Code: "JOB_NOT_FAILED", Message: "The job hasn't failed"}
+
+	// Duplicates are answered once, and each list follows the request order
+	selection := selectByUUID([]uuid.UUID{missingID, finishedID, failedID, otherMissingID, failedID, missingID}, jobs, failedStates, skip)
+	exam.Equal(e, env, 1, len(selection.jobs))
+	exam.Equal(e, env, int64(2), selection.jobs[0].ID)
+	exam.Equal(e, env, 1, len(selection.skipped))
+	exam.Equal(e, env, finishedID.String(), selection.skipped[0].Uuid.String())
+	exam.Equal(e, env, skip.Code, selection.skipped[0].Code)
+	var notFound []string
+	for _, id := range selection.notFound {
+		notFound = append(notFound, id.String())
+	}
+	exam.Equal(e, env, []string{missingID.String(), otherMissingID.String()}, notFound)
+}
@@ -0,0 +1,76 @@
+package vtserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// CancelTranscode handles POST /transcodes/{uuid}/cancel requests.
+func (s *Server) CancelTranscode(ctx context.Context, request vtrest.CancelTranscodeRequestObject) (vtrest.CancelTranscodeResponseObject, error) {
+	mode := vtrest.Hard
+	if request.Params.Mode != nil {
+		mode = *request.Params.Mode
+	}
+	if mode != vtrest.Hard && mode != vtrest.Soft {
+		return vtrest.CancelTranscode400ApplicationProblemPlusJSONResponse{
+			Code:    "INVALID_MODE",
+			Message: fmt.Sprintf("mode must be %q or %q", vtrest.Hard, vtrest.Soft),
+		}, nil
+	}
+
+	job, err := s.lookupJob(ctx, request.Uuid)
+	if errors.Is(err, errJobNotFound) {
+		return vtrest.CancelTranscode404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("Transcode job with UUID %s not found", request.Uuid),
+		}, nil
+	} else if err != nil {
+		return vtrest.CancelTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	switch job.State {
+	case rivertype.JobStateCompleted, rivertype.JobStateCancelled, rivertype.JobStateDiscarded:
+		return vtrest.CancelTranscode409ApplicationProblemPlusJSONResponse{
+			Code:    "JOB_FINISHED",
+			Message: fmt.Sprintf("Transcode job with UUID %s has already finished", request.Uuid),
+		}, nil
+	}
+
+	// The worker cancels soft-cancelled jobs itself once their output is finalized
+	requested := false
+	if mode == vtrest.Soft {
+		if requested, err = internal.RequestSoftCancel(ctx, s.pool, job.ID); err != nil {
+			return vtrest.CancelTranscode500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: err.Error(),
+			}, nil
+		}
+	}
+	if requested {
+		job, err = s.lookupJob(ctx, request.Uuid)
+	} else {
+		job, err = s.riverClient.JobCancel(ctx, job.ID)
+	}
+	if err != nil {
+		return vtrest.CancelTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to cancel job: %v", err),
+		}, nil
+	}
+
+	transcodeJob, err := transcodeJobFromRiver(job)
+	if err != nil {
+		return vtrest.CancelTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	return vtrest.CancelTranscode202JSONResponse(*transcodeJob), nil
+}
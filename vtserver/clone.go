@@ -0,0 +1,239 @@
+package vtserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// CloneTranscode handles POST /transcodes/{uuid}/clone requests.  The original's args
+// are turned back into a TranscodeRequest, so the clone goes through the same
+// validation, quota, and insertion as a new submission.
+func (s *Server) CloneTranscode(ctx context.Context, request vtrest.CloneTranscodeRequestObject) (vtrest.CloneTranscodeResponseObject, error) {
+	if request.Body == nil {
+		return vtrest.CloneTranscode400ApplicationProblemPlusJSONResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	job, err := s.lookupJob(ctx, request.Uuid)
+	if errors.Is(err, errJobNotFound) {
+		return vtrest.CloneTranscode404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("Transcode job with UUID %s not found", request.Uuid),
+		}, nil
+	} else if err != nil {
+		return vtrest.CloneTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	var args internal.TranscodeJobArgs
+	if err := json.Unmarshal(job.EncodedArgs, &args); err != nil {
+		return vtrest.CloneTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to unmarshal job args: %v", err),
+		}, nil
+	}
+	// The tokens are sealed again, under the current key, when the clone is inserted
+	if err := args.OpenSecrets(ctx, s.settings.Load().secrets); err != nil {
+		return vtrest.CloneTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+
+	body := transcodeRequestFromArgs(args)
+	body.Uuid = request.Body.Uuid
+	if request.Body.DestinationPath != nil {
+		body.DestinationPath = *request.Body.DestinationPath
+	}
+	if request.Body.Profile != nil {
+		body.Profile = *request.Body.Profile
+	}
+	if request.Body.Labels != nil {
+		labels := maps.Clone(args.Labels)
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		maps.Copy(labels, *request.Body.Labels)
+		body.Labels = (*vtrest.Labels)(&labels)
+	}
+
+	response, err := s.CreateTranscode(ctx, vtrest.CreateTranscodeRequestObject{Body: &body})
+	if err != nil {
+		return nil, err
+	}
+	switch response := response.(type) {
+	case vtrest.CreateTranscode201JSONResponse:
+		return vtrest.CloneTranscode201JSONResponse(response), nil
+	case vtrest.CreateTranscode400ApplicationProblemPlusJSONResponse:
+		return vtrest.CloneTranscode400ApplicationProblemPlusJSONResponse(response), nil
+	case vtrest.CreateTranscode409ApplicationProblemPlusJSONResponse:
+		return vtrest.CloneTranscode409ApplicationProblemPlusJSONResponse(response), nil
+	case vtrest.CreateTranscode429ApplicationProblemPlusJSONResponse:
+		return vtrest.CloneTranscode429ApplicationProblemPlusJSONResponse(response), nil
+	case vtrest.CreateTranscode500ApplicationProblemPlusJSONResponse:
+		return vtrest.CloneTranscode500ApplicationProblemPlusJSONResponse(response), nil
+	default:
+		return nil, fmt.Errorf("unexpected create response %T", response)
+	}
+}
+
+// transcodeRequestFromArgs is the inverse of transcodeJobArgs: it returns the request
+// that creates a job with args, apart from its group and workflow.  Webhook tokens must
+// already have been opened.
+func transcodeRequestFromArgs(args internal.TranscodeJobArgs) vtrest.TranscodeRequest {
+	body := vtrest.TranscodeRequest{
+		Uuid:                     args.UUID,
+		SourcePath:               args.SourcePath,
+		DestinationPath:          args.DestinationPath,
+		Profile:                  string(args.Profile),
+		WebhookUri:               args.WebhookURI,
+		WebhookToken:             args.WebhookToken,
+		HeartbeatWebhookUri:      args.HeartbeatWebhookURI,
+		HeartbeatIntervalSeconds: args.HeartbeatIntervalSeconds,
+		ParallelSegments:         args.ParallelSegments,
+	}
+	if args.WebhookTokenHeader != "" {
+		body.WebhookTokenHeader = &args.WebhookTokenHeader
+	}
+	if args.ReuseCompleted {
+		body.ReuseCompleted = &args.ReuseCompleted
+	}
+	if args.SkipIfValid {
+		body.SkipIfValid = &args.SkipIfValid
+	}
+	if args.Compare != nil {
+		body.Compare = &vtrest.CompareOptions{Vmaf: &args.Compare.VMAF}
+	}
+	if len(args.Labels) > 0 {
+		labels := maps.Clone(args.Labels)
+		body.Labels = (*vtrest.Labels)(&labels)
+	}
+	if output := args.Output; output != nil {
+		body.Output = &vtrest.OutputOwnership{Uid: output.UID, Gid: output.GID}
+		if output.Mode != nil {
+			mode := fmt.Sprintf("%04o", uint32(*output.Mode))
+			body.Output.Mode = &mode
+		}
+	}
+	if audio := args.Audio; audio != nil {
+		body.Audio = &vtrest.AudioOptions{
+			Codec:       vtrest.AudioOptionsCodec(audio.Codec),
+			BitrateKbps: audio.BitrateKbps,
+		}
+		if audio.Layout != "" {
+			layout := vtrest.AudioOptionsLayout(audio.Layout)
+			body.Audio.Layout = &layout
+		}
+		if audio.Downmix != "" {
+			downmix := vtrest.AudioOptionsDownmix(audio.Downmix)
+			body.Audio.Downmix = &downmix
+		}
+		if audio.StereoTrack {
+			body.Audio.StereoTrack = &audio.StereoTrack
+		}
+	}
+	if subtitles := args.Subtitles; subtitles != nil {
+		body.Subtitles = &vtrest.SubtitleOptions{}
+		if subtitles.BurnForced {
+			body.Subtitles.BurnForced = &subtitles.BurnForced
+		}
+		if subtitles.Captions != "" {
+			captions := vtrest.SubtitleOptionsCaptions(subtitles.Captions)
+			body.Subtitles.Captions = &captions
+		}
+		for _, sub := range subtitles.External {
+			external := vtrest.ExternalSubtitle{Path: sub.Path}
+			if sub.Language != "" {
+				external.Language = &sub.Language
+			}
+			body.Subtitles.External = append(body.Subtitles.External, external)
+		}
+	}
+	if video := args.Video; video != nil {
+		body.Video = &vtrest.VideoOptions{}
+		if video.Detelecine {
+			body.Video.Detelecine = &video.Detelecine
+		}
+		if video.Denoise != "" {
+			denoise := vtrest.VideoOptionsDenoise(video.Denoise)
+			body.Video.Denoise = &denoise
+		}
+		if video.DenoiseLevel != "" {
+			level := vtrest.VideoOptionsDenoiseLevel(video.DenoiseLevel)
+			body.Video.DenoiseLevel = &level
+		}
+		if video.GrainTune {
+			body.Video.GrainTune = &video.GrainTune
+		}
+		if perTitle := video.PerTitle; perTitle != nil {
+			body.Video.PerTitle = &vtrest.PerTitleOptions{MinCrf: &perTitle.MinCRF, MaxCrf: &perTitle.MaxCRF}
+		}
+	}
+	if streams := args.Streams; streams != nil {
+		body.Streams = &vtrest.StreamSelection{}
+		if streams.Audio != nil {
+			body.Streams.Audio = &streams.Audio
+		}
+		if streams.Subtitles != nil {
+			body.Streams.Subtitles = &streams.Subtitles
+		}
+		if streams.Video != 0 {
+			body.Streams.Video = &streams.Video
+		}
+	}
+	if animation := args.Animation; animation != nil {
+		body.Animation = &vtrest.AnimationOptions{
+			StartSeconds:    animation.StartSeconds,
+			DurationSeconds: animation.DurationSeconds,
+			Width:           animation.Width,
+		}
+	}
+	if handbrake := args.HandBrake; handbrake != nil {
+		body.Handbrake = &vtrest.HandBrakeOptions{Quality: handbrake.Quality}
+		if handbrake.EncoderPreset != "" {
+			body.Handbrake.EncoderPreset = &handbrake.EncoderPreset
+		}
+		if handbrake.EncoderTune != "" {
+			body.Handbrake.EncoderTune = &handbrake.EncoderTune
+		}
+		if handbrake.AudioEncoder != "" {
+			body.Handbrake.AudioEncoder = &handbrake.AudioEncoder
+		}
+		if handbrake.AllSubtitles {
+			body.Handbrake.AllSubtitles = &handbrake.AllSubtitles
+		}
+	}
+	if chapters := args.Chapters; chapters != nil {
+		body.Chapters = &vtrest.ChapterOptions{MinLengthSeconds: chapters.MinLengthSeconds}
+		if chapters.Generate {
+			body.Chapters.Generate = &chapters.Generate
+		}
+	}
+	for _, rendition := range args.Renditions {
+		body.Renditions = append(body.Renditions, vtrest.Rendition{
+			Name:             rendition.Name,
+			Height:           rendition.Height,
+			VideoBitrateKbps: rendition.VideoBitrateKbps,
+		})
+	}
+	for _, webhook := range args.Webhooks {
+		target := vtrest.WebhookTarget{Uri: webhook.URI, Token: webhook.Token}
+		if webhook.TokenHeader != "" {
+			target.TokenHeader = &webhook.TokenHeader
+		}
+		for _, event := range webhook.Events {
+			target.Events = append(target.Events, vtrest.WebhookEvent(event))
+		}
+		body.Webhooks = append(body.Webhooks, target)
+	}
+	return body
+}
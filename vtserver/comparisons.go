@@ -0,0 +1,162 @@
+package vtserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// CreateComparison handles POST /comparisons requests.
+func (s *Server) CreateComparison(ctx context.Context, request vtrest.CreateComparisonRequestObject) (vtrest.CreateComparisonResponseObject, error) {
+	if request.Body == nil {
+		return vtrest.CreateComparison400ApplicationProblemPlusJSONResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	var problems []vtrest.FieldError
+	for _, path := range []struct{ field, path string }{{"/sourcePath", request.Body.SourcePath}, {"/outputPath", request.Body.OutputPath}} {
+		if problem := validatePath(path.field, path.path); problem != nil {
+			problems = append(problems, *problem)
+		} else if !s.pathAllowed(ctx, path.path) {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("%s", path.field),
+				Code:    "PATH_NOT_ALLOWED",
+				Message: fmt.Sprintf("Path %q is not inside an allowed directory", path.path),
+			})
+		}
+	}
+	if len(problems) > 0 {
+		return vtrest.CreateComparison400ApplicationProblemPlusJSONResponse(validationProblem(problems)), nil
+	}
+
+	args := internal.CompareJobArgs{
+		UUID:       uuid.UUID(request.Body.Uuid),
+		SourcePath: request.Body.SourcePath,
+		OutputPath: request.Body.OutputPath,
+		Tenant:     tenantName(ctx),
+	}
+	if request.Body.Vmaf != nil {
+		args.VMAF = *request.Body.Vmaf
+	}
+	metadata, err := json.Marshal(internal.JobMetadata{RequestID: internal.RequestIDFromContext(ctx)})
+	if err != nil {
+		return vtrest.CreateComparison500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to marshal job metadata: %v", err),
+		}, nil
+	}
+
+	// The UUID is a unique job arg, so duplicates are skipped atomically
+	inserted, err := s.riverClient.Insert(ctx, args, &river.InsertOpts{Metadata: metadata})
+	if err != nil {
+		return vtrest.CreateComparison500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to insert river job: %v", err),
+		}, nil
+	}
+	if inserted.UniqueSkippedAsDuplicate {
+		return vtrest.CreateComparison409ApplicationProblemPlusJSONResponse{
+			Code:    "DUPLICATE_UUID",
+			Message: fmt.Sprintf("A comparison with UUID %s already exists", args.UUID),
+		}, nil
+	}
+
+	now := time.Now()
+	return vtrest.CreateComparison201JSONResponse{
+		Uuid:       request.Body.Uuid,
+		Status:     vtrest.Pending,
+		SourcePath: args.SourcePath,
+		OutputPath: args.OutputPath,
+		Vmaf:       args.VMAF,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// GetComparison handles GET /comparisons/{uuid} requests.
+func (s *Server) GetComparison(ctx context.Context, request vtrest.GetComparisonRequestObject) (vtrest.GetComparisonResponseObject, error) {
+	params := river.NewJobListParams().
+		Kinds(internal.CompareJobArgs{}.Kind()).
+		States(rivertype.JobStates()...).
+		Where("args->>'uuid' = @uuid", river.NamedArgs{"uuid": request.Uuid.String()}).
+		First(1)
+	result, err := s.readClient.JobList(ctx, scopeToTenant(ctx, params))
+	if err == nil && len(result.Jobs) == 0 && s.readClient != s.riverClient {
+		// Clients can poll a comparison as soon as they have submitted it
+		result, err = s.riverClient.JobList(ctx, scopeToTenant(ctx, params))
+	}
+	if err != nil {
+		return vtrest.GetComparison500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to look up river job: %v", err),
+		}, nil
+	}
+	if len(result.Jobs) == 0 {
+		return vtrest.GetComparison404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("Comparison with UUID %s not found", request.Uuid),
+		}, nil
+	}
+
+	comparison, err := comparisonFromRiver(result.Jobs[0])
+	if err != nil {
+		return vtrest.GetComparison500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	return vtrest.GetComparison200JSONResponse(*comparison), nil
+}
+
+// comparisonFromRiver builds the API representation of a compare job.  Like a workflow
+// step, a comparison that failed without recording why reports River's last error.
+func comparisonFromRiver(job *rivertype.JobRow) (*vtrest.Comparison, error) {
+	var args internal.CompareJobArgs
+	if err := json.Unmarshal(job.EncodedArgs, &args); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal comparison args: %w", err)
+	}
+	comparison := &vtrest.Comparison{
+		Uuid:       args.UUID,
+		Status:     mapRiverStateToTranscodeStatus(job.State),
+		SourcePath: args.SourcePath,
+		OutputPath: args.OutputPath,
+		Vmaf:       args.VMAF,
+		CreatedAt:  job.CreatedAt,
+		UpdatedAt:  job.CreatedAt,
+	}
+	if job.FinalizedAt != nil {
+		comparison.UpdatedAt = *job.FinalizedAt
+	} else if job.AttemptedAt != nil {
+		comparison.UpdatedAt = *job.AttemptedAt
+	}
+
+	if output := job.Output(); len(output) > 0 {
+		var report internal.ComparisonReport
+		if err := json.Unmarshal(output, &report); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal comparison output: %w", err)
+		}
+		comparison.Error = report.Error
+		comparison.ErrorCode = (*vtrest.ErrorCode)(report.ErrorCode)
+		// The recorded report has the same shape as the API's
+		if report.Source != nil && report.Output != nil {
+			comparison.Report = &vtrest.ComparisonReport{}
+			if err := json.Unmarshal(output, comparison.Report); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal comparison report: %w", err)
+			}
+		}
+	}
+	if comparison.Error == nil && comparison.Status == vtrest.Failed && len(job.Errors) > 0 {
+		lastError := job.Errors[len(job.Errors)-1].Error
+		comparison.Error = &lastError
+	}
+	return comparison, nil
+}
@@ -0,0 +1,329 @@
+package vtserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// maxDirectoryJobs caps the number of jobs a single directory request may create.
+const maxDirectoryJobs = 1000
+
+// maxDirectoryPatterns caps the number of include and exclude patterns.
+const maxDirectoryPatterns = 32
+
+// outputExtensionPattern matches the outputExtension of a directory request.
+var outputExtensionPattern = regexp.MustCompile(`^\.[A-Za-z0-9]{1,8}$`)
+
+// errTooManyFiles stops the walk of a directory with more matching files than maxDirectoryJobs.
+var errTooManyFiles = errors.New("too many matching files")
+
+// CreateDirectoryTranscode handles POST /transcodes/directory requests.
+func (s *Server) CreateDirectoryTranscode(ctx context.Context, request vtrest.CreateDirectoryTranscodeRequestObject) (vtrest.CreateDirectoryTranscodeResponseObject, error) {
+	body := request.Body
+	if body == nil {
+		return vtrest.CreateDirectoryTranscode400ApplicationProblemPlusJSONResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "Request body is required",
+		}, nil
+	}
+	if problems := s.validateDirectoryRequest(ctx, body); len(problems) > 0 {
+		return vtrest.CreateDirectoryTranscode400ApplicationProblemPlusJSONResponse(validationProblem(problems)), nil
+	}
+
+	requests, err := directoryTranscodeRequests(body)
+	if errors.Is(err, errTooManyFiles) {
+		return vtrest.CreateDirectoryTranscode400ApplicationProblemPlusJSONResponse{
+			Code:    "TOO_MANY_FILES",
+			Message: fmt.Sprintf("More than %d files in %q match; split the request into subdirectories", maxDirectoryJobs, body.SourceDirectory),
+		}, nil
+	} else if err != nil {
+		return vtrest.CreateDirectoryTranscode400ApplicationProblemPlusJSONResponse{
+			Code:    "SOURCE_NOT_FOUND",
+			Message: fmt.Sprintf("Source directory %q could not be read from the server: %v", body.SourceDirectory, err),
+		}, nil
+	}
+
+	// Each job must pass the same checks as if it had been submitted on its own
+	for _, req := range requests {
+		if problems := s.validateTranscodeRequest(ctx, &req); len(problems) > 0 {
+			for i := range problems {
+				problems[i].Message = fmt.Sprintf("%s: %s", req.SourcePath, problems[i].Message)
+			}
+			return vtrest.CreateDirectoryTranscode400ApplicationProblemPlusJSONResponse(validationProblem(problems)), nil
+		}
+	}
+
+	// Only files without a job yet count towards the tenant's quota
+	if tenant := tenantFromContext(ctx); tenant != nil && tenant.MaxActiveJobs > 0 {
+		newJobs, err := s.countNewJobs(ctx, requests)
+		var quota *vtrest.Error
+		if err == nil {
+			quota, err = s.checkQuota(ctx, newJobs)
+		}
+		if err != nil {
+			return vtrest.CreateDirectoryTranscode500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: err.Error(),
+			}, nil
+		} else if quota != nil {
+			return vtrest.CreateDirectoryTranscode429ApplicationProblemPlusJSONResponse(*quota), nil
+		}
+	}
+
+	metadata, err := json.Marshal(internal.JobMetadata{RequestID: internal.RequestIDFromContext(ctx)})
+	if err != nil {
+		return vtrest.CreateDirectoryTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to marshal job metadata: %v", err),
+		}, nil
+	}
+	response := vtrest.CreateDirectoryTranscode201JSONResponse{
+		Uuid:     body.Uuid,
+		GroupId:  directoryGroupID(body),
+		Created:  []vtrest.DirectoryTranscodeJob{},
+		Existing: []vtrest.DirectoryTranscodeJob{},
+	}
+	if len(requests) == 0 {
+		return response, nil
+	}
+
+	secrets := s.settings.Load().secrets
+	params := make([]river.InsertManyParams, len(requests))
+	for i, req := range requests {
+		args := transcodeJobArgs(ctx, &req)
+		if err := args.SealSecrets(ctx, secrets); err != nil {
+			return vtrest.CreateDirectoryTranscode500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: err.Error(),
+			}, nil
+		}
+		params[i] = river.InsertManyParams{
+			Args:       args,
+			InsertOpts: &river.InsertOpts{Metadata: metadata},
+		}
+	}
+	// Job UUIDs are unique args, so files submitted by an earlier request are skipped
+	results, err := s.riverClient.InsertMany(ctx, params)
+	if err != nil {
+		return vtrest.CreateDirectoryTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to insert river jobs: %v", err),
+		}, nil
+	}
+
+	now := time.Now()
+	for i, result := range results {
+		req := requests[i]
+		job := vtrest.DirectoryTranscodeJob{
+			Uuid:            req.Uuid,
+			SourcePath:      req.SourcePath,
+			DestinationPath: req.DestinationPath,
+		}
+		if result.UniqueSkippedAsDuplicate {
+			response.Existing = append(response.Existing, job)
+			continue
+		}
+		response.Created = append(response.Created, job)
+
+		event := internal.JobEvent{
+			Type:       internal.JobEventCreated,
+			UUID:       req.Uuid,
+			OccurredAt: now,
+			RequestID:  internal.RequestIDFromContext(ctx),
+			Labels:     params[i].Args.(internal.TranscodeJobArgs).Labels,
+		}
+		if err := s.events.Publish(ctx, event); err != nil {
+			// The job exists either way; don't fail the request over a missed event
+			slog.Warn("failed to publish created event", "uuid", req.Uuid, "error", err)
+		}
+	}
+	return response, nil
+}
+
+// countNewJobs returns how many of requests don't have a job yet.
+func (s *Server) countNewJobs(ctx context.Context, requests []vtrest.TranscodeRequest) (int, error) {
+	ids := make([]string, len(requests))
+	for i, req := range requests {
+		ids[i] = req.Uuid.String()
+	}
+	params := river.NewJobListParams().
+		Kinds(internal.TranscodeJobArgs{}.Kind()).
+		States(rivertype.JobStates()...).
+		Where("args->>'uuid' = any(@uuids::text[])", river.NamedArgs{"uuids": ids}).
+		First(maxDirectoryJobs)
+	result, err := s.riverClient.JobList(ctx, params)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list river jobs: %w", err)
+	}
+	return len(requests) - len(result.Jobs), nil
+}
+
+// validateDirectoryRequest runs the checks on a directory request that apply to the
+// request as a whole, before it is expanded into jobs.
+func (s *Server) validateDirectoryRequest(ctx context.Context, body *vtrest.DirectoryTranscodeRequest) []vtrest.FieldError {
+	var problems []vtrest.FieldError
+
+	if profile := internal.Profile(body.Profile); !profile.IsValid() {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/profile"),
+			Code:    "INVALID_PROFILE",
+			Message: fmt.Sprintf("Invalid profile: %q", body.Profile),
+		})
+	}
+
+	for _, dir := range []struct{ field, path string }{{"/sourceDirectory", body.SourceDirectory}, {"/destinationDirectory", body.DestinationDirectory}} {
+		if problem := validatePath(dir.field, dir.path); problem != nil {
+			problems = append(problems, *problem)
+		} else if !s.pathAllowed(ctx, dir.path) {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("%s", dir.field),
+				Code:    "PATH_NOT_ALLOWED",
+				Message: fmt.Sprintf("Path %q is not inside an allowed directory", dir.path),
+			})
+		}
+	}
+
+	for _, list := range []struct {
+		field    string
+		patterns []string
+	}{{"/include", body.Include}, {"/exclude", body.Exclude}} {
+		if len(list.patterns) > maxDirectoryPatterns {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("%s", list.field),
+				Code:    "INVALID_PATTERN",
+				Message: fmt.Sprintf("At most %d patterns are allowed", maxDirectoryPatterns),
+			})
+			continue
+		}
+		for i, pattern := range list.patterns {
+			if _, err := filepath.Match(pattern, ""); err != nil || pattern == "" {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("%s/%d", list.field, i),
+					Code:    "INVALID_PATTERN",
+					Message: fmt.Sprintf("Invalid glob pattern: %q", pattern),
+				})
+			}
+		}
+	}
+
+	if body.OutputExtension != nil && !outputExtensionPattern.MatchString(*body.OutputExtension) {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/outputExtension"),
+			Code:    "INVALID_OUTPUT_EXTENSION",
+			Message: fmt.Sprintf("outputExtension %q must be a '.' followed by 1 to 8 letters or digits", *body.OutputExtension),
+		})
+	}
+
+	if body.GroupId != nil && !groupIDPattern.MatchString(*body.GroupId) {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/groupId"),
+			Code:    "INVALID_GROUP_ID",
+			Message: fmt.Sprintf("groupId %q must be 1 to 64 letters, digits, '.', '_' or '-'", *body.GroupId),
+		})
+	}
+
+	return problems
+}
+
+// directoryTranscodeRequests walks the source directory of a validated directory
+// request and returns a transcode request for each matching file, in lexical order.
+func directoryTranscodeRequests(body *vtrest.DirectoryTranscodeRequest) ([]vtrest.TranscodeRequest, error) {
+	if info, err := os.Stat(body.SourceDirectory); err != nil {
+		return nil, err
+	} else if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", body.SourceDirectory)
+	}
+	groupID := directoryGroupID(body)
+	destinationDir := filepath.Clean(body.DestinationDirectory)
+
+	var requests []vtrest.TranscodeRequest
+	err := filepath.WalkDir(body.SourceDirectory, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			// Don't pick up the outputs of an earlier request
+			if path != body.SourceDirectory && filepath.Clean(path) == destinationDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(body.SourceDirectory, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if !matchesAny(body.Include, rel, true) || matchesAny(body.Exclude, rel, false) {
+			return nil
+		}
+		if len(requests) == maxDirectoryJobs {
+			return errTooManyFiles
+		}
+
+		destination := rel
+		if body.OutputExtension != nil {
+			destination = strings.TrimSuffix(rel, filepath.Ext(rel)) + *body.OutputExtension
+		}
+		requests = append(requests, vtrest.TranscodeRequest{
+			Uuid:            uuid.NewSHA1(body.Uuid, []byte(rel)),
+			SourcePath:      path,
+			DestinationPath: filepath.Join(destinationDir, filepath.FromSlash(destination)),
+			Profile:         body.Profile,
+			Audio:           body.Audio,
+			Subtitles:       body.Subtitles,
+			Video:           body.Video,
+			Labels:          body.Labels,
+			GroupId:         &groupID,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// directoryGroupID returns the group the jobs of a directory request are added to.
+func directoryGroupID(body *vtrest.DirectoryTranscodeRequest) string {
+	if body.GroupId != nil {
+		return *body.GroupId
+	}
+	return body.Uuid.String()
+}
+
+// matchesAny reports whether the slash-separated relative path rel matches one of
+// patterns.  Patterns containing / are matched against rel, and others against its
+// last element.  ifEmpty is the result when there are no patterns.
+func matchesAny(patterns []string, rel string, ifEmpty bool) bool {
+	if len(patterns) == 0 {
+		return ifEmpty
+	}
+	for _, pattern := range patterns {
+		name := rel
+		if !strings.Contains(pattern, "/") {
+			name = rel[strings.LastIndex(rel, "/")+1:]
+		}
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+package vtserver
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// writeTree creates an empty file at each of the slash-separated paths under dir.
+func writeTree(e exam.E, env deep.Env, dir string, paths ...string) {
+	for _, path := range paths {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+		exam.Nil(e, env, os.MkdirAll(filepath.Dir(path), 0o755)).Must()
+		exam.Nil(e, env, os.WriteFile(path, nil, 0o644)).Must()
+	}
+}
+
+func TestDirectoryTranscodeRequests(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	source := t.TempDir()
+	writeTree(e, env, source, "b.mkv", "a.mkv", "notes.txt", "sub/c.mkv", "sub/d.avi", "out/a.mp4")
+	mp4 := ".mp4"
+
+	tests := []struct {
+		loc       exam.Loc
+		name      string
+		include   []string
+		exclude   []string
+		extension *string
+		// want is each source and its destination, relative to source and the output directory
+		want []string
+	}{
+		{
+			loc:  exam.Here(),
+			name: "every file but the outputs",
+			want: []string{"a.mkv -> a.mkv", "b.mkv -> b.mkv", "notes.txt -> notes.txt", "sub/c.mkv -> sub/c.mkv", "sub/d.avi -> sub/d.avi"},
+		},
+		{
+			loc:     exam.Here(),
+			name:    "include by name at any depth",
+			include: []string{"*.mkv"},
+			want:    []string{"a.mkv -> a.mkv", "b.mkv -> b.mkv", "sub/c.mkv -> sub/c.mkv"},
+		},
+		{
+			loc:     exam.Here(),
+			name:    "include by path",
+			include: []string{"sub/*"},
+			want:    []string{"sub/c.mkv -> sub/c.mkv", "sub/d.avi -> sub/d.avi"},
+		},
+		{
+			loc:     exam.Here(),
+			name:    "exclude wins over include",
+			include: []string{"*.mkv", "*.avi"},
+			exclude: []string{"sub/*", "b.*"},
+			want:    []string{"a.mkv -> a.mkv"},
+		},
+		{
+			loc:       exam.Here(),
+			name:      "output extension",
+			include:   []string{"*.mkv"},
+			extension: &mp4,
+			want:      []string{"a.mkv -> a.mp4", "b.mkv -> b.mp4", "sub/c.mkv -> sub/c.mp4"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			e.Log("Running test at", tt.loc)
+			env := deep.NewEnv()
+			destination := filepath.Join(source, "out")
+			body := &vtrest.DirectoryTranscodeRequest{
+				Uuid:                 uuid.New(),
+				SourceDirectory:      source,
+				DestinationDirectory: destination,
+				Profile:              "preview",
+				Include:              tt.include,
+				Exclude:              tt.exclude,
+				OutputExtension:      tt.extension,
+			}
+			requests, err := directoryTranscodeRequests(body)
+			exam.Nil(e, env, err).Log(err).Must()
+
+			var got []string
+			for _, req := range requests {
+				src, err := filepath.Rel(source, req.SourcePath)
+				exam.Nil(e, env, err).Must()
+				dst, err := filepath.Rel(destination, req.DestinationPath)
+				exam.Nil(e, env, err).Must()
+				got = append(got, filepath.ToSlash(src)+" -> "+filepath.ToSlash(dst))
+
+				// Job UUIDs depend only on the request and the file, so resubmitting finds the same jobs
+				exam.Equal(e, env, uuid.NewSHA1(body.Uuid, []byte(filepath.ToSlash(src))).String(), req.Uuid.String())
+				exam.Equal(e, env, body.Uuid.String(), *req.GroupId)
+			}
+			exam.Equal(e, env, tt.want, got)
+		})
+	}
+}
+
+func TestDirectoryTranscodeRequestsTooManyFiles(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	source := t.TempDir()
+	var paths []string
+	for i := range maxDirectoryJobs {
+		paths = append(paths, fmt.Sprintf("%04d.mkv", i))
+	}
+	writeTree(e, env, source, paths...)
+	body := &vtrest.DirectoryTranscodeRequest{
+		Uuid:                 uuid.New(),
+		SourceDirectory:      source,
+		DestinationDirectory: t.TempDir(),
+		Profile:              "preview",
+	}
+
+	// Exactly at the cap is fine
+	requests, err := directoryTranscodeRequests(body)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, maxDirectoryJobs, len(requests))
+
+	// Files that don't match don't count
+	writeTree(e, env, source, "extra.txt")
+	body.Include = []string{"*.mkv"}
+	requests, err = directoryTranscodeRequests(body)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, maxDirectoryJobs, len(requests))
+
+	body.Include = nil
+	_, err = directoryTranscodeRequests(body)
+	exam.Equal(e, env, true, errors.Is(err, errTooManyFiles))
+}
+
+func TestDirectoryTranscodeRequestsMissingSource(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	file := filepath.Join(t.TempDir(), "movie.mkv")
+	writeTree(e, env, filepath.Dir(file), "movie.mkv")
+	for _, dir := range []string{filepath.Join(filepath.Dir(file), "missing"), file} {
+		_, err := directoryTranscodeRequests(&vtrest.DirectoryTranscodeRequest{SourceDirectory: dir, DestinationDirectory: t.TempDir()})
+		exam.NotNil(e, env, err)
+	}
+}
@@ -0,0 +1,182 @@
+package vtserver
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// downloadSigner creates and verifies time-limited signatures for output download URLs.
+type downloadSigner struct {
+	key       []byte
+	ttl       time.Duration
+	publicURL string
+}
+
+// newDownloadSigner creates a signer from cfg.  If cfg has no download key, fallbackKey
+// is used, and if that is empty too a random key is generated.
+func newDownloadSigner(cfg *internal.ServerConfig, fallbackKey []byte) (*downloadSigner, error) {
+	key := []byte(cfg.DownloadKey)
+	if len(key) == 0 {
+		key = fallbackKey
+	}
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed to generate download signing key: %w", err)
+		}
+	}
+	return &downloadSigner{
+		key:       key,
+		ttl:       cfg.DownloadURLTTL,
+		publicURL: strings.TrimSuffix(cfg.PublicURL, "/"),
+	}, nil
+}
+
+func (d *downloadSigner) sign(id uuid.UUID, expires int64) string {
+	mac := hmac.New(sha256.New, d.key)
+	fmt.Fprintf(mac, "%s\n%d", id, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// url returns a signed download URL for the output of the given job, and its expiry time.
+func (d *downloadSigner) url(id uuid.UUID, now time.Time) (string, time.Time) {
+	expiresAt := now.Add(d.ttl).Truncate(time.Second)
+	expires := expiresAt.Unix()
+	query := url.Values{
+		"expires":   {strconv.FormatInt(expires, 10)},
+		"signature": {d.sign(id, expires)},
+	}
+	return fmt.Sprintf("%s%s/transcodes/%s/output/download?%s", d.publicURL, vtrest.BasePath, id, query.Encode()), expiresAt
+}
+
+// verify reports whether signature is valid for the given job and has not expired.
+func (d *downloadSigner) verify(id uuid.UUID, expires int64, signature string, now time.Time) bool {
+	if now.Unix() > expires {
+		return false
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	want, _ := hex.DecodeString(d.sign(id, expires))
+	return hmac.Equal(got, want)
+}
+
+// GetTranscodeOutput handles GET /transcodes/{uuid}/output requests.
+func (s *Server) GetTranscodeOutput(ctx context.Context, request vtrest.GetTranscodeOutputRequestObject) (vtrest.GetTranscodeOutputResponseObject, error) {
+	job, err := s.lookupJob(ctx, request.Uuid)
+	if errors.Is(err, errJobNotFound) {
+		return vtrest.GetTranscodeOutput404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("Transcode job with UUID %s not found", request.Uuid),
+		}, nil
+	} else if err != nil {
+		return vtrest.GetTranscodeOutput500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+
+	if !outputReady(job) {
+		return vtrest.GetTranscodeOutput409ApplicationProblemPlusJSONResponse{
+			Code:    "OUTPUT_NOT_READY",
+			Message: fmt.Sprintf("Transcode job with UUID %s has not completed successfully", request.Uuid),
+		}, nil
+	}
+
+	downloadURL, expiresAt := s.settings.Load().downloads.url(request.Uuid, time.Now())
+	return vtrest.GetTranscodeOutput200JSONResponse{
+		Url:       downloadURL,
+		ExpiresAt: expiresAt.UTC(),
+	}, nil
+}
+
+// DownloadTranscodeOutput handles GET /transcodes/{uuid}/output/download requests.
+func (s *Server) DownloadTranscodeOutput(ctx context.Context, request vtrest.DownloadTranscodeOutputRequestObject) (vtrest.DownloadTranscodeOutputResponseObject, error) {
+	if !s.settings.Load().downloads.verify(request.Uuid, request.Params.Expires, request.Params.Signature, time.Now()) {
+		return vtrest.DownloadTranscodeOutput403ApplicationProblemPlusJSONResponse{
+			Code:    "INVALID_SIGNATURE",
+			Message: "Download URL signature is invalid or has expired",
+		}, nil
+	}
+
+	job, err := s.lookupJob(ctx, request.Uuid)
+	if errors.Is(err, errJobNotFound) {
+		return vtrest.DownloadTranscodeOutput404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("Transcode job with UUID %s not found", request.Uuid),
+		}, nil
+	} else if err != nil {
+		return vtrest.DownloadTranscodeOutput500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+
+	var jobArgs internal.TranscodeJobArgs
+	if err := json.Unmarshal(job.EncodedArgs, &jobArgs); err != nil {
+		return vtrest.DownloadTranscodeOutput500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to unmarshal job args: %v", err),
+		}, nil
+	}
+
+	// The server can only stream the output if it shares the media mount with the workers.
+	f, err := os.Open(jobArgs.DestinationPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return vtrest.DownloadTranscodeOutput404ApplicationProblemPlusJSONResponse{
+			Code:    "OUTPUT_NOT_FOUND",
+			Message: fmt.Sprintf("Output file %q is not accessible from the server", jobArgs.DestinationPath),
+		}, nil
+	} else if err != nil {
+		return vtrest.DownloadTranscodeOutput500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to open output file: %v", err),
+		}, nil
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return vtrest.DownloadTranscodeOutput500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to stat output file: %v", err),
+		}, nil
+	}
+
+	// The generated handler closes the body once it has been written.
+	return vtrest.DownloadTranscodeOutput200ApplicationoctetStreamResponse{
+		Body:          f,
+		ContentLength: info.Size(),
+	}, nil
+}
+
+// outputReady reports whether the job finished successfully and has an output to download.
+func outputReady(job *rivertype.JobRow) bool {
+	if job.State != rivertype.JobStateCompleted {
+		return false
+	}
+	var jobStatus internal.TranscodeJobStatus
+	if output := job.Output(); len(output) > 0 {
+		if err := json.Unmarshal(output, &jobStatus); err != nil {
+			return false
+		}
+	}
+	// Jobs with a completion webhook are completed even when transcoding failed.
+	return jobStatus.Error == nil
+}
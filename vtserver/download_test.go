@@ -0,0 +1,126 @@
+package vtserver
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/riverqueue/river/rivertype"
+)
+
+func TestDownloadSigner(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	cfg := &internal.ServerConfig{PublicURL: "https://transcoder.example/", DownloadKey: "secret", DownloadURLTTL: time.Hour}
+	signer, err := newDownloadSigner(cfg, nil)
+	exam.Nil(e, env, err).Log(err).Must()
+
+	id := uuid.New()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 600, time.UTC)
+	rawURL, expiresAt := signer.url(id, now)
+	exam.Equal(e, env, now.Add(time.Hour).Truncate(time.Second), expiresAt)
+
+	parsed, err := url.Parse(rawURL)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, "https://transcoder.example/v1/transcodes/"+id.String()+"/output/download", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	expires, err := strconv.ParseInt(parsed.Query().Get("expires"), 10, 64)
+	exam.Nil(e, env, err).Log(err).Must()
+	signature := parsed.Query().Get("signature")
+
+	other, err := newDownloadSigner(&internal.ServerConfig{DownloadKey: "other", DownloadURLTTL: time.Hour}, nil)
+	exam.Nil(e, env, err).Log(err).Must()
+
+	tests := []struct {
+		loc       exam.Loc
+		name      string
+		signer    *downloadSigner
+		id        uuid.UUID
+		expires   int64
+		signature string
+		now       time.Time
+		want      bool
+	}{
+		{loc: exam.Here(), name: "valid", signer: signer, id: id, expires: expires, signature: signature, now: now, want: true},
+		{loc: exam.Here(), name: "valid until it expires", signer: signer, id: id, expires: expires, signature: signature, now: expiresAt, want: true},
+		{loc: exam.Here(), name: "expired", signer: signer, id: id, expires: expires, signature: signature, now: expiresAt.Add(time.Second)},
+		{loc: exam.Here(), name: "expiry extended", signer: signer, id: id, expires: expires + 3600, signature: signature, now: now},
+		{loc: exam.Here(), name: "other job", signer: signer, id: uuid.New(), expires: expires, signature: signature, now: now},
+		{loc: exam.Here(), name: "not hex", signer: signer, id: id, expires: expires, signature: "not-a-signature", now: now},
+		{loc: exam.Here(), name: "other key", signer: other, id: id, expires: expires, signature: signature, now: now},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			e.Log("Running test at", tt.loc)
+			env := deep.NewEnv()
+			exam.Equal(e, env, tt.want, tt.signer.verify(tt.id, tt.expires, tt.signature, tt.now))
+		})
+	}
+}
+
+func TestNewDownloadSignerKeys(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	id := uuid.New()
+	cfg := &internal.ServerConfig{DownloadURLTTL: time.Hour}
+
+	// Without a download key, the fallback key signs the URLs
+	fallback, err := newDownloadSigner(cfg, []byte("fallback"))
+	exam.Nil(e, env, err).Log(err).Must()
+	explicit, err := newDownloadSigner(&internal.ServerConfig{DownloadKey: "fallback", DownloadURLTTL: time.Hour}, nil)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, explicit.sign(id, 1), fallback.sign(id, 1))
+
+	// Without either, each signer gets a random key of its own
+	first, err := newDownloadSigner(cfg, nil)
+	exam.Nil(e, env, err).Log(err).Must()
+	second, err := newDownloadSigner(cfg, nil)
+	exam.Nil(e, env, err).Log(err).Must()
+	exam.Equal(e, env, 32, len(first.key))
+	exam.Equal(e, env, false, first.sign(id, 1) == second.sign(id, 1))
+}
+
+func TestOutputReady(t *testing.T) {
+	failed, err := json.Marshal(internal.TranscodeJobStatus{Error: new(string)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	succeeded, err := json.Marshal(internal.TranscodeJobStatus{Progress: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		loc    exam.Loc
+		name   string
+		state  rivertype.JobState
+		output []byte
+		want   bool
+	}{
+		{loc: exam.Here(), name: "completed", state: rivertype.JobStateCompleted, output: succeeded, want: true},
+		{loc: exam.Here(), name: "completed without output", state: rivertype.JobStateCompleted, want: true},
+		{loc: exam.Here(), name: "completed by a failure webhook", state: rivertype.JobStateCompleted, output: failed},
+		{loc: exam.Here(), name: "running", state: rivertype.JobStateRunning, output: succeeded},
+		{loc: exam.Here(), name: "discarded", state: rivertype.JobStateDiscarded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			e.Log("Running test at", tt.loc)
+			env := deep.NewEnv()
+			job := &rivertype.JobRow{State: tt.state}
+			if tt.output != nil {
+				job.Metadata, _ = json.Marshal(map[string]json.RawMessage{rivertype.MetadataKeyOutput: tt.output})
+			}
+			exam.Equal(e, env, tt.want, outputReady(job))
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package vtserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// EstimateTranscode handles POST /estimate requests.
+func (s *Server) EstimateTranscode(ctx context.Context, request vtrest.EstimateTranscodeRequestObject) (vtrest.EstimateTranscodeResponseObject, error) {
+	if request.Body == nil {
+		return vtrest.EstimateTranscode400ApplicationProblemPlusJSONResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	var problems []vtrest.FieldError
+	profile := internal.Profile(request.Body.Profile)
+	if !profile.IsValid() {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/profile"),
+			Code:    "INVALID_PROFILE",
+			Message: fmt.Sprintf("Invalid profile: %q", request.Body.Profile),
+		})
+	}
+	if problem := validatePath("/sourcePath", request.Body.SourcePath); problem != nil {
+		problems = append(problems, *problem)
+	} else if !s.pathAllowed(ctx, request.Body.SourcePath) {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/sourcePath"),
+			Code:    "PATH_NOT_ALLOWED",
+			Message: fmt.Sprintf("Path %q is not inside an allowed directory", request.Body.SourcePath),
+		})
+	}
+	if len(problems) > 0 {
+		return vtrest.EstimateTranscode400ApplicationProblemPlusJSONResponse(validationProblem(problems)), nil
+	}
+
+	duration, err := internal.ProbeDuration(ctx, nil, request.Body.SourcePath)
+	if err != nil {
+		return vtrest.EstimateTranscode400ApplicationProblemPlusJSONResponse{
+			Code:    "SOURCE_NOT_PROBED",
+			Message: fmt.Sprintf("Source %q could not be probed from the server: %v", request.Body.SourcePath, err),
+		}, nil
+	}
+
+	stats, err := internal.LookupProfileStats(ctx, s.readPool, profile)
+	if err != nil {
+		return vtrest.EstimateTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+
+	response := vtrest.EstimateTranscode200JSONResponse{
+		Profile:               request.Body.Profile,
+		SourceDurationSeconds: duration.Seconds(),
+	}
+	if encode, outputBytes, ok := stats.Estimate(duration); ok {
+		encodeSeconds := encode.Seconds()
+		response.SampleJobs = stats.Jobs
+		response.EstimatedEncodeSeconds = &encodeSeconds
+		response.EstimatedOutputSizeBytes = &outputBytes
+	}
+	return response, nil
+}
@@ -0,0 +1,60 @@
+package vtserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// GetTranscodeEvents handles GET /transcodes/{uuid}/events requests.
+func (s *Server) GetTranscodeEvents(ctx context.Context, request vtrest.GetTranscodeEventsRequestObject) (vtrest.GetTranscodeEventsResponseObject, error) {
+	job, err := s.readJob(ctx, request.Uuid)
+	if errors.Is(err, errJobNotFound) {
+		return vtrest.GetTranscodeEvents404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("Transcode job with UUID %s not found", request.Uuid),
+		}, nil
+	} else if err != nil {
+		return vtrest.GetTranscodeEvents500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+
+	rows, err := s.readPool.Query(ctx, "SELECT state, attempt, error, occurred_at FROM job_events WHERE river_job_id = $1 ORDER BY id", job.ID)
+	if err != nil {
+		return vtrest.GetTranscodeEvents500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to query job events: %v", err),
+		}, nil
+	}
+	defer rows.Close()
+
+	response := vtrest.GetTranscodeEvents200JSONResponse{
+		Events: []vtrest.TranscodeEvent{},
+	}
+	for rows.Next() {
+		var event vtrest.TranscodeEvent
+		var attempt int16
+		if err := rows.Scan(&event.State, &attempt, &event.Error, &event.OccurredAt); err != nil {
+			return vtrest.GetTranscodeEvents500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: fmt.Sprintf("failed to scan job event: %v", err),
+			}, nil
+		}
+		event.Attempt = int(attempt)
+		event.Status = mapRiverStateToTranscodeStatus(rivertype.JobState(event.State))
+		response.Events = append(response.Events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return vtrest.GetTranscodeEvents500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to read job events: %v", err),
+		}, nil
+	}
+
+	return response, nil
+}
@@ -0,0 +1,148 @@
+package vtserver
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+)
+
+// exportBatchSize bounds the jobs read from the database at once by an export.
+const exportBatchSize = 1000
+
+// exportColumns is the header row of a CSV export.
+var exportColumns = []string{"uuid", "status", "profile", "sourcePath", "destinationPath", "groupId", "labels", "priority", "progress", "error", "errorCode", "createdAt", "updatedAt"}
+
+// ExportTranscodes handles GET /transcodes/export requests.
+func (s *Server) ExportTranscodes(ctx context.Context, request vtrest.ExportTranscodesRequestObject) (vtrest.ExportTranscodesResponseObject, error) {
+	format := vtrest.Ndjson
+	if request.Params.Format != nil {
+		format = *request.Params.Format
+	}
+	if format != vtrest.Ndjson && format != vtrest.Csv {
+		return vtrest.ExportTranscodes400ApplicationProblemPlusJSONResponse{
+			Code:    "INVALID_FORMAT",
+			Message: fmt.Sprintf("format must be %q or %q", vtrest.Ndjson, vtrest.Csv),
+		}, nil
+	}
+
+	params, problem := listParamsFromRequest(vtrest.ListTranscodesParams{Status: request.Params.Status, Label: request.Params.Label})
+	if problem != nil {
+		return vtrest.ExportTranscodes400ApplicationProblemPlusJSONResponse(*problem), nil
+	}
+	return &exportResponse{
+		ctx:    ctx,
+		server: s,
+		params: scopeToTenant(ctx, params.OrderBy(river.JobListOrderByID, river.SortOrderAsc).First(exportBatchSize)),
+		format: format,
+	}, nil
+}
+
+// exportResponse streams the jobs matching an export's filters a batch at a time, so
+// exporting a long history doesn't hold it all in memory.
+type exportResponse struct {
+	ctx    context.Context
+	server *Server
+	params *river.JobListParams
+	format vtrest.ExportFormat
+}
+
+func (r *exportResponse) VisitExportTranscodesResponse(w http.ResponseWriter) error {
+	var write func(*vtrest.TranscodeJob) error
+	var flush func() error
+	if r.format == vtrest.Csv {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="transcodes.csv"`)
+		writer := csv.NewWriter(w)
+		write = func(job *vtrest.TranscodeJob) error { return writer.Write(exportRow(job)) }
+		flush = func() error {
+			writer.Flush()
+			return writer.Error()
+		}
+		w.WriteHeader(http.StatusOK)
+		if err := writer.Write(exportColumns); err != nil {
+			return nil
+		}
+	} else {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", `attachment; filename="transcodes.ndjson"`)
+		encoder := json.NewEncoder(w)
+		write = func(job *vtrest.TranscodeJob) error { return encoder.Encode(job) }
+		flush = func() error { return nil }
+		w.WriteHeader(http.StatusOK)
+	}
+	flusher := http.NewResponseController(w)
+
+	// Once the status has been sent, errors can only end the response early.
+	params := r.params
+	for {
+		result, err := r.server.readClient.JobList(r.ctx, params)
+		if err != nil {
+			slog.Warn("failed to list jobs for export", "error", err)
+			return nil
+		}
+		for _, job := range result.Jobs {
+			transcodeJob, err := transcodeJobFromRiver(job)
+			if err != nil {
+				slog.Warn("failed to export job", "job_id", job.ID, "error", err)
+				return nil
+			}
+			if err := write(transcodeJob); err != nil {
+				return nil
+			}
+		}
+		if err := flush(); err != nil {
+			return nil
+		}
+		if err := flusher.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return nil
+		}
+		if len(result.Jobs) < exportBatchSize || result.LastCursor == nil {
+			return nil
+		}
+		params = params.After(result.LastCursor)
+	}
+}
+
+// exportRow returns the CSV row for job, in the order of exportColumns.
+func exportRow(job *vtrest.TranscodeJob) []string {
+	var labels []string
+	if job.Labels != nil {
+		for _, key := range slices.Sorted(maps.Keys(*job.Labels)) {
+			labels = append(labels, key+"="+(*job.Labels)[key])
+		}
+	}
+	var priority, errorCode string
+	if job.Priority != nil {
+		priority = strconv.Itoa(*job.Priority)
+	}
+	if job.ErrorCode != nil {
+		errorCode = string(*job.ErrorCode)
+	}
+	return []string{
+		job.Uuid.String(),
+		string(job.Status),
+		job.Profile,
+		job.SourcePath,
+		job.DestinationPath,
+		derefString(job.GroupId),
+		strings.Join(labels, ";"),
+		priority,
+		strconv.FormatFloat(job.Progress, 'f', -1, 64),
+		derefString(job.Error),
+		errorCode,
+		job.CreatedAt.Format(time.RFC3339),
+		job.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,118 @@
+package vtserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+func TestExportRow(t *testing.T) {
+	id := uuid.MustParse("0b5c6d2e-8f1a-4c3b-9d7e-2a1f0e9d8c7b")
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	groupID := "season-1"
+	labels := vtrest.Labels{"show": "Firefly", "episode": "1"}
+	priority := 2
+	errMsg := "source missing, giving up"
+	errorCode := vtrest.ErrorCodeSourceNotFound
+
+	tests := []struct {
+		loc  exam.Loc
+		name string
+		job  vtrest.TranscodeJob
+		want []string
+	}{
+		{
+			loc:  exam.Here(),
+			name: "every column",
+			job: vtrest.TranscodeJob{
+				Uuid:            id,
+				Status:          vtrest.Failed,
+				Profile:         "preview",
+				SourcePath:      "/media/Firefly, S01E01.mkv",
+				DestinationPath: "/media/out/Firefly \"Serenity\".mp4",
+				GroupId:         &groupID,
+				Labels:          &labels,
+				Priority:        &priority,
+				Progress:        12.5,
+				Error:           &errMsg,
+				ErrorCode:       &errorCode,
+				CreatedAt:       created,
+				UpdatedAt:       created.Add(time.Minute),
+			},
+			want: []string{
+				id.String(), "failed", "preview", "/media/Firefly, S01E01.mkv", "/media/out/Firefly \"Serenity\".mp4",
+				"season-1", "episode=1;show=Firefly", "2", "12.5", errMsg, "SOURCE_NOT_FOUND",
+				"2026-01-02T03:04:05Z", "2026-01-02T03:05:05Z",
+			},
+		},
+		{
+			loc:  exam.Here(),
+			name: "optional columns empty",
+			job: vtrest.TranscodeJob{
+				Uuid:            id,
+				Status:          vtrest.Completed,
+				Profile:         "preview",
+				SourcePath:      "/media/movie.mkv",
+				DestinationPath: "/media/out/movie.mp4",
+				Progress:        100,
+				CreatedAt:       created,
+				UpdatedAt:       created,
+			},
+			want: []string{
+				id.String(), "completed", "preview", "/media/movie.mkv", "/media/out/movie.mp4",
+				"", "", "", "100", "", "", "2026-01-02T03:04:05Z", "2026-01-02T03:04:05Z",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			e.Log("Running test at", tt.loc)
+			env := deep.NewEnv()
+			row := exportRow(&tt.job)
+			exam.Equal(e, env, tt.want, row)
+			exam.Equal(e, env, len(exportColumns), len(row))
+
+			// Paths with commas and quotes survive a round trip through CSV
+			var buf bytes.Buffer
+			writer := csv.NewWriter(&buf)
+			exam.Nil(e, env, writer.Write(row)).Must()
+			writer.Flush()
+			read, err := csv.NewReader(&buf).Read()
+			exam.Nil(e, env, err).Log(err).Must()
+			exam.Equal(e, env, row, read)
+		})
+	}
+}
+
+func TestExportTranscodesInvalidParams(t *testing.T) {
+	xml := vtrest.ExportFormat("xml")
+	tests := []struct {
+		loc      exam.Loc
+		name     string
+		params   vtrest.ExportTranscodesParams
+		wantCode string
+	}{
+		{loc: exam.Here(), name: "format", params: vtrest.ExportTranscodesParams{Format: &xml}, wantCode: "INVALID_FORMAT"},
+		{loc: exam.Here(), name: "label", params: vtrest.ExportTranscodesParams{Label: []string{"show"}}, wantCode: "INVALID_LABEL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			e.Log("Running test at", tt.loc)
+			env := deep.NewEnv()
+			response, err := (&Server{}).ExportTranscodes(context.Background(), vtrest.ExportTranscodesRequestObject{Params: tt.params})
+			exam.Nil(e, env, err).Log(err).Must()
+			problem, ok := response.(vtrest.ExportTranscodes400ApplicationProblemPlusJSONResponse)
+			exam.Equal(e, env, true, ok).Must()
+			exam.Equal(e, env, tt.wantCode, problem.Code)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package vtserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// GetGroupStatus handles GET /groups/{groupId} requests.
+func (s *Server) GetGroupStatus(ctx context.Context, request vtrest.GetGroupStatusRequestObject) (vtrest.GetGroupStatusResponseObject, error) {
+	params := river.NewJobListParams().
+		Kinds(internal.TranscodeJobArgs{}.Kind()).
+		States(rivertype.JobStates()...).
+		Where("args->>'groupId' = @group", river.NamedArgs{"group": request.GroupId}).
+		OrderBy(river.JobListOrderByID, river.SortOrderAsc).
+		First(maxListLimit)
+
+	// Groups can be larger than a page, so read every page
+	var jobs []vtrest.TranscodeJob
+	for {
+		result, err := s.readClient.JobList(ctx, scopeToTenant(ctx, params))
+		if err != nil {
+			return vtrest.GetGroupStatus500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: fmt.Sprintf("failed to list river jobs: %v", err),
+			}, nil
+		}
+		for _, job := range result.Jobs {
+			transcodeJob, err := transcodeJobFromRiver(job)
+			if err != nil {
+				return vtrest.GetGroupStatus500ApplicationProblemPlusJSONResponse{
+					Code:    "INTERNAL_ERROR",
+					Message: err.Error(),
+				}, nil
+			}
+			jobs = append(jobs, *transcodeJob)
+		}
+		if len(result.Jobs) < maxListLimit || result.LastCursor == nil {
+			break
+		}
+		params = params.After(result.LastCursor)
+	}
+
+	if len(jobs) == 0 {
+		return vtrest.GetGroupStatus404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("No transcode jobs found in group %q", request.GroupId),
+		}, nil
+	}
+	return vtrest.GetGroupStatus200JSONResponse(jobGroup(request.GroupId, jobs)), nil
+}
+
+// jobGroup aggregates the jobs of a group.
+func jobGroup(groupID string, jobs []vtrest.TranscodeJob) vtrest.JobGroup {
+	group := vtrest.JobGroup{
+		GroupId:   groupID,
+		Jobs:      jobs,
+		CreatedAt: jobs[0].CreatedAt,
+		UpdatedAt: jobs[0].UpdatedAt,
+	}
+	statuses := make([]vtrest.TranscodeStatus, len(jobs))
+	var progress float64
+	for i, job := range jobs {
+		statuses[i] = job.Status
+		switch job.Status {
+		case vtrest.Pending:
+			group.Counts.Pending++
+			progress += job.Progress
+		case vtrest.Running:
+			group.Counts.Running++
+			progress += job.Progress
+		case vtrest.Completed:
+			group.Counts.Completed++
+			progress += 100
+		case vtrest.Failed:
+			group.Counts.Failed++
+			progress += 100
+		}
+		if job.CreatedAt.Before(group.CreatedAt) {
+			group.CreatedAt = job.CreatedAt
+		}
+		if job.UpdatedAt.After(group.UpdatedAt) {
+			group.UpdatedAt = job.UpdatedAt
+		}
+	}
+	group.Progress = progress / float64(len(jobs))
+	group.Status = aggregateStatus(statuses)
+	return group
+}
+
+// aggregateStatus combines the statuses of several jobs: pending until any has
+// started, running until every one has finished, and then failed if any failed or
+// completed if none did.
+func aggregateStatus(statuses []vtrest.TranscodeStatus) vtrest.TranscodeStatus {
+	started, unfinished, failed := false, false, false
+	for _, status := range statuses {
+		switch status {
+		case vtrest.Pending:
+			unfinished = true
+		case vtrest.Running:
+			started, unfinished = true, true
+		case vtrest.Completed:
+			started = true
+		case vtrest.Failed:
+			started, failed = true, true
+		}
+	}
+	switch {
+	case unfinished && started:
+		return vtrest.Running
+	case unfinished:
+		return vtrest.Pending
+	case failed:
+		return vtrest.Failed
+	default:
+		return vtrest.Completed
+	}
+}
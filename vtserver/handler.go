@@ -0,0 +1,112 @@
+package vtserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/riverdriver/riverpgxv5"
+)
+
+// Config configures the server.  Port and ReplicaDatabase are only used by Run, and
+// Database by the server binary; an embedded Handler uses the pools it is given.
+type Config = internal.ServerConfig
+
+// ConfigFromEnv loads the server configuration from the same environment variables
+// and config file as the server binary.  It panics if a variable is invalid.
+func ConfigFromEnv() *Config {
+	return internal.NewServerConfigFromEnv()
+}
+
+// Migrate brings the schema of the database behind pool up to date, as the binaries do
+// at startup unless VT_AUTO_MIGRATE is false.  It is safe to call concurrently from
+// several processes.
+func Migrate(ctx context.Context, pool *pgxpool.Pool) error {
+	return internal.MigrateUp(ctx, pool)
+}
+
+// Handler serves the API, for mounting on another service's router.  Requests are
+// routed by their path relative to the handler, so mount it with http.StripPrefix to
+// serve it below a prefix, and include the prefix in Config.PublicURL so download URLs
+// point back at it.
+type Handler struct {
+	server  *Server
+	handler http.Handler
+	// stopMonitor stops pinging the database for /readyz.
+	stopMonitor context.CancelFunc
+}
+
+// NewHandler creates a Handler that stores jobs through pool, whose schema must already
+// be migrated.  If pool's search_path runtime parameter names a schema, as
+// VT_DB_SCHEMA makes it for the binaries, the tables there are used.  Call Close once
+// the handler is no longer used.
+func NewHandler(pool *pgxpool.Pool, cfg *Config) (*Handler, error) {
+	return NewHandlerWithReplica(pool, pool, cfg)
+}
+
+// NewHandlerWithReplica is NewHandler for a pool with a read-only replica, which job
+// status, list, and stats reads are sent to.
+func NewHandlerWithReplica(pool, replica *pgxpool.Pool, cfg *Config) (*Handler, error) {
+	// Create River client (insert-only, no workers)
+	riverClient, err := river.NewClient(riverpgxv5.New(pool), &river.Config{
+		// No workers needed for the server - it only inserts jobs
+		Workers: nil,
+		Schema:  internal.PoolSchema(pool),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create river client: %w", err)
+	}
+
+	// Connect to the event bus, if one is configured
+	events, err := internal.NewEventPublisher(cfg.Events)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event publisher: %w", err)
+	}
+
+	server, err := NewServer(pool, replica, riverClient, events, cfg)
+	if err != nil {
+		events.Close()
+		return nil, fmt.Errorf("failed to create server: %w", err)
+	}
+	// Serve the API under its version prefix, and unversioned for clients from before it had one
+	strictHandler := vtrest.NewStrictHandlerWithOptions(server, nil, vtrest.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  requestErrorHandler,
+		ResponseErrorHandlerFunc: responseErrorHandler,
+	})
+	monitorCtx, stopMonitor := context.WithCancel(context.Background())
+	go server.db.Run(monitorCtx)
+
+	mux := http.NewServeMux()
+	for _, baseURL := range []string{vtrest.BasePath, ""} {
+		vtrest.HandlerWithOptions(strictHandler, vtrest.StdHTTPServerOptions{
+			BaseURL:          baseURL,
+			BaseRouter:       mux,
+			ErrorHandlerFunc: requestErrorHandler,
+		})
+	}
+	return &Handler{
+		server:      server,
+		handler:     requestIDMiddleware(deprecatedPathMiddleware(server.problemMiddleware(server.tenantMiddleware(mux)))),
+		stopMonitor: stopMonitor,
+	}, nil
+}
+
+// ServeHTTP serves an API request.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.handler.ServeHTTP(w, r)
+}
+
+// Reload applies the reloadable settings in cfg, as the server binary does on SIGHUP.
+func (h *Handler) Reload(cfg *Config) error {
+	return h.server.Reload(cfg)
+}
+
+// Close stops monitoring the database and disconnects from the event bus.
+func (h *Handler) Close() error {
+	h.stopMonitor()
+	return h.server.events.Close()
+}
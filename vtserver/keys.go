@@ -0,0 +1,36 @@
+package vtserver
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// ListWebhookKeys handles GET /.well-known/vt-webhook-keys requests.
+func (s *Server) ListWebhookKeys(ctx context.Context, request vtrest.ListWebhookKeysRequestObject) (vtrest.ListWebhookKeysResponseObject, error) {
+	keys, err := internal.ListWebhookKeys(ctx, s.pool)
+	if err != nil {
+		return vtrest.ListWebhookKeys500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+
+	response := vtrest.ListWebhookKeys200JSONResponse{
+		Keys: make([]vtrest.WebhookKey, 0, len(keys)),
+	}
+	use, alg := "sig", "EdDSA"
+	for _, key := range keys {
+		response.Keys = append(response.Keys, vtrest.WebhookKey{
+			Kid: internal.WebhookKeyID(key),
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(key),
+			Use: &use,
+			Alg: &alg,
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,234 @@
+package vtserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+const (
+	defaultListLimit = 100
+	maxListLimit     = 1000
+	// maxStatusUUIDs bounds the number of jobs looked up by one bulk status request,
+	// and acted on by one bulk cancel or retry.
+	maxStatusUUIDs = 1000
+)
+
+// ListTranscodes handles GET /transcodes requests.
+func (s *Server) ListTranscodes(ctx context.Context, request vtrest.ListTranscodesRequestObject) (vtrest.ListTranscodesResponseObject, error) {
+	params, problem := listParamsFromRequest(request.Params)
+	if problem != nil {
+		return vtrest.ListTranscodes400ApplicationProblemPlusJSONResponse(*problem), nil
+	}
+
+	result, err := s.readClient.JobList(ctx, scopeToTenant(ctx, params))
+	if err != nil {
+		return vtrest.ListTranscodes500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to list river jobs: %v", err),
+		}, nil
+	}
+
+	response := vtrest.ListTranscodes200JSONResponse{
+		Jobs: make([]vtrest.TranscodeJob, 0, len(result.Jobs)),
+	}
+	for _, job := range result.Jobs {
+		transcodeJob, err := transcodeJobFromRiver(job)
+		if err != nil {
+			return vtrest.ListTranscodes500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: err.Error(),
+			}, nil
+		}
+		response.Jobs = append(response.Jobs, *transcodeJob)
+	}
+
+	// A full page means there may be more jobs after the last one.
+	if len(result.Jobs) == listLimit(request.Params) && result.LastCursor != nil {
+		cursor, err := result.LastCursor.MarshalText()
+		if err != nil {
+			return vtrest.ListTranscodes500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: fmt.Sprintf("failed to marshal cursor: %v", err),
+			}, nil
+		}
+		nextCursor := string(cursor)
+		response.NextCursor = &nextCursor
+	}
+
+	return response, nil
+}
+
+// GetTranscodeStatuses handles POST /transcodes/status requests.
+func (s *Server) GetTranscodeStatuses(ctx context.Context, request vtrest.GetTranscodeStatusesRequestObject) (vtrest.GetTranscodeStatusesResponseObject, error) {
+	if request.Body == nil || len(request.Body.Uuids) == 0 {
+		return vtrest.GetTranscodeStatuses400ApplicationProblemPlusJSONResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "At least one UUID is required",
+		}, nil
+	}
+	if len(request.Body.Uuids) > maxStatusUUIDs {
+		return vtrest.GetTranscodeStatuses400ApplicationProblemPlusJSONResponse{
+			Code:    "INVALID_REQUEST",
+			Message: fmt.Sprintf("At most %d UUIDs may be requested at once", maxStatusUUIDs),
+		}, nil
+	}
+
+	ids := make([]string, len(request.Body.Uuids))
+	for i, id := range request.Body.Uuids {
+		ids[i] = id.String()
+	}
+	params := river.NewJobListParams().
+		Kinds(internal.TranscodeJobArgs{}.Kind()).
+		States(rivertype.JobStates()...).
+		Where("args->>'uuid' = any(@uuids::text[])", river.NamedArgs{"uuids": ids}).
+		First(maxStatusUUIDs)
+	result, err := s.readClient.JobList(ctx, scopeToTenant(ctx, params))
+	if err != nil {
+		return vtrest.GetTranscodeStatuses500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to list river jobs: %v", err),
+		}, nil
+	}
+
+	found := make(map[uuid.UUID]vtrest.TranscodeJob, len(result.Jobs))
+	for _, job := range result.Jobs {
+		transcodeJob, err := transcodeJobFromRiver(job)
+		if err != nil {
+			return vtrest.GetTranscodeStatuses500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: err.Error(),
+			}, nil
+		}
+		found[transcodeJob.Uuid] = *transcodeJob
+	}
+
+	// Answer in request order, once per UUID even if it was requested more than once
+	response := vtrest.GetTranscodeStatuses200JSONResponse{
+		Jobs:     make([]vtrest.TranscodeJob, 0, len(found)),
+		NotFound: []uuid.UUID{},
+	}
+	seen := make(map[uuid.UUID]bool, len(request.Body.Uuids))
+	for _, id := range request.Body.Uuids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if job, ok := found[id]; ok {
+			response.Jobs = append(response.Jobs, job)
+		} else {
+			response.NotFound = append(response.NotFound, id)
+		}
+	}
+	return response, nil
+}
+
+func listLimit(params vtrest.ListTranscodesParams) int {
+	if params.Limit == nil {
+		return defaultListLimit
+	}
+	return *params.Limit
+}
+
+// listParamsFromRequest converts list query parameters into River list parameters.
+func listParamsFromRequest(params vtrest.ListTranscodesParams) (*river.JobListParams, *vtrest.Error) {
+	limit := listLimit(params)
+	if limit < 1 || limit > maxListLimit {
+		return nil, &vtrest.Error{
+			Code:    "INVALID_LIMIT",
+			Message: fmt.Sprintf("limit must be between 1 and %d", maxListLimit),
+		}
+	}
+
+	listParams := river.NewJobListParams().
+		Kinds(internal.TranscodeJobArgs{}.Kind()).
+		OrderBy(river.JobListOrderByID, river.SortOrderDesc).
+		First(limit)
+
+	if params.Status != nil {
+		states := riverStatesForTranscodeStatus(*params.Status)
+		if states == nil {
+			return nil, &vtrest.Error{
+				Code:    "INVALID_STATUS",
+				Message: fmt.Sprintf("Invalid status: %q", *params.Status),
+			}
+		}
+		listParams = listParams.States(states...)
+	}
+
+	if len(params.Label) > 0 {
+		labels, problem := parseLabelFilter(params.Label)
+		if problem != nil {
+			return nil, problem
+		}
+		if listParams, problem = whereLabels(listParams, labels); problem != nil {
+			return nil, problem
+		}
+	}
+
+	if params.Cursor != nil {
+		var cursor river.JobListCursor
+		if err := cursor.UnmarshalText([]byte(*params.Cursor)); err != nil {
+			return nil, &vtrest.Error{
+				Code:    "INVALID_CURSOR",
+				Message: "Invalid cursor",
+			}
+		}
+		listParams = listParams.After(&cursor)
+	}
+
+	return listParams, nil
+}
+
+// parseLabelFilter parses label query parameters of the form key=value.  The value may
+// be empty or contain '='; a key given more than once takes its last value.
+func parseLabelFilter(filter []string) (map[string]string, *vtrest.Error) {
+	labels := make(map[string]string, len(filter))
+	for _, label := range filter {
+		key, value, ok := strings.Cut(label, "=")
+		if !ok || key == "" {
+			return nil, &vtrest.Error{
+				Code:    "INVALID_LABEL",
+				Message: fmt.Sprintf("Label filter %q must be of the form key=value", label),
+			}
+		}
+		labels[key] = value
+	}
+	return labels, nil
+}
+
+// whereLabels restricts params to jobs carrying every one of labels.
+func whereLabels(params *river.JobListParams, labels map[string]string) (*river.JobListParams, *vtrest.Error) {
+	fragment, err := json.Marshal(labels)
+	if err != nil {
+		return nil, &vtrest.Error{
+			Code:    "INVALID_LABEL",
+			Message: fmt.Sprintf("failed to encode label filter: %v", err),
+		}
+	}
+	return params.Where("args->'labels' @> @labels::jsonb", river.NamedArgs{"labels": string(fragment)}), nil
+}
+
+// riverStatesForTranscodeStatus returns the River job states that map to the given status.
+// It is the inverse of mapRiverStateToTranscodeStatus.
+func riverStatesForTranscodeStatus(status vtrest.TranscodeStatus) []rivertype.JobState {
+	switch status {
+	case vtrest.Pending:
+		return []rivertype.JobState{rivertype.JobStateAvailable, rivertype.JobStateScheduled, rivertype.JobStateRetryable, rivertype.JobStatePending}
+	case vtrest.Running:
+		return []rivertype.JobState{rivertype.JobStateRunning}
+	case vtrest.Completed:
+		return []rivertype.JobState{rivertype.JobStateCompleted}
+	case vtrest.Failed:
+		return []rivertype.JobState{rivertype.JobStateDiscarded, rivertype.JobStateCancelled}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,101 @@
+package vtserver
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river/rivertype"
+)
+
+func TestListParamsFromRequest(t *testing.T) {
+	// A cursor as River encodes the last job of a page
+	cursor := base64.URLEncoding.EncodeToString([]byte(`{"id":42,"kind":"transcode","queue":"default","sort_field":"id","time":"2026-01-02T03:04:05Z"}`))
+	invalidCursor := "not a cursor"
+	failed := vtrest.Failed
+	invalidStatus := vtrest.TranscodeStatus("bogus")
+	limit := func(limit int) *int { return &limit }
+
+	tests := []struct {
+		loc      exam.Loc
+		name     string
+		params   vtrest.ListTranscodesParams
+		wantCode string
+	}{
+		{loc: exam.Here(), name: "defaults"},
+		{
+			loc:    exam.Here(),
+			name:   "every filter",
+			params: vtrest.ListTranscodesParams{Limit: limit(maxListLimit), Status: &failed, Label: []string{"show=Firefly"}, Cursor: &cursor},
+		},
+		{loc: exam.Here(), name: "limit too small", params: vtrest.ListTranscodesParams{Limit: limit(0)}, wantCode: "INVALID_LIMIT"},
+		{loc: exam.Here(), name: "limit too large", params: vtrest.ListTranscodesParams{Limit: limit(maxListLimit + 1)}, wantCode: "INVALID_LIMIT"},
+		{loc: exam.Here(), name: "invalid status", params: vtrest.ListTranscodesParams{Status: &invalidStatus}, wantCode: "INVALID_STATUS"},
+		{loc: exam.Here(), name: "invalid label", params: vtrest.ListTranscodesParams{Label: []string{"show"}}, wantCode: "INVALID_LABEL"},
+		{loc: exam.Here(), name: "invalid cursor", params: vtrest.ListTranscodesParams{Cursor: &invalidCursor}, wantCode: "INVALID_CURSOR"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			e.Log("Running test at", tt.loc)
+			env := deep.NewEnv()
+			params, problem := listParamsFromRequest(tt.params)
+			var gotCode string
+			if problem != nil {
+				gotCode = problem.Code
+			}
+			exam.Equal(e, env, tt.wantCode, gotCode)
+			exam.Equal(e, env, tt.wantCode == "", params != nil)
+		})
+	}
+}
+
+func TestParseLabelFilter(t *testing.T) {
+	tests := []struct {
+		loc      exam.Loc
+		name     string
+		filter   []string
+		want     map[string]string
+		wantCode string
+	}{
+		{loc: exam.Here(), name: "several labels", filter: []string{"show=Firefly", "season=1"}, want: map[string]string{"show": "Firefly", "season": "1"}},
+		{loc: exam.Here(), name: "empty value", filter: []string{"show="}, want: map[string]string{"show": ""}},
+		{loc: exam.Here(), name: "value containing =", filter: []string{"query=a=b"}, want: map[string]string{"query": "a=b"}},
+		{loc: exam.Here(), name: "repeated key", filter: []string{"show=Firefly", "show=Serenity"}, want: map[string]string{"show": "Serenity"}},
+		{loc: exam.Here(), name: "no =", filter: []string{"show"}, wantCode: "INVALID_LABEL"},
+		{loc: exam.Here(), name: "empty key", filter: []string{"=Firefly"}, wantCode: "INVALID_LABEL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			e.Log("Running test at", tt.loc)
+			env := deep.NewEnv()
+			got, problem := parseLabelFilter(tt.filter)
+			var gotCode string
+			if problem != nil {
+				gotCode = problem.Code
+			}
+			exam.Equal(e, env, tt.wantCode, gotCode)
+			exam.Equal(e, env, tt.want, got)
+		})
+	}
+}
+
+func TestRiverStatesForTranscodeStatus(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	// Every River state maps to a status whose states include it
+	for _, state := range rivertype.JobStates() {
+		status := mapRiverStateToTranscodeStatus(state)
+		states := riverStatesForTranscodeStatus(status)
+		found := false
+		for _, s := range states {
+			found = found || s == state
+		}
+		exam.Equal(e, env, true, found).Log(state, status)
+	}
+	exam.Equal(e, env, 0, len(riverStatesForTranscodeStatus("bogus")))
+}
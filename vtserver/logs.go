@@ -0,0 +1,123 @@
+package vtserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// logBatchSize bounds the job log lines read from the database at once.
+const logBatchSize = 1000
+
+// GetTranscodeLog handles GET /transcodes/{uuid}/log requests.
+func (s *Server) GetTranscodeLog(ctx context.Context, request vtrest.GetTranscodeLogRequestObject) (vtrest.GetTranscodeLogResponseObject, error) {
+	job, err := s.readJob(ctx, request.Uuid)
+	if errors.Is(err, errJobNotFound) {
+		return vtrest.GetTranscodeLog404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("Transcode job with UUID %s not found", request.Uuid),
+		}, nil
+	} else if err != nil {
+		return vtrest.GetTranscodeLog500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &transcodeLogResponse{
+		ctx:    ctx,
+		server: s,
+		jobID:  job.ID,
+		follow: request.Params.Follow != nil && *request.Params.Follow,
+	}, nil
+}
+
+// transcodeLogResponse streams a job's log.  The generated text response would need
+// the whole log up front, which a followed log never has.
+type transcodeLogResponse struct {
+	ctx    context.Context
+	server *Server
+	jobID  int64
+	follow bool
+}
+
+func (r *transcodeLogResponse) VisitGetTranscodeLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	flusher := http.NewResponseController(w)
+
+	// Once the status has been sent, errors can only end the response early.
+	var lastID int64
+	lastAttempt := 0
+	finished := false
+	for {
+		n, err := r.writeLines(w, &lastID, &lastAttempt)
+		if err != nil {
+			slog.Warn("failed to stream job log", "job_id", r.jobID, "error", err)
+			return nil
+		}
+		if err := flusher.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return nil
+		}
+		if n == logBatchSize {
+			continue
+		}
+		if !r.follow || finished {
+			return nil
+		}
+
+		// Workers write their last lines before finishing a job, so one more read after
+		// the job finishes picks up the rest.
+		job, err := r.server.readClient.JobGet(r.ctx, r.jobID)
+		if err != nil {
+			slog.Warn("failed to get job while streaming its log", "job_id", r.jobID, "error", err)
+			return nil
+		}
+		if status := mapRiverStateToTranscodeStatus(job.State); status == vtrest.Completed || status == vtrest.Failed {
+			finished = true
+			continue
+		}
+
+		select {
+		case <-r.ctx.Done():
+			return nil
+		case <-time.After(waitPollInterval):
+		}
+	}
+}
+
+// writeLines writes the next batch of log lines after lastID, marking the start of each
+// attempt, and returns how many lines were read.
+func (r *transcodeLogResponse) writeLines(w http.ResponseWriter, lastID *int64, lastAttempt *int) (int, error) {
+	rows, err := r.server.readPool.Query(r.ctx, "SELECT id, attempt, line FROM job_logs WHERE river_job_id = $1 AND id > $2 ORDER BY id LIMIT $3", r.jobID, *lastID, logBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query job log: %w", err)
+	}
+	defer rows.Close()
+
+	n := 0
+	for rows.Next() {
+		var attempt int16
+		var line string
+		if err := rows.Scan(lastID, &attempt, &line); err != nil {
+			return n, fmt.Errorf("failed to scan job log line: %w", err)
+		}
+		n++
+		if int(attempt) != *lastAttempt {
+			*lastAttempt = int(attempt)
+			if _, err := fmt.Fprintf(w, "=== attempt %d ===\n", attempt); err != nil {
+				return n, err
+			}
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return n, err
+		}
+	}
+	return n, rows.Err()
+}
@@ -0,0 +1,76 @@
+package vtserver
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// maxRequestIDLength bounds client-supplied request IDs so they can't bloat logs or job metadata.
+const maxRequestIDLength = 128
+
+// statusRecorder captures the status code written by a handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap exposes the wrapped writer to http.ResponseController.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// requestIDMiddleware propagates the caller's X-Request-ID, or generates one, echoes it
+// in the response, makes it available to handlers through the request context, and logs
+// each request with it.
+func requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(internal.RequestIDHeader)
+		if !validRequestID(id) {
+			id = uuid.NewString()
+		}
+		w.Header().Set(internal.RequestIDHeader, id)
+
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(internal.WithRequestID(r.Context(), id)))
+		log.Printf("%s %s %d %s request_id=%s", r.Method, r.URL.Path, recorder.status, time.Since(start).Round(time.Millisecond), id)
+	})
+}
+
+// deprecatedPathMiddleware marks responses to the unversioned API paths as deprecated,
+// linking to the same path under the current version.
+func deprecatedPathMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Well-known paths and readiness checks belong at the root
+		if r.URL.Path != vtrest.BasePath && !strings.HasPrefix(r.URL.Path, vtrest.BasePath+"/") && !strings.HasPrefix(r.URL.Path, "/.well-known/") && r.URL.Path != readyzPath {
+			w.Header().Set("Deprecation", "true")
+			w.Header().Set("Link", fmt.Sprintf(`<%s%s>; rel="successor-version"`, vtrest.BasePath, r.URL.Path))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// validRequestID reports whether a client-supplied request ID is safe to reuse.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		if c < 0x21 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,130 @@
+package vtserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+const (
+	problemContentType = "application/problem+json"
+	// problemTypePrefix and problemInstancePrefix build the type and instance URIs of
+	// problem details from the error code and request ID.
+	problemTypePrefix     = "urn:video-transcoder:problem:"
+	problemInstancePrefix = "urn:video-transcoder:request:"
+	// dbUnavailableRetryAfter is the Retry-After, in seconds, of requests that failed
+	// because the database is unavailable.
+	dbUnavailableRetryAfter = "5"
+)
+
+// problemWriter holds back error responses so problemMiddleware can complete them.
+type problemWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *problemWriter) WriteHeader(status int) {
+	if status >= 400 && strings.HasPrefix(w.Header().Get("Content-Type"), problemContentType) {
+		w.status = status
+		return
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *problemWriter) Write(b []byte) (int, error) {
+	if w.status != 0 {
+		return w.body.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer to flush streams.
+func (w *problemWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// problemMiddleware fills in the RFC 7807 members of problem details responses, so
+// handlers only need to report an error code and message.  Internal errors while the
+// database is unavailable are answered with 503 and a Retry-After instead, so clients
+// know to try again.
+func (s *Server) problemMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pw := &problemWriter{ResponseWriter: w}
+		next.ServeHTTP(pw, r)
+		if pw.status == 0 {
+			return
+		}
+
+		body := pw.body.Bytes()
+		var problem vtrest.Error
+		err := json.Unmarshal(body, &problem)
+		if pw.status == http.StatusInternalServerError {
+			if dbErr := s.db.Check(r.Context()); internal.IsDBUnavailable(dbErr) {
+				pw.status = http.StatusServiceUnavailable
+				problem = vtrest.Error{Code: "DATABASE_UNAVAILABLE", Message: "The database is unavailable; try again later"}
+				err = nil
+				w.Header().Set("Retry-After", dbUnavailableRetryAfter)
+			}
+		}
+		if err == nil {
+			completeProblem(&problem, pw.status, internal.RequestIDFromContext(r.Context()))
+			if encoded, err := json.Marshal(problem); err == nil {
+				body = append(encoded, '\n')
+			}
+		}
+		w.Header().Del("Content-Length")
+		w.WriteHeader(pw.status)
+		if _, err := w.Write(body); err != nil {
+			slog.Warn("failed to write problem response", "error", err)
+		}
+	})
+}
+
+// completeProblem fills in the problem details members that weren't set by the handler.
+func completeProblem(problem *vtrest.Error, status int, requestID string) {
+	if problem.Type == nil && problem.Code != "" {
+		problemType := problemTypePrefix + strings.ToLower(strings.ReplaceAll(problem.Code, "_", "-"))
+		problem.Type = &problemType
+	}
+	if problem.Title == nil {
+		title := http.StatusText(status)
+		problem.Title = &title
+	}
+	if problem.Status == nil {
+		problem.Status = &status
+	}
+	if problem.Detail == nil {
+		problem.Detail = &problem.Message
+	}
+	if problem.Instance == nil && requestID != "" {
+		instance := problemInstancePrefix + url.PathEscape(requestID)
+		problem.Instance = &instance
+	}
+}
+
+// writeProblem writes a problem details response for errors raised outside the
+// handlers, such as request parameters that can't be parsed.
+func writeProblem(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(vtrest.Error{Code: code, Message: message}); err != nil {
+		slog.Warn("failed to write problem response", "error", err)
+	}
+}
+
+// requestErrorHandler reports requests the generated handlers can't decode.
+func requestErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	writeProblem(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+}
+
+// responseErrorHandler reports handler errors that have no response of their own.
+func responseErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	writeProblem(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+}
@@ -0,0 +1,70 @@
+package vtserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// PurgeTranscode handles DELETE /transcodes/{uuid}/purge requests.  The job's events
+// and log lines reference its River job and are deleted along with it, as are its
+// webhook deliveries and comparison.
+func (s *Server) PurgeTranscode(ctx context.Context, request vtrest.PurgeTranscodeRequestObject) (vtrest.PurgeTranscodeResponseObject, error) {
+	if !isAdmin(ctx) {
+		return vtrest.PurgeTranscode403ApplicationProblemPlusJSONResponse(errAdminRequired), nil
+	}
+
+	job, err := s.lookupJob(ctx, request.Uuid)
+	if errors.Is(err, errJobNotFound) {
+		return vtrest.PurgeTranscode404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("Transcode job with UUID %s not found", request.Uuid),
+		}, nil
+	} else if err != nil {
+		return vtrest.PurgeTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return vtrest.PurgeTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to begin transaction: %v", err),
+		}, nil
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := s.riverClient.JobDeleteTx(ctx, tx, job.ID); errors.Is(err, rivertype.ErrJobRunning) {
+		return vtrest.PurgeTranscode409ApplicationProblemPlusJSONResponse{
+			Code:    "JOB_RUNNING",
+			Message: fmt.Sprintf("Transcode job with UUID %s is running; cancel it before purging it", request.Uuid),
+		}, nil
+	} else if err != nil {
+		return vtrest.PurgeTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to delete job: %v", err),
+		}, nil
+	}
+	followUps, err := internal.PurgeFollowUpJobs(ctx, tx, request.Uuid)
+	if err != nil {
+		return vtrest.PurgeTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return vtrest.PurgeTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to commit transaction: %v", err),
+		}, nil
+	}
+	log.Printf("purged transcode job %s and %d webhook deliveries and comparisons", request.Uuid, followUps)
+	return vtrest.PurgeTranscode204Response{}, nil
+}
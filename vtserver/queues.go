@@ -0,0 +1,104 @@
+package vtserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// listQueuesQuery counts the unfinished jobs of every queue.  Queues come from River's
+// queue table, which lists the queues of running clients, and from the jobs themselves,
+// so a backlog with no workers to drain it still shows up.  A job becomes ready to run
+// at its scheduled_at, so that is where its wait starts.
+const listQueuesQuery = `
+	SELECT q.name,
+		count(j.id) FILTER (WHERE j.state = 'available'),
+		count(j.id) FILTER (WHERE j.state = 'running'),
+		count(j.id) FILTER (WHERE j.state = 'retryable'),
+		extract(epoch FROM now() - min(j.scheduled_at) FILTER (WHERE j.state = 'available'))::float8
+	FROM (
+		SELECT name FROM river_queue
+		UNION
+		SELECT DISTINCT queue FROM river_job WHERE state IN ('available', 'running', 'retryable')
+	) q
+	LEFT JOIN river_job j ON j.queue = q.name AND j.state IN ('available', 'running', 'retryable')
+	GROUP BY q.name
+	ORDER BY q.name`
+
+// ListQueues handles GET /queues requests.
+func (s *Server) ListQueues(ctx context.Context, request vtrest.ListQueuesRequestObject) (vtrest.ListQueuesResponseObject, error) {
+	rows, err := s.readPool.Query(ctx, listQueuesQuery)
+	if err != nil {
+		return vtrest.ListQueues500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to query queues: %v", err),
+		}, nil
+	}
+	defer rows.Close()
+
+	response := vtrest.ListQueues200JSONResponse{
+		Queues: []vtrest.Queue{},
+	}
+	for rows.Next() {
+		var queue vtrest.Queue
+		if err := rows.Scan(&queue.Name, &queue.Pending, &queue.Running, &queue.Retryable, &queue.OldestPendingAgeSeconds); err != nil {
+			return vtrest.ListQueues500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: fmt.Sprintf("failed to scan queue: %v", err),
+			}, nil
+		}
+		if queue.OldestPendingAgeSeconds != nil && *queue.OldestPendingAgeSeconds < 0 {
+			// Clock skew between the database and the inserting client.
+			age := 0.0
+			queue.OldestPendingAgeSeconds = &age
+		}
+		response.Queues = append(response.Queues, queue)
+	}
+	if err := rows.Err(); err != nil {
+		return vtrest.ListQueues500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to read queues: %v", err),
+		}, nil
+	}
+
+	return response, nil
+}
+
+// queueScalingQuery counts the pending and running jobs of queue $1 and estimates how
+// long the pending ones take to work.  Pending transcodes don't know their source
+// durations yet, so each is estimated at its profile's average encode time; other jobs,
+// and transcodes of profiles that haven't succeeded yet, at the average run time of the
+// completed jobs of their kind River still retains.
+const queueScalingQuery = `
+	SELECT
+		count(*) FILTER (WHERE j.state = 'available'),
+		count(*) FILTER (WHERE j.state = 'running'),
+		coalesce(sum(coalesce(p.seconds, k.seconds)) FILTER (WHERE j.state = 'available'), 0)::float8,
+		count(*) FILTER (WHERE j.state = 'available' AND coalesce(p.seconds, k.seconds) IS NULL)
+	FROM river_job j
+	LEFT JOIN (
+		SELECT profile, encode_seconds / jobs AS seconds FROM profile_stats WHERE jobs > 0
+	) p ON j.kind = 'transcode' AND p.profile = j.args->>'profile'
+	LEFT JOIN (
+		SELECT kind, avg(extract(epoch FROM finalized_at - attempted_at)) AS seconds
+		FROM river_job
+		WHERE queue = $1 AND state = 'completed' AND attempted_at IS NOT NULL
+		GROUP BY kind
+	) k ON k.kind = j.kind
+	WHERE j.queue = $1 AND j.state IN ('available', 'running')`
+
+// GetQueueScaling handles GET /queues/{name}/scaling requests.
+func (s *Server) GetQueueScaling(ctx context.Context, request vtrest.GetQueueScalingRequestObject) (vtrest.GetQueueScalingResponseObject, error) {
+	response := vtrest.GetQueueScaling200JSONResponse{Queue: request.Name}
+	var backlogSeconds float64
+	err := s.readPool.QueryRow(ctx, queueScalingQuery, request.Name).Scan(&response.PendingJobs, &response.RunningJobs, &backlogSeconds, &response.UnestimatedJobs)
+	if err != nil {
+		return vtrest.GetQueueScaling500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to query queue: %v", err),
+		}, nil
+	}
+	response.BacklogMinutes = backlogSeconds / 60
+	return response, nil
+}
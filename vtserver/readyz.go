@@ -0,0 +1,26 @@
+package vtserver
+
+import (
+	"context"
+
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// readyzPath is where readiness checks are served, at the root as well as under the
+// version prefix.
+const readyzPath = "/readyz"
+
+// GetReadiness handles GET /readyz requests.
+func (s *Server) GetReadiness(ctx context.Context, request vtrest.GetReadinessRequestObject) (vtrest.GetReadinessResponseObject, error) {
+	since, err := s.db.Status()
+	readiness := vtrest.Readiness{
+		Ready:    err == nil,
+		Database: vtrest.DatabaseHealth{Available: err == nil, Since: since},
+	}
+	if err != nil {
+		errMsg := err.Error()
+		readiness.Database.Error = &errMsg
+		return vtrest.GetReadiness503JSONResponse(readiness), nil
+	}
+	return vtrest.GetReadiness200JSONResponse(readiness), nil
+}
@@ -0,0 +1,78 @@
+// Package vtserver is the HTTP API of the video transcoder.  Run serves it the way the
+// server binary does; other Go services can instead mount a Handler on their own
+// router.
+package vtserver
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/krelinga/video-transcoder/internal"
+)
+
+// Run serves the API on cfg.Port until ctx is cancelled, then shuts the HTTP server
+// down gracefully.  The schema must already be migrated.  Reads go to
+// cfg.ReplicaDatabase, if it is set.
+func Run(ctx context.Context, pool *pgxpool.Pool, cfg *Config) error {
+	replica := pool
+	if cfg.ReplicaDatabase != nil {
+		var err error
+		if replica, err = internal.NewDBPool(ctx, cfg.ReplicaDatabase); err != nil {
+			return fmt.Errorf("failed to create replica database pool: %w", err)
+		}
+		defer replica.Close()
+	}
+
+	handler, err := NewHandlerWithReplica(pool, replica, cfg)
+	if err != nil {
+		return err
+	}
+	defer handler.Close()
+	// Reload reloadable settings on SIGHUP; the port and database can't change without a restart
+	internal.OnReload(ctx, func() {
+		if err := handler.Reload(ConfigFromEnv()); err != nil {
+			slog.Warn("failed to apply reloaded configuration", "error", err)
+		}
+	})
+
+	// Configure HTTP server
+	httpServer := &http.Server{
+		Addr:    fmt.Sprintf(":%d", cfg.Port),
+		Handler: handler,
+	}
+
+	// Start HTTP server in goroutine
+	serverErr := make(chan error, 1)
+	go func() {
+		log.Printf("Starting HTTP server on port %d", cfg.Port)
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			serverErr <- err
+		}
+		close(serverErr)
+	}()
+
+	// Wait for shutdown signal or server error
+	select {
+	case err := <-serverErr:
+		return fmt.Errorf("HTTP server error: %w", err)
+	case <-ctx.Done():
+		log.Println("Shutdown signal received, shutting down HTTP server gracefully...")
+	}
+
+	// Create shutdown context with timeout
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Shutdown HTTP server
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("HTTP server shutdown error: %w", err)
+	}
+
+	log.Println("Server shutdown complete")
+	return nil
+}
@@ -0,0 +1,602 @@
+package vtserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/riverdriver/riverpgxv5"
+	"github.com/riverqueue/river/rivertype"
+)
+
+const (
+	// defaultWaitTimeout and maxWaitTimeout bound how long waitForChange holds a status request.
+	defaultWaitTimeout = 30 * time.Second
+	maxWaitTimeout     = 60 * time.Second
+	// waitPollInterval is how often a held status request re-reads the job.
+	waitPollInterval = time.Second
+)
+
+// Server implements the vtrest.StrictServerInterface for handling transcode requests.
+type Server struct {
+	pool        *pgxpool.Pool
+	riverClient *river.Client[pgx.Tx]
+	// readPool and readClient serve status, list, and stats reads.  They are the
+	// replica's when there is one, and otherwise pool and riverClient.
+	readPool   *pgxpool.Pool
+	readClient *river.Client[pgx.Tx]
+	events     internal.EventPublisher
+	db         *internal.DBMonitor
+	settings   atomic.Pointer[serverSettings]
+}
+
+// serverSettings are the parts of the server configuration that can be reloaded.
+type serverSettings struct {
+	downloads *downloadSigner
+	// allowedPaths restricts source and destination paths; empty allows any path.
+	allowedPaths []string
+	// blockPrivateWebhooks rejects webhook URIs that point at internal addresses.
+	blockPrivateWebhooks bool
+	// tenants maps the hashes of API keys to their tenants; empty disables authentication.
+	tenants map[string]*internal.Tenant
+	// tenantsByName maps tenant names to their tenants, to resolve database API tokens.
+	tenantsByName map[string]*internal.Tenant
+	// adminKeys holds the hashes of the keys accepted by the admin endpoints.
+	adminKeys map[string]bool
+	// secrets seals webhook tokens before jobs are inserted; nil stores them in plaintext.
+	secrets internal.KeyWrapper
+}
+
+// NewServer creates a new Server instance.
+func NewServer(pool, replica *pgxpool.Pool, riverClient *river.Client[pgx.Tx], events internal.EventPublisher, cfg *internal.ServerConfig) (*Server, error) {
+	readClient := riverClient
+	if replica != pool {
+		var err error
+		readClient, err = river.NewClient(riverpgxv5.New(replica), &river.Config{Schema: internal.PoolSchema(replica)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create replica river client: %w", err)
+		}
+	}
+	s := &Server{
+		pool:        pool,
+		riverClient: riverClient,
+		readPool:    replica,
+		readClient:  readClient,
+		events:      events,
+		db:          internal.NewDBMonitor(pool),
+	}
+	if err := s.Reload(cfg); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Reload applies the reloadable settings in cfg.  Requests already in progress keep
+// the settings they started with.  If cfg has no download key, the current key is
+// kept so previously issued download URLs stay valid.  The log level is applied to the
+// output installed by internal.SetupLogging.
+func (s *Server) Reload(cfg *internal.ServerConfig) error {
+	var previousKey []byte
+	if current := s.settings.Load(); current != nil {
+		previousKey = current.downloads.key
+	}
+	downloads, err := newDownloadSigner(cfg, previousKey)
+	if err != nil {
+		return err
+	}
+	secrets, err := internal.SecretsKeyWrapper(cfg.SecretsKeys)
+	if err != nil {
+		return err
+	}
+	s.settings.Store(&serverSettings{
+		downloads:            downloads,
+		allowedPaths:         cfg.AllowedPaths,
+		blockPrivateWebhooks: cfg.BlockPrivateWebhooks,
+		tenants:              internal.TenantsByKey(cfg.Tenants),
+		tenantsByName:        tenantsByName(cfg.Tenants),
+		adminKeys:            adminKeys(cfg.AdminKeys),
+		secrets:              secrets,
+	})
+	internal.SetLogLevel(cfg.LogLevel)
+	return nil
+}
+
+// CreateTranscode handles POST /transcodes requests.
+func (s *Server) CreateTranscode(ctx context.Context, request vtrest.CreateTranscodeRequestObject) (vtrest.CreateTranscodeResponseObject, error) {
+	if request.Body == nil {
+		return vtrest.CreateTranscode400ApplicationProblemPlusJSONResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	if problems := s.validateTranscodeRequest(ctx, request.Body); len(problems) > 0 {
+		return vtrest.CreateTranscode400ApplicationProblemPlusJSONResponse(validationProblem(problems)), nil
+	}
+
+	if quota, err := s.checkQuota(ctx, 1); err != nil {
+		return vtrest.CreateTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	} else if quota != nil {
+		return vtrest.CreateTranscode429ApplicationProblemPlusJSONResponse(*quota), nil
+	}
+
+	jobArgs := transcodeJobArgs(ctx, request.Body)
+	if err := jobArgs.SealSecrets(ctx, s.settings.Load().secrets); err != nil {
+		return vtrest.CreateTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Record the request ID so the worker and webhooks can be correlated with this call
+	metadata, err := json.Marshal(internal.JobMetadata{RequestID: internal.RequestIDFromContext(ctx)})
+	if err != nil {
+		return vtrest.CreateTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to marshal job metadata: %v", err),
+		}, nil
+	}
+
+	// Insert job into River; the UUID is a unique job arg, so duplicates are skipped atomically
+	insertedJob, err := s.riverClient.Insert(ctx, jobArgs, &river.InsertOpts{Metadata: metadata})
+	if err != nil {
+		return vtrest.CreateTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to insert river job: %v", err),
+		}, nil
+	}
+	if insertedJob.UniqueSkippedAsDuplicate {
+		return vtrest.CreateTranscode409ApplicationProblemPlusJSONResponse{
+			Code:    "DUPLICATE_UUID",
+			Message: fmt.Sprintf("A transcode job with UUID %s already exists", jobArgs.UUID),
+		}, nil
+	}
+
+	now := time.Now()
+	event := internal.JobEvent{
+		Type:       internal.JobEventCreated,
+		UUID:       jobArgs.UUID,
+		OccurredAt: now,
+		RequestID:  internal.RequestIDFromContext(ctx),
+		Labels:     jobArgs.Labels,
+	}
+	if err := s.events.Publish(ctx, event); err != nil {
+		// The job exists either way; don't fail the request over a missed event
+		slog.Warn("failed to publish created event", "uuid", jobArgs.UUID, "error", err)
+	}
+
+	return vtrest.CreateTranscode201JSONResponse{
+		Uuid:            request.Body.Uuid,
+		Status:          vtrest.Pending,
+		SourcePath:      request.Body.SourcePath,
+		DestinationPath: request.Body.DestinationPath,
+		Profile:         request.Body.Profile,
+		Priority:        &insertedJob.Job.Priority,
+		Progress:        0,
+		Labels:          request.Body.Labels,
+		GroupId:         request.Body.GroupId,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}, nil
+}
+
+// transcodeJobArgs converts a validated transcode request into River job args for the
+// request's tenant.
+func transcodeJobArgs(ctx context.Context, body *vtrest.TranscodeRequest) internal.TranscodeJobArgs {
+	jobArgs := internal.TranscodeJobArgs{
+		UUID:                     uuid.UUID(body.Uuid),
+		SourcePath:               body.SourcePath,
+		DestinationPath:          body.DestinationPath,
+		Profile:                  internal.Profile(body.Profile),
+		WebhookURI:               body.WebhookUri,
+		WebhookToken:             body.WebhookToken,
+		WebhookTokenHeader:       derefString(body.WebhookTokenHeader),
+		HeartbeatWebhookURI:      body.HeartbeatWebhookUri,
+		HeartbeatIntervalSeconds: body.HeartbeatIntervalSeconds,
+		ParallelSegments:         body.ParallelSegments,
+		Tenant:                   tenantName(ctx),
+	}
+	if body.ReuseCompleted != nil {
+		jobArgs.ReuseCompleted = *body.ReuseCompleted
+	}
+	if body.SkipIfValid != nil {
+		jobArgs.SkipIfValid = *body.SkipIfValid
+	}
+	if body.Compare != nil {
+		jobArgs.Compare = &internal.CompareOptions{VMAF: body.Compare.Vmaf != nil && *body.Compare.Vmaf}
+	}
+	if body.Labels != nil {
+		jobArgs.Labels = *body.Labels
+	}
+	if output := body.Output; output != nil {
+		jobArgs.Output = &internal.OutputOwnership{UID: output.Uid, GID: output.Gid}
+		if output.Mode != nil {
+			mode, _ := internal.ParseFileMode(*output.Mode) // Already validated
+			jobArgs.Output.Mode = &mode
+		}
+	}
+	if body.GroupId != nil {
+		jobArgs.GroupID = *body.GroupId
+	}
+	if audio := body.Audio; audio != nil {
+		jobArgs.Audio = &internal.AudioOptions{
+			Codec:       internal.AudioCodec(audio.Codec),
+			BitrateKbps: audio.BitrateKbps,
+		}
+		if audio.Layout != nil {
+			jobArgs.Audio.Layout = internal.AudioLayout(*audio.Layout)
+		}
+		if audio.Downmix != nil {
+			jobArgs.Audio.Downmix = internal.AudioDownmix(*audio.Downmix)
+		}
+		if audio.StereoTrack != nil {
+			jobArgs.Audio.StereoTrack = *audio.StereoTrack
+		}
+	}
+	if subtitles := body.Subtitles; subtitles != nil {
+		jobArgs.Subtitles = &internal.SubtitleOptions{}
+		if subtitles.BurnForced != nil {
+			jobArgs.Subtitles.BurnForced = *subtitles.BurnForced
+		}
+		if subtitles.Captions != nil {
+			jobArgs.Subtitles.Captions = internal.CaptionMode(*subtitles.Captions)
+		}
+		for _, sub := range subtitles.External {
+			external := internal.ExternalSubtitle{Path: sub.Path}
+			if sub.Language != nil {
+				external.Language = *sub.Language
+			}
+			jobArgs.Subtitles.External = append(jobArgs.Subtitles.External, external)
+		}
+	}
+	if video := body.Video; video != nil {
+		jobArgs.Video = &internal.VideoOptions{}
+		if video.Detelecine != nil {
+			jobArgs.Video.Detelecine = *video.Detelecine
+		}
+		if video.Denoise != nil {
+			jobArgs.Video.Denoise = internal.DenoiseFilter(*video.Denoise)
+		}
+		if video.DenoiseLevel != nil {
+			jobArgs.Video.DenoiseLevel = internal.DenoiseLevel(*video.DenoiseLevel)
+		}
+		if video.GrainTune != nil {
+			jobArgs.Video.GrainTune = *video.GrainTune
+		}
+		if perTitle := video.PerTitle; perTitle != nil {
+			jobArgs.Video.PerTitle = &internal.PerTitleOptions{
+				MinCRF: internal.DefaultPerTitleMinCRF,
+				MaxCRF: internal.DefaultPerTitleMaxCRF,
+			}
+			if perTitle.MinCrf != nil {
+				jobArgs.Video.PerTitle.MinCRF = *perTitle.MinCrf
+			}
+			if perTitle.MaxCrf != nil {
+				jobArgs.Video.PerTitle.MaxCRF = *perTitle.MaxCrf
+			}
+		}
+	}
+	if streams := body.Streams; streams != nil {
+		jobArgs.Streams = &internal.StreamSelection{}
+		if streams.Audio != nil {
+			jobArgs.Streams.Audio = append([]int{}, *streams.Audio...)
+		}
+		if streams.Subtitles != nil {
+			jobArgs.Streams.Subtitles = append([]int{}, *streams.Subtitles...)
+		}
+		if streams.Video != nil {
+			jobArgs.Streams.Video = *streams.Video
+		}
+	}
+	if animation := body.Animation; animation != nil {
+		jobArgs.Animation = &internal.AnimationOptions{
+			StartSeconds:    animation.StartSeconds,
+			DurationSeconds: animation.DurationSeconds,
+			Width:           animation.Width,
+		}
+	}
+	if handbrake := body.Handbrake; handbrake != nil {
+		jobArgs.HandBrake = &internal.HandBrakeOptions{Quality: handbrake.Quality}
+		if handbrake.EncoderPreset != nil {
+			jobArgs.HandBrake.EncoderPreset = *handbrake.EncoderPreset
+		}
+		if handbrake.EncoderTune != nil {
+			jobArgs.HandBrake.EncoderTune = *handbrake.EncoderTune
+		}
+		if handbrake.AudioEncoder != nil {
+			jobArgs.HandBrake.AudioEncoder = *handbrake.AudioEncoder
+		}
+		if handbrake.AllSubtitles != nil {
+			jobArgs.HandBrake.AllSubtitles = *handbrake.AllSubtitles
+		}
+	}
+	if chapters := body.Chapters; chapters != nil {
+		jobArgs.Chapters = &internal.ChapterOptions{MinLengthSeconds: chapters.MinLengthSeconds}
+		if chapters.Generate != nil {
+			jobArgs.Chapters.Generate = *chapters.Generate
+		}
+	}
+	for _, rendition := range body.Renditions {
+		jobArgs.Renditions = append(jobArgs.Renditions, internal.Rendition{
+			Name:             rendition.Name,
+			Height:           rendition.Height,
+			VideoBitrateKbps: rendition.VideoBitrateKbps,
+		})
+	}
+	for _, target := range body.Webhooks {
+		webhook := internal.WebhookTarget{URI: target.Uri, Token: target.Token}
+		if target.TokenHeader != nil {
+			webhook.TokenHeader = *target.TokenHeader
+		}
+		for _, event := range target.Events {
+			webhook.Events = append(webhook.Events, internal.WebhookEvent(event))
+		}
+		jobArgs.Webhooks = append(jobArgs.Webhooks, webhook)
+	}
+	return jobArgs
+}
+
+// GetTranscodeStatus handles GET /transcodes/{uuid} requests.
+func (s *Server) GetTranscodeStatus(ctx context.Context, request vtrest.GetTranscodeStatusRequestObject) (vtrest.GetTranscodeStatusResponseObject, error) {
+	timeout := defaultWaitTimeout
+	if request.Params.TimeoutSeconds != nil {
+		if *request.Params.TimeoutSeconds < 1 || time.Duration(*request.Params.TimeoutSeconds)*time.Second > maxWaitTimeout {
+			return vtrest.GetTranscodeStatus400ApplicationProblemPlusJSONResponse{
+				Code:    "INVALID_TIMEOUT",
+				Message: fmt.Sprintf("timeoutSeconds must be between 1 and %d", int(maxWaitTimeout.Seconds())),
+			}, nil
+		}
+		timeout = time.Duration(*request.Params.TimeoutSeconds) * time.Second
+	}
+
+	job, err := s.readJob(ctx, request.Uuid)
+	var transcodeJob *vtrest.TranscodeJob
+	if err == nil {
+		transcodeJob, err = transcodeJobFromRiver(job)
+	}
+	if err == nil && request.Params.WaitForChange != nil && *request.Params.WaitForChange {
+		// Wait for a change from the client's version if it sent one, so changes made
+		// between its requests aren't missed
+		baseline := transcodeJobETag(transcodeJob)
+		if request.Params.IfNoneMatch != nil {
+			baseline = *request.Params.IfNoneMatch
+		}
+		if etagMatches(baseline, transcodeJobETag(transcodeJob)) {
+			transcodeJob, err = s.waitForChange(ctx, request.Uuid, transcodeJob, baseline, timeout)
+		}
+	}
+	if errors.Is(err, errJobNotFound) {
+		return vtrest.GetTranscodeStatus404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("Transcode job with UUID %s not found", request.Uuid),
+		}, nil
+	} else if err != nil {
+		return vtrest.GetTranscodeStatus500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+
+	etag := transcodeJobETag(transcodeJob)
+	if request.Params.IfNoneMatch != nil && etagMatches(*request.Params.IfNoneMatch, etag) {
+		return vtrest.GetTranscodeStatus304Response{
+			Headers: vtrest.GetTranscodeStatus304ResponseHeaders{ETag: etag},
+		}, nil
+	}
+	return vtrest.GetTranscodeStatus200JSONResponse{
+		Body:    *transcodeJob,
+		Headers: vtrest.GetTranscodeStatus200ResponseHeaders{ETag: etag},
+	}, nil
+}
+
+// waitForChange re-reads a job until it no longer matches the baseline ETag, the
+// timeout passes, or the client goes away, and returns the latest version.
+func (s *Server) waitForChange(ctx context.Context, id uuid.UUID, job *vtrest.TranscodeJob, baseline string, timeout time.Duration) (*vtrest.TranscodeJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(waitPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return job, nil
+		case <-ticker.C:
+		}
+		row, err := s.readJob(ctx, id)
+		if ctx.Err() != nil {
+			// Timed out mid-query; the last version read is still current enough
+			return job, nil
+		} else if err != nil {
+			return nil, err
+		}
+		latest, err := transcodeJobFromRiver(row)
+		if err != nil {
+			return nil, err
+		}
+		if !etagMatches(baseline, transcodeJobETag(latest)) {
+			return latest, nil
+		}
+		job = latest
+	}
+}
+
+// transcodeJobETag returns a strong ETag identifying a job's status, progress, and
+// update time, which together change whenever a poller would care.
+func transcodeJobETag(job *vtrest.TranscodeJob) string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s\n%g\n%d", job.Status, job.Progress, job.UpdatedAt.UnixNano()))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches reports whether an If-None-Match header value matches etag.  The header
+// may list several ETags, or be "*" to match any version.
+func etagMatches(header, etag string) bool {
+	for candidate := range strings.SplitSeq(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// transcodeJobFromRiver builds the API representation of a transcode job from its River job.
+func transcodeJobFromRiver(job *rivertype.JobRow) (*vtrest.TranscodeJob, error) {
+	// Parse job args for source/destination paths
+	var jobArgs internal.TranscodeJobArgs
+	if err := json.Unmarshal(job.EncodedArgs, &jobArgs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal job args: %w", err)
+	}
+
+	// Parse job output for progress/error if present
+	var jobStatus internal.TranscodeJobStatus
+	jobOutput := job.Output()
+	if len(jobOutput) > 0 {
+		if err := json.Unmarshal(jobOutput, &jobStatus); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal job output: %w", err)
+		}
+	}
+
+	// Map River state to TranscodeStatus
+	status := mapRiverStateToTranscodeStatus(job.State)
+
+	// Use job error if status is failed and no output error
+	var jobError *string
+	if jobStatus.Error != nil {
+		jobError = jobStatus.Error
+	} else if status == vtrest.Failed && len(job.Errors) > 0 {
+		lastError := job.Errors[len(job.Errors)-1].Error
+		jobError = &lastError
+	}
+
+	var labels *vtrest.Labels
+	if len(jobArgs.Labels) > 0 {
+		labels = (*vtrest.Labels)(&jobArgs.Labels)
+	}
+
+	var toolVersions *vtrest.ToolVersions
+	if len(jobStatus.ToolVersions) > 0 {
+		toolVersions = (*vtrest.ToolVersions)(&jobStatus.ToolVersions)
+	}
+
+	var skippedExisting *bool
+	if jobStatus.SkippedExisting {
+		skippedExisting = &jobStatus.SkippedExisting
+	}
+
+	var partial *bool
+	if jobStatus.Partial {
+		partial = &jobStatus.Partial
+	}
+
+	var sourceSHA256 *string
+	if jobStatus.SourceSHA256 != "" {
+		sourceSHA256 = &jobStatus.SourceSHA256
+	}
+
+	var groupID *string
+	if jobArgs.GroupID != "" {
+		groupID = &jobArgs.GroupID
+	}
+
+	finalTime := job.CreatedAt
+	if job.FinalizedAt != nil {
+		finalTime = *job.FinalizedAt
+	}
+	return &vtrest.TranscodeJob{
+		Uuid:                      jobArgs.UUID,
+		Status:                    status,
+		SourcePath:                jobArgs.SourcePath,
+		DestinationPath:           jobArgs.DestinationPath,
+		Profile:                   string(jobArgs.Profile),
+		Priority:                  &job.Priority,
+		Progress:                  jobStatus.Progress,
+		Speed:                     jobStatus.Speed,
+		EstimatedSecondsRemaining: jobStatus.EstimatedSecondsRemaining,
+		Frame:                     jobStatus.Frame,
+		Fps:                       jobStatus.FPS,
+		BitrateKbps:               jobStatus.BitrateKbps,
+		PositionSeconds:           jobStatus.PositionSeconds,
+		PausedAt:                  jobStatus.PausedAt,
+		Error:                     jobError,
+		ErrorCode:                 (*vtrest.ErrorCode)(jobStatus.ErrorCode),
+		Renditions:                internal.RestRenditionStatuses(jobStatus.Renditions),
+		ToolVersions:              toolVersions,
+		Commands:                  jobStatus.Commands,
+		SourceSha256:              sourceSHA256,
+		ReusedFrom:                jobStatus.ReusedFrom,
+		SkippedExisting:           skippedExisting,
+		Partial:                   partial,
+		Labels:                    labels,
+		GroupId:                   groupID,
+		CreatedAt:                 job.CreatedAt.UTC(),
+		UpdatedAt:                 finalTime.UTC(),
+	}, nil
+}
+
+// errJobNotFound is returned by lookupJob when no job exists for a UUID.
+var errJobNotFound = errors.New("transcode job not found")
+
+// lookupJob returns the River job backing the transcode job with the given UUID, read
+// from the primary for requests that act on the job.
+func (s *Server) lookupJob(ctx context.Context, id uuid.UUID) (*rivertype.JobRow, error) {
+	return findJob(ctx, s.riverClient, id)
+}
+
+// readJob is lookupJob for requests that only report the job, which read it from the
+// replica if there is one.  A job the replica hasn't caught up with yet is read from
+// the primary, so clients can poll a job as soon as they have submitted it.
+func (s *Server) readJob(ctx context.Context, id uuid.UUID) (*rivertype.JobRow, error) {
+	job, err := findJob(ctx, s.readClient, id)
+	if errors.Is(err, errJobNotFound) && s.readClient != s.riverClient {
+		return s.lookupJob(ctx, id)
+	}
+	return job, err
+}
+
+func findJob(ctx context.Context, client *river.Client[pgx.Tx], id uuid.UUID) (*rivertype.JobRow, error) {
+	params := river.NewJobListParams().
+		Kinds(internal.TranscodeJobArgs{}.Kind()).
+		States(rivertype.JobStates()...).
+		Where("args->>'uuid' = @uuid", river.NamedArgs{"uuid": id.String()}).
+		First(1)
+	result, err := client.JobList(ctx, scopeToTenant(ctx, params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up river job: %w", err)
+	}
+	if len(result.Jobs) == 0 {
+		return nil, errJobNotFound
+	}
+	return result.Jobs[0], nil
+}
+
+// mapRiverStateToTranscodeStatus converts River job state to API TranscodeStatus.
+func mapRiverStateToTranscodeStatus(state rivertype.JobState) vtrest.TranscodeStatus {
+	switch state {
+	case rivertype.JobStateAvailable, rivertype.JobStateScheduled, rivertype.JobStateRetryable, rivertype.JobStatePending:
+		return vtrest.Pending
+	case rivertype.JobStateRunning:
+		return vtrest.Running
+	case rivertype.JobStateCompleted:
+		return vtrest.Completed
+	case rivertype.JobStateDiscarded, rivertype.JobStateCancelled:
+		return vtrest.Failed
+	default:
+		return vtrest.Pending
+	}
+}
@@ -0,0 +1,46 @@
+package vtserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+	"github.com/krelinga/video-transcoder/internal"
+)
+
+func TestServerReload(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	s := &Server{}
+	exam.Nil(e, env, s.Reload(&internal.ServerConfig{DownloadURLTTL: time.Hour})).Must()
+	first := s.settings.Load()
+	exam.Equal(e, env, 0, len(first.allowedPaths))
+
+	// Requests started afterwards see the new settings, and download URLs stay valid
+	cfg := &internal.ServerConfig{
+		DownloadURLTTL:       time.Minute,
+		AllowedPaths:         []string{"/media"},
+		BlockPrivateWebhooks: true,
+		AdminKeys:            []string{"admin-key"},
+	}
+	exam.Nil(e, env, s.Reload(cfg)).Must()
+	second := s.settings.Load()
+	exam.Equal(e, env, []string{"/media"}, second.allowedPaths)
+	exam.Equal(e, env, true, second.blockPrivateWebhooks)
+	exam.Equal(e, env, true, second.adminKeys[internal.HashAPIKey("admin-key")])
+	exam.Equal(e, env, time.Minute, second.downloads.ttl)
+	exam.Equal(e, env, string(first.downloads.key), string(second.downloads.key))
+
+	// A download key replaces the one URLs were signed with
+	cfg.DownloadKey = "secret"
+	exam.Nil(e, env, s.Reload(cfg)).Must()
+	exam.Equal(e, env, "secret", string(s.settings.Load().downloads.key))
+
+	// Invalid settings are rejected, keeping the previous ones
+	third := s.settings.Load()
+	err := s.Reload(&internal.ServerConfig{DownloadURLTTL: time.Hour, SecretsKeys: []string{"not a key"}})
+	exam.NotNil(e, env, err)
+	exam.Equal(e, env, true, third == s.settings.Load())
+}
@@ -0,0 +1,135 @@
+package vtserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+)
+
+type tenantContextKey struct{}
+
+// withTenant returns a copy of ctx carrying the tenant a request was authenticated as.
+func withTenant(ctx context.Context, tenant *internal.Tenant) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, tenant)
+}
+
+// tenantFromContext returns the tenant a request was authenticated as, or nil if the
+// server has no tenants or the request didn't need authenticating.
+func tenantFromContext(ctx context.Context) *internal.Tenant {
+	tenant, _ := ctx.Value(tenantContextKey{}).(*internal.Tenant)
+	return tenant
+}
+
+// tenantName returns the name recorded in the jobs created by a request.
+func tenantName(ctx context.Context) string {
+	if tenant := tenantFromContext(ctx); tenant != nil {
+		return tenant.Name
+	}
+	return ""
+}
+
+type adminContextKey struct{}
+
+// isAdmin reports whether a request was authenticated with an admin key.
+func isAdmin(ctx context.Context) bool {
+	admin, _ := ctx.Value(adminContextKey{}).(bool)
+	return admin
+}
+
+// tenantMiddleware authenticates requests by their bearer API key when the server has
+// tenants, and makes the key's tenant available through the request context.  Keys
+// are looked up in the tenants file first, then among the API tokens in the database.
+// Admin keys are accepted whether or not the server has tenants, and aren't scoped to
+// any tenant.  Well-known paths and readiness checks are public, and download URLs
+// carry their own signature.
+func (s *Server) tenantMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/.well-known/") || r.URL.Path == readyzPath || r.URL.Path == vtrest.BasePath+readyzPath || strings.HasSuffix(r.URL.Path, "/output/download") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		settings := s.settings.Load()
+		key, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		hash := internal.HashAPIKey(key)
+		if ok && settings.adminKeys[hash] {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), adminContextKey{}, true)))
+			return
+		}
+		if len(settings.tenantsByName) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		tenant := settings.tenants[hash]
+		if ok && tenant == nil {
+			name, err := internal.LookupAPIToken(r.Context(), s.pool, hash)
+			if err != nil && !errors.Is(err, internal.ErrAPITokenNotFound) {
+				writeProblem(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+				return
+			}
+			// Tokens of tenants since removed from the tenants file are ignored
+			tenant = settings.tenantsByName[name]
+		}
+		if !ok || tenant == nil {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeProblem(w, http.StatusUnauthorized, "UNAUTHORIZED", "A valid API key is required")
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(withTenant(r.Context(), tenant)))
+	})
+}
+
+// tenantsByName indexes tenants by name; it returns nil if there are none.
+func tenantsByName(tenants []internal.Tenant) map[string]*internal.Tenant {
+	if len(tenants) == 0 {
+		return nil
+	}
+	byName := make(map[string]*internal.Tenant, len(tenants))
+	for i := range tenants {
+		byName[tenants[i].Name] = &tenants[i]
+	}
+	return byName
+}
+
+// adminKeys returns the set of hashes of keys, as returned by internal.HashAPIKey.
+func adminKeys(keys []string) map[string]bool {
+	hashes := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		hashes[internal.HashAPIKey(key)] = true
+	}
+	return hashes
+}
+
+// scopeToTenant restricts params to the jobs of the request's tenant, if it has one.
+func scopeToTenant(ctx context.Context, params *river.JobListParams) *river.JobListParams {
+	tenant := tenantFromContext(ctx)
+	if tenant == nil {
+		return params
+	}
+	return params.Where("coalesce(args->>'tenant', '') = @tenant", river.NamedArgs{"tenant": tenant.Name})
+}
+
+// checkQuota returns a problem if submitting that many more transcode jobs would take
+// the request's tenant over its cap on active jobs.
+func (s *Server) checkQuota(ctx context.Context, jobs int) (*vtrest.Error, error) {
+	tenant := tenantFromContext(ctx)
+	if tenant == nil || tenant.MaxActiveJobs == 0 || jobs == 0 {
+		return nil, nil
+	}
+	active, err := internal.CountActiveJobs(ctx, s.pool, tenant.Name)
+	if err != nil {
+		return nil, err
+	}
+	if active+jobs > tenant.MaxActiveJobs {
+		return &vtrest.Error{
+			Code:    "QUOTA_EXCEEDED",
+			Message: fmt.Sprintf("Tenant %s has %d of at most %d active jobs, so %d more can't be submitted", tenant.Name, active, tenant.MaxActiveJobs, jobs),
+		}, nil
+	}
+	return nil, nil
+}
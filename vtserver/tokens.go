@@ -0,0 +1,127 @@
+package vtserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+var errAdminRequired = vtrest.Error{
+	Code:    "FORBIDDEN",
+	Message: "An admin key is required",
+}
+
+func apiTokenFromInternal(token *internal.APIToken) vtrest.ApiToken {
+	return vtrest.ApiToken{
+		Id:        token.ID,
+		Tenant:    token.Tenant,
+		Name:      token.Name,
+		CreatedAt: token.CreatedAt,
+		RotatedAt: token.RotatedAt,
+		RevokedAt: token.RevokedAt,
+	}
+}
+
+// ListApiTokens handles GET /admin/tokens requests.
+func (s *Server) ListApiTokens(ctx context.Context, request vtrest.ListApiTokensRequestObject) (vtrest.ListApiTokensResponseObject, error) {
+	if !isAdmin(ctx) {
+		return vtrest.ListApiTokens403ApplicationProblemPlusJSONResponse(errAdminRequired), nil
+	}
+	var tenant string
+	if request.Params.Tenant != nil {
+		tenant = *request.Params.Tenant
+	}
+	tokens, err := internal.ListAPITokens(ctx, s.pool, tenant)
+	if err != nil {
+		return vtrest.ListApiTokens500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	list := vtrest.ApiTokenList{Tokens: make([]vtrest.ApiToken, len(tokens))}
+	for i := range tokens {
+		list.Tokens[i] = apiTokenFromInternal(&tokens[i])
+	}
+	return vtrest.ListApiTokens200JSONResponse(list), nil
+}
+
+// CreateApiToken handles POST /admin/tokens requests.
+func (s *Server) CreateApiToken(ctx context.Context, request vtrest.CreateApiTokenRequestObject) (vtrest.CreateApiTokenResponseObject, error) {
+	if !isAdmin(ctx) {
+		return vtrest.CreateApiToken403ApplicationProblemPlusJSONResponse(errAdminRequired), nil
+	}
+	if request.Body == nil {
+		return vtrest.CreateApiToken400ApplicationProblemPlusJSONResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "Request body is required",
+		}, nil
+	}
+	if s.settings.Load().tenantsByName[request.Body.Tenant] == nil {
+		return vtrest.CreateApiToken400ApplicationProblemPlusJSONResponse{
+			Code:    "UNKNOWN_TENANT",
+			Message: fmt.Sprintf("Tenant %q isn't defined in the tenants file", request.Body.Tenant),
+		}, nil
+	}
+	var name string
+	if request.Body.Name != nil {
+		name = *request.Body.Name
+	}
+
+	token, key, err := internal.CreateAPIToken(ctx, s.pool, request.Body.Tenant, name)
+	if err != nil {
+		return vtrest.CreateApiToken500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	return vtrest.CreateApiToken201JSONResponse{
+		Token: apiTokenFromInternal(token),
+		Key:   key,
+	}, nil
+}
+
+// RotateApiToken handles POST /admin/tokens/{id}/rotate requests.
+func (s *Server) RotateApiToken(ctx context.Context, request vtrest.RotateApiTokenRequestObject) (vtrest.RotateApiTokenResponseObject, error) {
+	if !isAdmin(ctx) {
+		return vtrest.RotateApiToken403ApplicationProblemPlusJSONResponse(errAdminRequired), nil
+	}
+	token, key, err := internal.RotateAPIToken(ctx, s.pool, request.Id)
+	if errors.Is(err, internal.ErrAPITokenNotFound) {
+		return vtrest.RotateApiToken404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("API token %s not found or revoked", request.Id),
+		}, nil
+	} else if err != nil {
+		return vtrest.RotateApiToken500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	return vtrest.RotateApiToken200JSONResponse{
+		Token: apiTokenFromInternal(token),
+		Key:   key,
+	}, nil
+}
+
+// RevokeApiToken handles DELETE /admin/tokens/{id} requests.
+func (s *Server) RevokeApiToken(ctx context.Context, request vtrest.RevokeApiTokenRequestObject) (vtrest.RevokeApiTokenResponseObject, error) {
+	if !isAdmin(ctx) {
+		return vtrest.RevokeApiToken403ApplicationProblemPlusJSONResponse(errAdminRequired), nil
+	}
+	token, err := internal.RevokeAPIToken(ctx, s.pool, request.Id)
+	if errors.Is(err, internal.ErrAPITokenNotFound) {
+		return vtrest.RevokeApiToken404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("API token %s not found or already revoked", request.Id),
+		}, nil
+	} else if err != nil {
+		return vtrest.RevokeApiToken500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	return vtrest.RevokeApiToken200JSONResponse(apiTokenFromInternal(token)), nil
+}
@@ -0,0 +1,136 @@
+package vtserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// UpdateTranscode handles PATCH /transcodes/{uuid} requests.  The job is validated
+// again with the changes applied, as a request to create it would be.
+func (s *Server) UpdateTranscode(ctx context.Context, request vtrest.UpdateTranscodeRequestObject) (vtrest.UpdateTranscodeResponseObject, error) {
+	if request.Body == nil {
+		return vtrest.UpdateTranscode400ApplicationProblemPlusJSONResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "Request body is required",
+		}, nil
+	}
+	priority := 0
+	if request.Body.Priority != nil {
+		priority = *request.Body.Priority
+		if priority < internal.MinJobPriority || priority > internal.MaxJobPriority {
+			return vtrest.UpdateTranscode400ApplicationProblemPlusJSONResponse(validationProblem([]vtrest.FieldError{{
+				Field:   fieldPointer("/priority"),
+				Code:    "INVALID_PRIORITY",
+				Message: fmt.Sprintf("priority must be between %d and %d", internal.MinJobPriority, internal.MaxJobPriority),
+			}})), nil
+		}
+	}
+
+	job, err := s.lookupJob(ctx, request.Uuid)
+	if errors.Is(err, errJobNotFound) {
+		return vtrest.UpdateTranscode404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("Transcode job with UUID %s not found", request.Uuid),
+		}, nil
+	} else if err != nil {
+		return vtrest.UpdateTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	if !internal.JobPending(job) {
+		return vtrest.UpdateTranscode409ApplicationProblemPlusJSONResponse{
+			Code:    "JOB_STARTED",
+			Message: fmt.Sprintf("Transcode job with UUID %s has already been started", request.Uuid),
+		}, nil
+	}
+
+	var args internal.TranscodeJobArgs
+	if err := json.Unmarshal(job.EncodedArgs, &args); err != nil {
+		return vtrest.UpdateTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to unmarshal job args: %v", err),
+		}, nil
+	}
+	// Webhook tokens are validated along with the rest of the job, and sealed again below
+	secrets := s.settings.Load().secrets
+	if err := args.OpenSecrets(ctx, secrets); err != nil {
+		return vtrest.UpdateTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+
+	body := transcodeRequestFromArgs(args)
+	if request.Body.DestinationPath != nil {
+		body.DestinationPath = *request.Body.DestinationPath
+	}
+	if request.Body.Profile != nil {
+		body.Profile = *request.Body.Profile
+	}
+	if request.Body.Webhooks != nil {
+		body.WebhookUri, body.WebhookToken, body.WebhookTokenHeader = nil, nil, nil
+		body.Webhooks = request.Body.Webhooks
+	}
+	if problems := s.validateTranscodeRequest(ctx, &body); len(problems) > 0 {
+		return vtrest.UpdateTranscode400ApplicationProblemPlusJSONResponse(validationProblem(problems)), nil
+	}
+
+	// Only the patched fields are taken from the request, so the job keeps its tenant,
+	// group, and workflow
+	updated := transcodeJobArgs(ctx, &body)
+	args.DestinationPath = updated.DestinationPath
+	args.Profile = updated.Profile
+	args.WebhookURI, args.WebhookToken, args.WebhookTokenHeader = updated.WebhookURI, updated.WebhookToken, updated.WebhookTokenHeader
+	args.Webhooks = updated.Webhooks
+	if err := args.SealSecrets(ctx, secrets); err != nil {
+		return vtrest.UpdateTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return vtrest.UpdateTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to marshal job args: %v", err),
+		}, nil
+	}
+
+	// The update only applies if the job is still as it was read, so a worker that has
+	// just started it, or a concurrent update, isn't overwritten
+	ok, err := internal.UpdatePendingJob(ctx, s.pool, job.ID, job.EncodedArgs, encoded, priority)
+	if err != nil {
+		return vtrest.UpdateTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	if !ok {
+		return vtrest.UpdateTranscode409ApplicationProblemPlusJSONResponse{
+			Code:    "JOB_STARTED",
+			Message: fmt.Sprintf("Transcode job with UUID %s was started or changed while it was being updated", request.Uuid),
+		}, nil
+	}
+
+	job, err = s.lookupJob(ctx, request.Uuid)
+	if err != nil {
+		return vtrest.UpdateTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	transcodeJob, err := transcodeJobFromRiver(job)
+	if err != nil {
+		return vtrest.UpdateTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	return vtrest.UpdateTranscode200JSONResponse(*transcodeJob), nil
+}
@@ -0,0 +1,843 @@
+package vtserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+const (
+	minHeartbeatIntervalSeconds = 1
+	maxHeartbeatIntervalSeconds = 3600
+	// maxPathLength matches Linux's PATH_MAX.
+	maxPathLength = 4096
+	// maxWebhookURILength is the longest webhook URI accepted.
+	maxWebhookURILength = 2048
+	// maxWebhookTokenBytes bounds the opaque tokens echoed back in webhook payloads.
+	maxWebhookTokenBytes = 1024
+	// webhookLookupTimeout bounds the DNS lookup of a webhook host when private
+	// webhooks are blocked.
+	webhookLookupTimeout = 2 * time.Second
+)
+
+// webhookTokenHeaderPattern matches the header names webhook tokens may be sent in.
+var webhookTokenHeaderPattern = regexp.MustCompile(`^[A-Za-z0-9-]{1,64}$`)
+
+// reservedWebhookHeaders are set by the worker on every webhook request, so tokens
+// can't be sent in them.
+var reservedWebhookHeaders = []string{"Connection", "Content-Length", "Content-Type", "Host", "Transfer-Encoding", internal.RequestIDHeader}
+
+// languageCodePattern matches ISO 639-2 language codes.
+var languageCodePattern = regexp.MustCompile(`^[a-z]{3}$`)
+
+// groupIDPattern matches job group IDs, which appear in URL paths.
+var groupIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// renditionNamePattern matches rendition names, which become part of a file name.
+var renditionNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+// validateTranscodeRequest runs the checks on a transcode request that don't need the database.
+// It returns every problem found, in the order createTranscode reports them.
+func (s *Server) validateTranscodeRequest(ctx context.Context, body *vtrest.TranscodeRequest) []vtrest.FieldError {
+	var problems []vtrest.FieldError
+
+	profile := internal.Profile(body.Profile)
+	if !profile.IsValid() {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/profile"),
+			Code:    "INVALID_PROFILE",
+			Message: fmt.Sprintf("Invalid profile: %q", body.Profile),
+		})
+	} else if tenant := tenantFromContext(ctx); tenant != nil && !tenant.ProfileAllowed(profile) {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/profile"),
+			Code:    "PROFILE_NOT_ALLOWED",
+			Message: fmt.Sprintf("Tenant %s may not use profile %q", tenant.Name, body.Profile),
+		})
+	}
+
+	for _, path := range []struct{ field, path string }{{"/sourcePath", body.SourcePath}, {"/destinationPath", body.DestinationPath}} {
+		if problem := validatePath(path.field, path.path); problem != nil {
+			problems = append(problems, *problem)
+		} else if !s.pathAllowed(ctx, path.path) {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("%s", path.field),
+				Code:    "PATH_NOT_ALLOWED",
+				Message: fmt.Sprintf("Path %q is not inside an allowed directory", path.path),
+			})
+		}
+	}
+
+	for _, uri := range []struct {
+		field string
+		uri   *string
+	}{{"/webhookUri", body.WebhookUri}, {"/heartbeatWebhookUri", body.HeartbeatWebhookUri}} {
+		if uri.uri == nil {
+			continue
+		}
+		if problem := s.validateWebhookURI(ctx, uri.field, *uri.uri); problem != nil {
+			problems = append(problems, *problem)
+		}
+	}
+	if problem := validateWebhookToken("/webhookToken", body.WebhookToken); problem != nil {
+		problems = append(problems, *problem)
+	}
+	if problem := validateWebhookTokenHeader("/webhookTokenHeader", body.WebhookTokenHeader, body.WebhookToken); problem != nil {
+		problems = append(problems, *problem)
+	}
+
+	if body.HeartbeatIntervalSeconds != nil && (*body.HeartbeatIntervalSeconds < minHeartbeatIntervalSeconds || *body.HeartbeatIntervalSeconds > maxHeartbeatIntervalSeconds) {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/heartbeatIntervalSeconds"),
+			Code:    "INVALID_HEARTBEAT_INTERVAL",
+			Message: fmt.Sprintf("heartbeatIntervalSeconds must be between %d and %d", minHeartbeatIntervalSeconds, maxHeartbeatIntervalSeconds),
+		})
+	}
+
+	if body.ParallelSegments != nil && (*body.ParallelSegments < 1 || *body.ParallelSegments > internal.MaxParallelSegments) {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/parallelSegments"),
+			Code:    "INVALID_PARALLEL_SEGMENTS",
+			Message: fmt.Sprintf("parallelSegments must be between 1 and %d", internal.MaxParallelSegments),
+		})
+	} else if body.ParallelSegments != nil && *body.ParallelSegments > 1 && !profile.SupportsParallelSegments() {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/parallelSegments"),
+			Code:    "INVALID_PARALLEL_SEGMENTS",
+			Message: fmt.Sprintf("Profile %q does not support parallel segments", body.Profile),
+		})
+	}
+
+	if body.SkipIfValid != nil && *body.SkipIfValid && !profile.SupportsSkipIfValid() {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/skipIfValid"),
+			Code:    "INVALID_SKIP_IF_VALID",
+			Message: fmt.Sprintf("Profile %q does not support skipIfValid", body.Profile),
+		})
+	}
+
+	if body.Compare != nil && !profile.SupportsCompare() {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/compare"),
+			Code:    "INVALID_COMPARE",
+			Message: fmt.Sprintf("Profile %q does not support compare", body.Profile),
+		})
+	}
+
+	if output := body.Output; output != nil {
+		for _, id := range []struct {
+			name  string
+			value *int
+		}{{"uid", output.Uid}, {"gid", output.Gid}} {
+			if id.value != nil && (*id.value < 0 || *id.value > internal.MaxOutputID) {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/output/%s", id.name),
+					Code:    "INVALID_OUTPUT_OWNER",
+					Message: fmt.Sprintf("%s must be between 0 and %d", id.name, internal.MaxOutputID),
+				})
+			}
+		}
+		if output.Mode != nil {
+			if _, err := internal.ParseFileMode(*output.Mode); err != nil {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/output/mode"),
+					Code:    "INVALID_FILE_MODE",
+					Message: err.Error(),
+				})
+			}
+		}
+	}
+
+	if audio := body.Audio; audio != nil {
+		codec := internal.AudioCodec(audio.Codec)
+		if !codec.IsValid() {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/audio/codec"),
+				Code:    "INVALID_AUDIO",
+				Message: fmt.Sprintf("Invalid audio codec: %q", audio.Codec),
+			})
+		} else if !profile.SupportsAudioCodec(codec) {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/audio/codec"),
+				Code:    "INVALID_AUDIO",
+				Message: fmt.Sprintf("Profile %q does not support audio codec %q", body.Profile, audio.Codec),
+			})
+		}
+		if audio.Layout != nil && !internal.AudioLayout(*audio.Layout).IsValid() {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/audio/layout"),
+				Code:    "INVALID_AUDIO",
+				Message: fmt.Sprintf("Invalid audio layout: %q", *audio.Layout),
+			})
+		}
+		if audio.Downmix != nil {
+			stereoTrack := audio.StereoTrack != nil && *audio.StereoTrack
+			stereoLayout := audio.Layout != nil && internal.AudioLayout(*audio.Layout) == internal.AudioLayoutStereo
+			if !internal.AudioDownmix(*audio.Downmix).IsValid() {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/audio/downmix"),
+					Code:    "INVALID_AUDIO",
+					Message: fmt.Sprintf("Invalid audio downmix: %q", *audio.Downmix),
+				})
+			} else if !stereoTrack && !stereoLayout {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/audio/downmix"),
+					Code:    "INVALID_AUDIO",
+					Message: "audio.downmix requires a stereo layout or stereoTrack",
+				})
+			}
+		}
+		if audio.BitrateKbps != nil && (*audio.BitrateKbps < internal.MinAudioBitrateKbps || *audio.BitrateKbps > internal.MaxAudioBitrateKbps) {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/audio/bitrateKbps"),
+				Code:    "INVALID_AUDIO",
+				Message: fmt.Sprintf("audio.bitrateKbps must be between %d and %d", internal.MinAudioBitrateKbps, internal.MaxAudioBitrateKbps),
+			})
+		}
+	}
+
+	if video := body.Video; video != nil {
+		if video.Denoise != nil && !internal.DenoiseFilter(*video.Denoise).IsValid() {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/video/denoise"),
+				Code:    "INVALID_VIDEO",
+				Message: fmt.Sprintf("Invalid denoise filter: %q", *video.Denoise),
+			})
+		}
+		if video.DenoiseLevel != nil {
+			if !internal.DenoiseLevel(*video.DenoiseLevel).IsValid() {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/video/denoiseLevel"),
+					Code:    "INVALID_VIDEO",
+					Message: fmt.Sprintf("Invalid denoise level: %q", *video.DenoiseLevel),
+				})
+			} else if video.Denoise == nil {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/video/denoiseLevel"),
+					Code:    "INVALID_VIDEO",
+					Message: "video.denoiseLevel requires video.denoise",
+				})
+			}
+		}
+		if perTitle := video.PerTitle; perTitle != nil {
+			minCRF, maxCRF := internal.DefaultPerTitleMinCRF, internal.DefaultPerTitleMaxCRF
+			if perTitle.MinCrf != nil {
+				minCRF = *perTitle.MinCrf
+			}
+			if perTitle.MaxCrf != nil {
+				maxCRF = *perTitle.MaxCrf
+			}
+			if !profile.SupportsPerTitle() {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/video/perTitle"),
+					Code:    "INVALID_VIDEO",
+					Message: fmt.Sprintf("Profile %q does not support per-title encoding", body.Profile),
+				})
+			}
+			if minCRF < 0 || maxCRF > internal.MaxPerTitleCRF || minCRF > maxCRF {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/video/perTitle"),
+					Code:    "INVALID_VIDEO",
+					Message: fmt.Sprintf("video.perTitle needs 0 <= minCrf <= maxCrf <= %d", internal.MaxPerTitleCRF),
+				})
+			}
+		}
+		if video.GrainTune != nil && *video.GrainTune && !profile.SupportsGrainTune() {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/video/grainTune"),
+				Code:    "INVALID_VIDEO",
+				Message: fmt.Sprintf("Profile %q does not support grain tuning", body.Profile),
+			})
+		}
+	}
+
+	if streams := body.Streams; streams != nil {
+		if streams.Video != nil && (*streams.Video < 0 || *streams.Video > 0 && !profile.SupportsVideoSelection()) {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/streams/video"),
+				Code:    "INVALID_STREAMS",
+				Message: fmt.Sprintf("Profile %q does not support video stream %d", body.Profile, *streams.Video),
+			})
+		}
+		for _, list := range []struct {
+			name    string
+			indexes *[]int
+		}{{"audio", streams.Audio}, {"subtitles", streams.Subtitles}} {
+			if list.indexes == nil {
+				continue
+			}
+			if problem := validateStreamIndexes(list.name, *list.indexes); problem != nil {
+				problems = append(problems, *problem)
+			}
+		}
+		if streams.Audio != nil && body.Audio != nil && body.Audio.StereoTrack != nil && *body.Audio.StereoTrack {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/streams/audio"),
+				Code:    "INVALID_STREAMS",
+				Message: "streams.audio cannot be combined with audio.stereoTrack",
+			})
+		}
+	}
+
+	if profile == internal.ProfileAnimated && !slices.Contains(internal.AnimationExtensions, strings.ToLower(filepath.Ext(body.DestinationPath))) {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/destinationPath"),
+			Code:    "INVALID_ANIMATION",
+			Message: "The animated profile's destinationPath must be a .gif or .webp file",
+		})
+	}
+	if profile == internal.ProfileABR {
+		if !slices.Contains(internal.ABRExtensions, strings.ToLower(filepath.Ext(body.DestinationPath))) {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/destinationPath"),
+				Code:    "INVALID_RENDITIONS",
+				Message: "The abr profile's destinationPath must be an .m3u8 HLS playlist or an .mpd DASH manifest",
+			})
+		}
+		if body.Audio != nil && body.Audio.StereoTrack != nil && *body.Audio.StereoTrack {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/audio/stereoTrack"),
+				Code:    "INVALID_AUDIO",
+				Message: "The abr profile does not support audio.stereoTrack",
+			})
+		}
+	}
+	if animation := body.Animation; animation != nil {
+		if profile != internal.ProfileAnimated {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/animation"),
+				Code:    "INVALID_ANIMATION",
+				Message: "animation is only supported by the animated profile",
+			})
+		}
+		if animation.StartSeconds != nil && *animation.StartSeconds < 0 {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/animation/startSeconds"),
+				Code:    "INVALID_ANIMATION",
+				Message: "animation.startSeconds must not be negative",
+			})
+		}
+		if animation.DurationSeconds != nil && (*animation.DurationSeconds <= 0 || *animation.DurationSeconds > internal.MaxAnimationDurationSeconds) {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/animation/durationSeconds"),
+				Code:    "INVALID_ANIMATION",
+				Message: fmt.Sprintf("animation.durationSeconds must be greater than 0 and at most %d", internal.MaxAnimationDurationSeconds),
+			})
+		}
+		if animation.Width != nil && (*animation.Width < internal.MinAnimationWidth || *animation.Width > internal.MaxAnimationWidth) {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/animation/width"),
+				Code:    "INVALID_ANIMATION",
+				Message: fmt.Sprintf("animation.width must be between %d and %d", internal.MinAnimationWidth, internal.MaxAnimationWidth),
+			})
+		}
+	}
+
+	if handbrake := body.Handbrake; handbrake != nil {
+		problems = append(problems, validateHandBrakeOptions(profile, body, handbrake)...)
+	}
+
+	if chapters := body.Chapters; chapters != nil {
+		if chapters.Generate != nil && *chapters.Generate && !profile.SupportsChapters() {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/chapters/generate"),
+				Code:    "INVALID_CHAPTERS",
+				Message: fmt.Sprintf("Profile %q does not support generated chapters", body.Profile),
+			})
+		}
+		if chapters.MinLengthSeconds != nil && (*chapters.MinLengthSeconds < internal.MinChapterLengthSeconds || *chapters.MinLengthSeconds > internal.MaxChapterLengthSeconds) {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/chapters/minLengthSeconds"),
+				Code:    "INVALID_CHAPTERS",
+				Message: fmt.Sprintf("chapters.minLengthSeconds must be between %d and %d", internal.MinChapterLengthSeconds, internal.MaxChapterLengthSeconds),
+			})
+		}
+	}
+
+	problems = append(problems, validateRenditions(profile, body.Renditions)...)
+
+	if body.Subtitles != nil {
+		if body.Subtitles.Captions != nil && !internal.CaptionMode(*body.Subtitles.Captions).IsValid() {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/subtitles/captions"),
+				Code:    "INVALID_SUBTITLES",
+				Message: fmt.Sprintf("Invalid caption mode: %q", *body.Subtitles.Captions),
+			})
+		}
+		if len(body.Subtitles.External) > internal.MaxExternalSubtitles {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/subtitles/external"),
+				Code:    "INVALID_SUBTITLES",
+				Message: fmt.Sprintf("At most %d external subtitle files are allowed", internal.MaxExternalSubtitles),
+			})
+		}
+		for i, sub := range body.Subtitles.External {
+			if problem := validatePath(fmt.Sprintf("/subtitles/external/%d/path", i), sub.Path); problem != nil {
+				problems = append(problems, *problem)
+			} else if !slices.Contains(internal.ExternalSubtitleExtensions, strings.ToLower(filepath.Ext(sub.Path))) || strings.Contains(sub.Path, ",") {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/subtitles/external/%d/path", i),
+					Code:    "INVALID_SUBTITLES",
+					Message: fmt.Sprintf("subtitles.external[%d].path must be an .srt, .ass, or .ssa file without commas", i),
+				})
+			} else if !s.pathAllowed(ctx, sub.Path) {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/subtitles/external/%d/path", i),
+					Code:    "PATH_NOT_ALLOWED",
+					Message: fmt.Sprintf("Path %q is not inside an allowed directory", sub.Path),
+				})
+			}
+			if sub.Language != nil && !languageCodePattern.MatchString(*sub.Language) {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/subtitles/external/%d/language", i),
+					Code:    "INVALID_SUBTITLES",
+					Message: fmt.Sprintf("subtitles.external[%d].language must be a three-letter ISO 639-2 code", i),
+				})
+			}
+		}
+	}
+
+	for i, target := range body.Webhooks {
+		if target.Uri == "" {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/webhooks/%d/uri", i),
+				Code:    "INVALID_WEBHOOK",
+				Message: fmt.Sprintf("webhooks[%d].uri must be non-empty", i),
+			})
+		} else if problem := s.validateWebhookURI(ctx, fmt.Sprintf("/webhooks/%d/uri", i), target.Uri); problem != nil {
+			problems = append(problems, *problem)
+		}
+		if problem := validateWebhookToken(fmt.Sprintf("/webhooks/%d/token", i), target.Token); problem != nil {
+			problems = append(problems, *problem)
+		}
+		if problem := validateWebhookTokenHeader(fmt.Sprintf("/webhooks/%d/tokenHeader", i), target.TokenHeader, target.Token); problem != nil {
+			problems = append(problems, *problem)
+		}
+		for _, event := range target.Events {
+			if !internal.WebhookEvent(event).IsValid() {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/webhooks/%d/events", i),
+					Code:    "INVALID_WEBHOOK",
+					Message: fmt.Sprintf("webhooks[%d] has invalid event %q", i, event),
+				})
+			}
+		}
+	}
+
+	if body.GroupId != nil && !groupIDPattern.MatchString(*body.GroupId) {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/groupId"),
+			Code:    "INVALID_GROUP",
+			Message: fmt.Sprintf("groupId %q must be 1 to 64 letters, digits, '.', '_' or '-'", *body.GroupId),
+		})
+	}
+
+	if body.Labels != nil {
+		for key := range *body.Labels {
+			if key == "" || strings.Contains(key, "=") {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/labels"),
+					Code:    "INVALID_LABEL",
+					Message: fmt.Sprintf("Label key %q must be non-empty and must not contain '='", key),
+				})
+			}
+		}
+	}
+
+	return problems
+}
+
+// validateHandBrakeOptions checks that the profile is encoded by HandBrake, that each
+// setting is on its allowlist, and that none conflicts with the rest of body.
+func validateHandBrakeOptions(profile internal.Profile, body *vtrest.TranscodeRequest, handbrake *vtrest.HandBrakeOptions) []vtrest.FieldError {
+	var problems []vtrest.FieldError
+	if !profile.SupportsHandBrakeOptions() {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/handbrake"),
+			Code:    "INVALID_HANDBRAKE",
+			Message: fmt.Sprintf("Profile %q is not encoded by HandBrake", body.Profile),
+		})
+	}
+	for _, setting := range []struct {
+		name    string
+		value   *string
+		allowed []string
+	}{
+		{"encoderPreset", handbrake.EncoderPreset, internal.HandBrakeEncoderPresets},
+		{"encoderTune", handbrake.EncoderTune, internal.HandBrakeEncoderTunes},
+		{"audioEncoder", handbrake.AudioEncoder, internal.HandBrakeAudioEncoders},
+	} {
+		if setting.value != nil && !slices.Contains(setting.allowed, *setting.value) {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/handbrake/%s", setting.name),
+				Code:    "INVALID_HANDBRAKE",
+				Message: fmt.Sprintf("handbrake.%s must be one of %s", setting.name, strings.Join(setting.allowed, ", ")),
+			})
+		}
+	}
+	if handbrake.EncoderTune != nil && body.Video != nil && body.Video.GrainTune != nil && *body.Video.GrainTune {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/handbrake/encoderTune"),
+			Code:    "INVALID_HANDBRAKE",
+			Message: "handbrake.encoderTune cannot be combined with video.grainTune",
+		})
+	}
+	if quality := handbrake.Quality; quality != nil {
+		if *quality < 0 || *quality > internal.MaxHandBrakeQuality {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/handbrake/quality"),
+				Code:    "INVALID_HANDBRAKE",
+				Message: fmt.Sprintf("handbrake.quality must be between 0 and %d", internal.MaxHandBrakeQuality),
+			})
+		}
+		if body.Video != nil && body.Video.PerTitle != nil {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/handbrake/quality"),
+				Code:    "INVALID_HANDBRAKE",
+				Message: "handbrake.quality cannot be combined with video.perTitle",
+			})
+		}
+	}
+	if handbrake.AudioEncoder != nil && body.Audio != nil {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/handbrake/audioEncoder"),
+			Code:    "INVALID_HANDBRAKE",
+			Message: "handbrake.audioEncoder cannot be combined with audio",
+		})
+	}
+	if handbrake.AllSubtitles != nil && *handbrake.AllSubtitles && body.Streams != nil && body.Streams.Subtitles != nil {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/handbrake/allSubtitles"),
+			Code:    "INVALID_HANDBRAKE",
+			Message: "handbrake.allSubtitles cannot be combined with streams.subtitles",
+		})
+	}
+	return problems
+}
+
+// validateRenditions checks the renditions requested for profile.
+func validateRenditions(profile internal.Profile, renditions []vtrest.Rendition) []vtrest.FieldError {
+	var problems []vtrest.FieldError
+	switch {
+	case profile == internal.ProfileRenditions && len(renditions) == 0:
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/renditions"),
+			Code:    "INVALID_RENDITIONS",
+			Message: "The renditions profile requires at least one rendition",
+		})
+	case profile != internal.ProfileRenditions && profile != internal.ProfileABR && len(renditions) > 0:
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/renditions"),
+			Code:    "INVALID_RENDITIONS",
+			Message: "renditions are only supported by the renditions and abr profiles",
+		})
+	case len(renditions) > internal.MaxRenditions:
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/renditions"),
+			Code:    "INVALID_RENDITIONS",
+			Message: fmt.Sprintf("At most %d renditions are allowed", internal.MaxRenditions),
+		})
+	}
+
+	names := make(map[string]bool, len(renditions))
+	for i, rendition := range renditions {
+		if !renditionNamePattern.MatchString(rendition.Name) {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/renditions/%d/name", i),
+				Code:    "INVALID_RENDITIONS",
+				Message: fmt.Sprintf("renditions[%d].name must be 1 to 32 letters, digits, underscores, or hyphens", i),
+			})
+		} else if names[rendition.Name] {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/renditions/%d/name", i),
+				Code:    "INVALID_RENDITIONS",
+				Message: fmt.Sprintf("renditions[%d].name %q is not unique", i, rendition.Name),
+			})
+		}
+		names[rendition.Name] = true
+		if rendition.Height < internal.MinRenditionHeight || rendition.Height > internal.MaxRenditionHeight || rendition.Height%2 != 0 {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/renditions/%d/height", i),
+				Code:    "INVALID_RENDITIONS",
+				Message: fmt.Sprintf("renditions[%d].height must be an even number between %d and %d", i, internal.MinRenditionHeight, internal.MaxRenditionHeight),
+			})
+		}
+		if bitrate := rendition.VideoBitrateKbps; bitrate != nil && (*bitrate < internal.MinRenditionBitrateKbps || *bitrate > internal.MaxRenditionBitrateKbps) {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/renditions/%d/videoBitrateKbps", i),
+				Code:    "INVALID_RENDITIONS",
+				Message: fmt.Sprintf("renditions[%d].videoBitrateKbps must be between %d and %d", i, internal.MinRenditionBitrateKbps, internal.MaxRenditionBitrateKbps),
+			})
+		} else if bitrate == nil && profile == internal.ProfileABR {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/renditions/%d/videoBitrateKbps", i),
+				Code:    "INVALID_RENDITIONS",
+				Message: fmt.Sprintf("renditions[%d].videoBitrateKbps is required by the abr profile", i),
+			})
+		}
+	}
+	return problems
+}
+
+// validateStreamIndexes checks the stream indexes selected for streams.name.
+func validateStreamIndexes(name string, indexes []int) *vtrest.FieldError {
+	if len(indexes) > internal.MaxSelectedStreams {
+		return &vtrest.FieldError{
+			Field:   fieldPointer("/streams/%s", name),
+			Code:    "INVALID_STREAMS",
+			Message: fmt.Sprintf("streams.%s may select at most %d streams", name, internal.MaxSelectedStreams),
+		}
+	}
+	seen := make(map[int]bool, len(indexes))
+	for _, index := range indexes {
+		if index < 0 || seen[index] {
+			return &vtrest.FieldError{
+				Field:   fieldPointer("/streams/%s", name),
+				Code:    "INVALID_STREAMS",
+				Message: fmt.Sprintf("streams.%s must be distinct non-negative stream indexes", name),
+			}
+		}
+		seen[index] = true
+	}
+	return nil
+}
+
+// validatePath checks that path is a usable absolute path.  It doesn't check that the
+// path is allowed; see pathAllowed.
+func validatePath(field, path string) *vtrest.FieldError {
+	switch {
+	case len(path) > maxPathLength:
+		return &vtrest.FieldError{
+			Field:   fieldPointer("%s", field),
+			Code:    "PATH_TOO_LONG",
+			Message: fmt.Sprintf("Path must be at most %d bytes", maxPathLength),
+		}
+	case path == "" || strings.ContainsRune(path, 0):
+		return &vtrest.FieldError{
+			Field:   fieldPointer("%s", field),
+			Code:    "INVALID_PATH",
+			Message: fmt.Sprintf("Path %q must be non-empty and must not contain null bytes", path),
+		}
+	case !filepath.IsAbs(path):
+		return &vtrest.FieldError{
+			Field:   fieldPointer("%s", field),
+			Code:    "PATH_NOT_ABSOLUTE",
+			Message: fmt.Sprintf("Path %q must be absolute", path),
+		}
+	}
+	return nil
+}
+
+// validateWebhookURI checks that uri is an http or https URL.  If private webhooks are
+// blocked, its host must not be, or resolve to, an internal address.
+func (s *Server) validateWebhookURI(ctx context.Context, field, uri string) *vtrest.FieldError {
+	if len(uri) > maxWebhookURILength {
+		return &vtrest.FieldError{
+			Field:   fieldPointer("%s", field),
+			Code:    "WEBHOOK_URI_TOO_LONG",
+			Message: fmt.Sprintf("Webhook URI must be at most %d bytes", maxWebhookURILength),
+		}
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return &vtrest.FieldError{
+			Field:   fieldPointer("%s", field),
+			Code:    "INVALID_WEBHOOK",
+			Message: fmt.Sprintf("Invalid webhook URI %q: %v", uri, err),
+		}
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return &vtrest.FieldError{
+			Field:   fieldPointer("%s", field),
+			Code:    "WEBHOOK_SCHEME_NOT_ALLOWED",
+			Message: fmt.Sprintf("Webhook URI %q must use http or https", uri),
+		}
+	}
+	if parsed.Hostname() == "" {
+		return &vtrest.FieldError{
+			Field:   fieldPointer("%s", field),
+			Code:    "INVALID_WEBHOOK",
+			Message: fmt.Sprintf("Webhook URI %q has no host", uri),
+		}
+	}
+	if !s.settings.Load().blockPrivateWebhooks {
+		return nil
+	}
+
+	addrs, err := lookupWebhookHost(ctx, parsed.Hostname())
+	if err != nil {
+		return &vtrest.FieldError{
+			Field:   fieldPointer("%s", field),
+			Code:    "WEBHOOK_HOST_NOT_ALLOWED",
+			Message: fmt.Sprintf("Webhook host %q could not be resolved: %v", parsed.Hostname(), err),
+		}
+	}
+	for _, addr := range addrs {
+		if internal.PrivateAddr(addr) {
+			return &vtrest.FieldError{
+				Field:   fieldPointer("%s", field),
+				Code:    "WEBHOOK_HOST_NOT_ALLOWED",
+				Message: fmt.Sprintf("Webhook host %q is an internal address", parsed.Hostname()),
+			}
+		}
+	}
+	return nil
+}
+
+// lookupWebhookHost returns the addresses of a webhook host, which may be an IP literal.
+func lookupWebhookHost(ctx context.Context, host string) ([]netip.Addr, error) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return []netip.Addr{addr}, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, webhookLookupTimeout)
+	defer cancel()
+	return net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+}
+
+// validateWebhookToken checks the size of a webhook token.
+func validateWebhookToken(field string, token []byte) *vtrest.FieldError {
+	if len(token) > maxWebhookTokenBytes {
+		return &vtrest.FieldError{
+			Field:   fieldPointer("%s", field),
+			Code:    "TOKEN_TOO_LONG",
+			Message: fmt.Sprintf("Webhook token must be at most %d bytes", maxWebhookTokenBytes),
+		}
+	}
+	return nil
+}
+
+// validateWebhookTokenHeader checks that header, if set, names a header the token can be
+// sent in, and that there is a token to send.
+func validateWebhookTokenHeader(field string, header *string, token []byte) *vtrest.FieldError {
+	if header == nil {
+		return nil
+	}
+	switch {
+	case !webhookTokenHeaderPattern.MatchString(*header):
+		return &vtrest.FieldError{
+			Field:   fieldPointer("%s", field),
+			Code:    "INVALID_TOKEN_HEADER",
+			Message: fmt.Sprintf("Token header %q must be 1 to 64 letters, digits, or '-'", *header),
+		}
+	case slices.Contains(reservedWebhookHeaders, http.CanonicalHeaderKey(*header)):
+		return &vtrest.FieldError{
+			Field:   fieldPointer("%s", field),
+			Code:    "INVALID_TOKEN_HEADER",
+			Message: fmt.Sprintf("Token header %q is set by the worker", *header),
+		}
+	case len(token) == 0:
+		return &vtrest.FieldError{
+			Field:   fieldPointer("%s", field),
+			Code:    "INVALID_TOKEN_HEADER",
+			Message: "A token header requires a token",
+		}
+	}
+	return nil
+}
+
+// fieldPointer formats a JSON pointer to a request body field.
+func fieldPointer(format string, args ...any) *string {
+	pointer := fmt.Sprintf(format, args...)
+	return &pointer
+}
+
+// validationProblem reports the problems found validating a request body.  The first
+// problem is the headline; every problem is listed with its field.
+func validationProblem(problems []vtrest.FieldError) vtrest.Error {
+	return vtrest.Error{
+		Code:    problems[0].Code,
+		Message: problems[0].Message,
+		Errors:  problems,
+	}
+}
+
+// pathAllowed reports whether path is inside one of the configured allowed directories,
+// and one of the storage roots of the request's tenant.
+func (s *Server) pathAllowed(ctx context.Context, path string) bool {
+	if !pathInside(path, s.settings.Load().allowedPaths) {
+		return false
+	}
+	if tenant := tenantFromContext(ctx); tenant != nil {
+		return pathInside(path, tenant.StorageRoots)
+	}
+	return true
+}
+
+// pathInside reports whether path is inside one of roots, or roots is empty.
+func pathInside(path string, roots []string) bool {
+	if len(roots) == 0 {
+		return true
+	}
+	cleanPath := filepath.Clean(path)
+	for _, root := range roots {
+		root = filepath.Clean(root)
+		if cleanPath == root || strings.HasPrefix(cleanPath, root+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTranscode handles POST /transcodes/validate requests.
+func (s *Server) ValidateTranscode(ctx context.Context, request vtrest.ValidateTranscodeRequestObject) (vtrest.ValidateTranscodeResponseObject, error) {
+	if request.Body == nil {
+		return vtrest.ValidateTranscode400ApplicationProblemPlusJSONResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	problems := s.validateTranscodeRequest(ctx, request.Body)
+
+	_, err := s.lookupJob(ctx, request.Body.Uuid)
+	if err != nil && !errors.Is(err, errJobNotFound) {
+		return vtrest.ValidateTranscode500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	if err == nil {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/uuid"),
+			Code:    "DUPLICATE_UUID",
+			Message: fmt.Sprintf("A transcode job with UUID %s already exists", request.Body.Uuid),
+		})
+	}
+
+	if request.Params.ProbeSource != nil && *request.Params.ProbeSource {
+		if info, err := os.Stat(request.Body.SourcePath); err != nil {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/sourcePath"),
+				Code:    "SOURCE_NOT_FOUND",
+				Message: fmt.Sprintf("Source %q is not accessible from the server: %v", request.Body.SourcePath, err),
+			})
+		} else if info.IsDir() {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/sourcePath"),
+				Code:    "SOURCE_NOT_FOUND",
+				Message: fmt.Sprintf("Source %q is a directory", request.Body.SourcePath),
+			})
+		}
+	}
+
+	if problems == nil {
+		problems = []vtrest.FieldError{}
+	}
+	return vtrest.ValidateTranscode200JSONResponse{
+		Valid:  len(problems) == 0,
+		Errors: problems,
+	}, nil
+}
@@ -0,0 +1,155 @@
+package vtserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// validateTestServer returns a server with the given settings, enough to validate requests.
+func validateTestServer(settings *serverSettings) *Server {
+	s := &Server{}
+	s.settings.Store(settings)
+	return s
+}
+
+func TestValidateTranscodeRequest(t *testing.T) {
+	s := validateTestServer(&serverSettings{allowedPaths: []string{"/media"}, blockPrivateWebhooks: true})
+	str := func(s string) *string { return &s }
+	intPtr := func(i int) *int { return &i }
+
+	tests := []struct {
+		loc    exam.Loc
+		name   string
+		modify func(*vtrest.TranscodeRequest)
+		// want are the field and code of each problem, in order
+		want []string
+	}{
+		{loc: exam.Here(), name: "valid", modify: func(*vtrest.TranscodeRequest) {}},
+		{
+			loc:  exam.Here(),
+			name: "every problem is reported in order",
+			modify: func(body *vtrest.TranscodeRequest) {
+				body.Profile = "bogus"
+				body.SourcePath = "relative.mkv"
+				body.DestinationPath = "/elsewhere/movie.mp4"
+			},
+			want: []string{"/profile INVALID_PROFILE", "/sourcePath PATH_NOT_ABSOLUTE", "/destinationPath PATH_NOT_ALLOWED"},
+		},
+		{
+			loc:    exam.Here(),
+			name:   "path with a null byte",
+			modify: func(body *vtrest.TranscodeRequest) { body.SourcePath = "/media/a\x00.mkv" },
+			want:   []string{"/sourcePath INVALID_PATH"},
+		},
+		{
+			loc:    exam.Here(),
+			name:   "path too long",
+			modify: func(body *vtrest.TranscodeRequest) { body.SourcePath = "/media/" + strings.Repeat("a", maxPathLength) },
+			want:   []string{"/sourcePath PATH_TOO_LONG"},
+		},
+		{
+			loc:    exam.Here(),
+			name:   "path escaping the allowed directory",
+			modify: func(body *vtrest.TranscodeRequest) { body.SourcePath = "/media/../etc/passwd" },
+			want:   []string{"/sourcePath PATH_NOT_ALLOWED"},
+		},
+		{
+			loc:    exam.Here(),
+			name:   "webhook scheme",
+			modify: func(body *vtrest.TranscodeRequest) { body.WebhookUri = str("ftp://hooks.example/done") },
+			want:   []string{"/webhookUri WEBHOOK_SCHEME_NOT_ALLOWED"},
+		},
+		{
+			loc:    exam.Here(),
+			name:   "private webhook",
+			modify: func(body *vtrest.TranscodeRequest) { body.HeartbeatWebhookUri = str("http://127.0.0.1/progress") },
+			want:   []string{"/heartbeatWebhookUri WEBHOOK_HOST_NOT_ALLOWED"},
+		},
+		{
+			loc:    exam.Here(),
+			name:   "token header without a token",
+			modify: func(body *vtrest.TranscodeRequest) { body.WebhookTokenHeader = str("X-Token") },
+			want:   []string{"/webhookTokenHeader INVALID_TOKEN_HEADER"},
+		},
+		{
+			loc:  exam.Here(),
+			name: "reserved token header",
+			modify: func(body *vtrest.TranscodeRequest) {
+				body.WebhookToken = []byte("token")
+				body.WebhookTokenHeader = str("content-type")
+			},
+			want: []string{"/webhookTokenHeader INVALID_TOKEN_HEADER"},
+		},
+		{
+			loc:  exam.Here(),
+			name: "heartbeat interval",
+			modify: func(body *vtrest.TranscodeRequest) {
+				body.HeartbeatIntervalSeconds = intPtr(maxHeartbeatIntervalSeconds + 1)
+			},
+			want: []string{"/heartbeatIntervalSeconds INVALID_HEARTBEAT_INTERVAL"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			e.Log("Running test at", tt.loc)
+			env := deep.NewEnv()
+			body := vtrest.TranscodeRequest{
+				Uuid:            uuid.New(),
+				SourcePath:      "/media/movie.mkv",
+				DestinationPath: "/media/out/movie.mp4",
+				Profile:         "preview",
+			}
+			tt.modify(&body)
+			var got []string
+			for _, problem := range s.validateTranscodeRequest(context.Background(), &body) {
+				got = append(got, *problem.Field+" "+problem.Code)
+			}
+			exam.Equal(e, env, tt.want, got)
+		})
+	}
+}
+
+func TestPathInside(t *testing.T) {
+	tests := []struct {
+		loc   exam.Loc
+		name  string
+		path  string
+		roots []string
+		want  bool
+	}{
+		{loc: exam.Here(), name: "no roots", path: "/anywhere", want: true},
+		{loc: exam.Here(), name: "the root itself", path: "/media/", roots: []string{"/media"}, want: true},
+		{loc: exam.Here(), name: "inside", path: "/media/movies/a.mkv", roots: []string{"/other", "/media"}, want: true},
+		{loc: exam.Here(), name: "sibling with the root as prefix", path: "/media2/a.mkv", roots: []string{"/media"}},
+		{loc: exam.Here(), name: "escaping with ..", path: "/media/../etc", roots: []string{"/media"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := exam.New(t)
+			e.Log("Running test at", tt.loc)
+			env := deep.NewEnv()
+			exam.Equal(e, env, tt.want, pathInside(tt.path, tt.roots))
+		})
+	}
+}
+
+func TestValidationProblem(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	problems := []vtrest.FieldError{
+		{Field: fieldPointer("/profile"), Code: "INVALID_PROFILE", Message: "Invalid profile"},
+		{Field: fieldPointer("/sourcePath"), Code: "INVALID_PATH", Message: "Invalid path"},
+	}
+	problem := validationProblem(problems)
+	exam.Equal(e, env, "INVALID_PROFILE", problem.Code)
+	exam.Equal(e, env, "Invalid profile", problem.Message)
+	exam.Equal(e, env, 2, len(problem.Errors))
+}
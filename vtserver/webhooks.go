@@ -0,0 +1,193 @@
+package vtserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// maxWebhookDeliveries bounds how many deliveries are listed for a single job.
+const maxWebhookDeliveries = 1000
+
+// ListTranscodeWebhooks handles GET /transcodes/{uuid}/webhooks requests.
+func (s *Server) ListTranscodeWebhooks(ctx context.Context, request vtrest.ListTranscodeWebhooksRequestObject) (vtrest.ListTranscodeWebhooksResponseObject, error) {
+	if _, err := s.lookupJob(ctx, request.Uuid); errors.Is(err, errJobNotFound) {
+		return vtrest.ListTranscodeWebhooks404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("Transcode job with UUID %s not found", request.Uuid),
+		}, nil
+	} else if err != nil {
+		return vtrest.ListTranscodeWebhooks500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+
+	where := "args->>'uuid' = @uuid"
+	if request.Params.IncludeHeartbeats == nil || !*request.Params.IncludeHeartbeats {
+		where += " AND NOT coalesce((args->>'isHeartbeat')::boolean, false)"
+	}
+	params := river.NewJobListParams().
+		Kinds(internal.WebhookJobArgs{}.Kind()).
+		States(rivertype.JobStates()...).
+		Where(where, river.NamedArgs{"uuid": request.Uuid.String()}).
+		OrderBy(river.JobListOrderByID, river.SortOrderAsc).
+		First(maxWebhookDeliveries)
+	result, err := s.riverClient.JobList(ctx, params)
+	if err != nil {
+		return vtrest.ListTranscodeWebhooks500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to list webhook jobs: %v", err),
+		}, nil
+	}
+
+	response := vtrest.ListTranscodeWebhooks200JSONResponse{
+		Deliveries: make([]vtrest.WebhookDelivery, 0, len(result.Jobs)),
+	}
+	for _, job := range result.Jobs {
+		delivery, err := webhookDeliveryFromRiver(job)
+		if err != nil {
+			return vtrest.ListTranscodeWebhooks500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: err.Error(),
+			}, nil
+		}
+		response.Deliveries = append(response.Deliveries, *delivery)
+	}
+	return response, nil
+}
+
+// ReplayTranscodeWebhook handles POST /transcodes/{uuid}/webhooks/replay requests.
+func (s *Server) ReplayTranscodeWebhook(ctx context.Context, request vtrest.ReplayTranscodeWebhookRequestObject) (vtrest.ReplayTranscodeWebhookResponseObject, error) {
+	job, err := s.lookupJob(ctx, request.Uuid)
+	if errors.Is(err, errJobNotFound) {
+		return vtrest.ReplayTranscodeWebhook404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("Transcode job with UUID %s not found", request.Uuid),
+		}, nil
+	} else if err != nil {
+		return vtrest.ReplayTranscodeWebhook500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+
+	transcodeJob, err := transcodeJobFromRiver(job)
+	if err != nil {
+		return vtrest.ReplayTranscodeWebhook500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	if transcodeJob.Status != vtrest.Completed && transcodeJob.Status != vtrest.Failed {
+		return vtrest.ReplayTranscodeWebhook409ApplicationProblemPlusJSONResponse{
+			Code:    "JOB_NOT_FINISHED",
+			Message: fmt.Sprintf("Transcode job with UUID %s has not finished", request.Uuid),
+		}, nil
+	}
+
+	var args internal.TranscodeJobArgs
+	if err := json.Unmarshal(job.EncodedArgs, &args); err != nil {
+		return vtrest.ReplayTranscodeWebhook500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to unmarshal job args: %v", err),
+		}, nil
+	}
+	var status internal.TranscodeJobStatus
+	if output := job.Output(); len(output) > 0 {
+		if err := json.Unmarshal(output, &status); err != nil {
+			return vtrest.ReplayTranscodeWebhook500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: fmt.Sprintf("failed to unmarshal job output: %v", err),
+			}, nil
+		}
+	}
+	// Jobs that failed by exhausting their retries only have the error River recorded
+	if status.Error == nil {
+		status.Error = transcodeJob.Error
+	}
+
+	webhooks := internal.CompletionWebhooks(args, &status, internal.ParseJobMetadata(job.Metadata).RequestID)
+	if len(webhooks) == 0 {
+		return vtrest.ReplayTranscodeWebhook409ApplicationProblemPlusJSONResponse{
+			Code:    "NO_WEBHOOK",
+			Message: fmt.Sprintf("Transcode job with UUID %s has no webhook subscribed to its outcome", request.Uuid),
+		}, nil
+	}
+
+	params := make([]river.InsertManyParams, len(webhooks))
+	for i, webhook := range webhooks {
+		params[i] = river.InsertManyParams{Args: webhook}
+	}
+	inserted, err := s.riverClient.InsertMany(ctx, params)
+	if err != nil {
+		return vtrest.ReplayTranscodeWebhook500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to enqueue webhook jobs: %v", err),
+		}, nil
+	}
+
+	response := vtrest.ReplayTranscodeWebhook202JSONResponse{
+		Deliveries: make([]vtrest.WebhookDelivery, 0, len(inserted)),
+	}
+	for _, result := range inserted {
+		delivery, err := webhookDeliveryFromRiver(result.Job)
+		if err != nil {
+			return vtrest.ReplayTranscodeWebhook500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: err.Error(),
+			}, nil
+		}
+		response.Deliveries = append(response.Deliveries, *delivery)
+	}
+	return response, nil
+}
+
+// webhookDeliveryFromRiver converts a River webhook job into an API WebhookDelivery.
+func webhookDeliveryFromRiver(job *rivertype.JobRow) (*vtrest.WebhookDelivery, error) {
+	var args internal.WebhookJobArgs
+	if err := json.Unmarshal(job.EncodedArgs, &args); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal webhook job args: %w", err)
+	}
+
+	delivery := &vtrest.WebhookDelivery{
+		Uri:         args.URI,
+		Heartbeat:   args.IsHeartbeat,
+		Status:      mapRiverStateToWebhookDeliveryStatus(job.State),
+		Attempts:    job.Attempt,
+		MaxAttempts: job.MaxAttempts,
+		CreatedAt:   job.CreatedAt,
+		FinalizedAt: job.FinalizedAt,
+	}
+
+	if output := job.Output(); len(output) > 0 {
+		var deliveryOutput internal.WebhookDeliveryOutput
+		if err := json.Unmarshal(output, &deliveryOutput); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal webhook job output: %w", err)
+		}
+		delivery.LastStatusCode = &deliveryOutput.StatusCode
+	}
+	if delivery.Status != vtrest.Delivered && len(job.Errors) > 0 {
+		lastError := job.Errors[len(job.Errors)-1].Error
+		delivery.LastError = &lastError
+	}
+	return delivery, nil
+}
+
+// mapRiverStateToWebhookDeliveryStatus converts River job state to API WebhookDeliveryStatus.
+func mapRiverStateToWebhookDeliveryStatus(state rivertype.JobState) vtrest.WebhookDeliveryStatus {
+	switch state {
+	case rivertype.JobStateCompleted:
+		return vtrest.Delivered
+	case rivertype.JobStateDiscarded, rivertype.JobStateCancelled:
+		return vtrest.Abandoned
+	default:
+		return vtrest.Queued
+	}
+}
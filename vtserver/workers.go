@@ -0,0 +1,81 @@
+package vtserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// listWorkersQuery returns the workers with a recent heartbeat and the transcode job
+// each is running, unless the job belongs to a tenant other than $3.  River records the
+// IDs of the clients that worked a job in attempted_by, so the current attempt's worker
+// is the last one.
+const listWorkersQuery = `
+	SELECT w.id, w.hostname, w.capabilities, w.tool_versions, w.started_at, w.heartbeat_at,
+		j.args->>'uuid', (j.metadata->'output'->>'progress')::float8
+	FROM workers w
+	LEFT JOIN LATERAL (
+		SELECT args, metadata FROM river_job
+		WHERE state = 'running' AND kind = $2 AND attempted_by[cardinality(attempted_by)] = w.id
+		ORDER BY attempted_at DESC
+		LIMIT 1
+	) j ON $3::text IS NULL OR coalesce(j.args->>'tenant', '') = $3
+	WHERE w.heartbeat_at > now() - make_interval(secs => $1)
+	ORDER BY w.hostname, w.id`
+
+// ListWorkers handles GET /workers requests.
+func (s *Server) ListWorkers(ctx context.Context, request vtrest.ListWorkersRequestObject) (vtrest.ListWorkersResponseObject, error) {
+	var tenant *string
+	if t := tenantFromContext(ctx); t != nil {
+		tenant = &t.Name
+	}
+	rows, err := s.readPool.Query(ctx, listWorkersQuery, internal.WorkerStaleAfter.Seconds(), internal.TranscodeJobArgs{}.Kind(), tenant)
+	if err != nil {
+		return vtrest.ListWorkers500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to query workers: %v", err),
+		}, nil
+	}
+	defer rows.Close()
+
+	response := vtrest.ListWorkers200JSONResponse{
+		Workers: []vtrest.Worker{},
+	}
+	for rows.Next() {
+		var worker vtrest.Worker
+		var currentJob *string
+		if err := rows.Scan(&worker.Id, &worker.Hostname, &worker.Capabilities, &worker.ToolVersions, &worker.StartedAt, &worker.LastHeartbeatAt, &currentJob, &worker.Progress); err != nil {
+			return vtrest.ListWorkers500ApplicationProblemPlusJSONResponse{
+				Code:    "INTERNAL_ERROR",
+				Message: fmt.Sprintf("failed to scan worker: %v", err),
+			}, nil
+		}
+		if currentJob != nil {
+			id, err := uuid.Parse(*currentJob)
+			if err != nil {
+				return vtrest.ListWorkers500ApplicationProblemPlusJSONResponse{
+					Code:    "INTERNAL_ERROR",
+					Message: fmt.Sprintf("failed to parse job UUID: %v", err),
+				}, nil
+			}
+			worker.CurrentJob = &id
+			if worker.Progress == nil {
+				// The job hasn't recorded any progress yet.
+				progress := 0.0
+				worker.Progress = &progress
+			}
+		}
+		response.Workers = append(response.Workers, worker)
+	}
+	if err := rows.Err(); err != nil {
+		return vtrest.ListWorkers500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to read workers: %v", err),
+		}, nil
+	}
+
+	return response, nil
+}
@@ -0,0 +1,473 @@
+package vtserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// workflowStepNamePattern matches workflow step names.
+var workflowStepNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// errWorkflowNotFound is returned by lookupWorkflow when no workflow exists for a UUID.
+var errWorkflowNotFound = errors.New("workflow not found")
+
+// CreateWorkflow handles POST /workflows requests.
+func (s *Server) CreateWorkflow(ctx context.Context, request vtrest.CreateWorkflowRequestObject) (vtrest.CreateWorkflowResponseObject, error) {
+	if request.Body == nil {
+		return vtrest.CreateWorkflow400ApplicationProblemPlusJSONResponse{
+			Code:    "INVALID_REQUEST",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	if problems := s.validateWorkflowRequest(ctx, request.Body); len(problems) > 0 {
+		return vtrest.CreateWorkflow400ApplicationProblemPlusJSONResponse(validationProblem(problems)), nil
+	}
+	params, err := workflowInsertParams(ctx, request.Body, s.settings.Load().secrets)
+	if err != nil {
+		return vtrest.CreateWorkflow500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	transcodes := 0
+	for _, param := range params {
+		if _, ok := param.Args.(internal.TranscodeJobArgs); ok {
+			transcodes++
+		}
+	}
+	if quota, err := s.checkQuota(ctx, transcodes); err != nil {
+		return vtrest.CreateWorkflow500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	} else if quota != nil {
+		return vtrest.CreateWorkflow429ApplicationProblemPlusJSONResponse(*quota), nil
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return vtrest.CreateWorkflow500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to begin transaction: %v", err),
+		}, nil
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the workflow so two concurrent requests with the same UUID can't both insert it
+	if err := internal.LockWorkflow(ctx, tx, request.Body.Uuid); err != nil {
+		return vtrest.CreateWorkflow500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	existing, err := s.riverClient.JobListTx(ctx, tx, internal.WorkflowListParams(request.Body.Uuid).First(1))
+	if err != nil {
+		return vtrest.CreateWorkflow500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to look up river jobs: %v", err),
+		}, nil
+	}
+	if len(existing.Jobs) > 0 {
+		return vtrest.CreateWorkflow409ApplicationProblemPlusJSONResponse{
+			Code:    "DUPLICATE_UUID",
+			Message: fmt.Sprintf("A workflow with UUID %s already exists", request.Body.Uuid),
+		}, nil
+	}
+
+	// Transcode steps keep their unique UUIDs, so a step that duplicates an existing
+	// transcode job rolls back the whole workflow
+	results, err := s.riverClient.InsertManyTx(ctx, tx, params)
+	if err != nil {
+		return vtrest.CreateWorkflow500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to insert river jobs: %v", err),
+		}, nil
+	}
+	rows := make([]*rivertype.JobRow, len(results))
+	for i, result := range results {
+		if result.UniqueSkippedAsDuplicate {
+			args := params[i].Args.(internal.TranscodeJobArgs)
+			return vtrest.CreateWorkflow409ApplicationProblemPlusJSONResponse{
+				Code:    "DUPLICATE_UUID",
+				Message: fmt.Sprintf("A transcode job with UUID %s already exists", args.UUID),
+			}, nil
+		}
+		rows[i] = result.Job
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return vtrest.CreateWorkflow500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to commit transaction: %v", err),
+		}, nil
+	}
+
+	now := time.Now()
+	for _, param := range params {
+		args, ok := param.Args.(internal.TranscodeJobArgs)
+		if !ok {
+			continue
+		}
+		event := internal.JobEvent{
+			Type:       internal.JobEventCreated,
+			UUID:       args.UUID,
+			OccurredAt: now,
+			RequestID:  internal.RequestIDFromContext(ctx),
+			Labels:     args.Labels,
+		}
+		if err := s.events.Publish(ctx, event); err != nil {
+			// The job exists either way; don't fail the request over a missed event
+			slog.Warn("failed to publish created event", "uuid", args.UUID, "error", err)
+		}
+	}
+
+	workflow, err := workflowFromRiver(request.Body.Uuid, rows)
+	if err != nil {
+		return vtrest.CreateWorkflow500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	return vtrest.CreateWorkflow201JSONResponse(*workflow), nil
+}
+
+// GetWorkflowStatus handles GET /workflows/{uuid} requests.
+func (s *Server) GetWorkflowStatus(ctx context.Context, request vtrest.GetWorkflowStatusRequestObject) (vtrest.GetWorkflowStatusResponseObject, error) {
+	result, err := s.readClient.JobList(ctx, scopeToTenant(ctx, internal.WorkflowListParams(request.Uuid)))
+	if err != nil {
+		return vtrest.GetWorkflowStatus500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: fmt.Sprintf("failed to look up river jobs: %v", err),
+		}, nil
+	}
+	workflow, err := workflowFromRiver(request.Uuid, result.Jobs)
+	if errors.Is(err, errWorkflowNotFound) {
+		return vtrest.GetWorkflowStatus404ApplicationProblemPlusJSONResponse{
+			Code:    "NOT_FOUND",
+			Message: fmt.Sprintf("Workflow with UUID %s not found", request.Uuid),
+		}, nil
+	} else if err != nil {
+		return vtrest.GetWorkflowStatus500ApplicationProblemPlusJSONResponse{
+			Code:    "INTERNAL_ERROR",
+			Message: err.Error(),
+		}, nil
+	}
+	return vtrest.GetWorkflowStatus200JSONResponse(*workflow), nil
+}
+
+// validateWorkflowRequest runs the checks on a workflow request that don't need the
+// database, including the transcode checks for every transcode step.
+func (s *Server) validateWorkflowRequest(ctx context.Context, body *vtrest.WorkflowRequest) []vtrest.FieldError {
+	var problems []vtrest.FieldError
+	if len(body.Steps) == 0 || len(body.Steps) > internal.MaxWorkflowSteps {
+		return []vtrest.FieldError{{
+			Field:   fieldPointer("/steps"),
+			Code:    "INVALID_WORKFLOW",
+			Message: fmt.Sprintf("A workflow must have between 1 and %d steps", internal.MaxWorkflowSteps),
+		}}
+	}
+
+	steps := make(map[string]vtrest.WorkflowStep, len(body.Steps))
+	for _, step := range body.Steps {
+		steps[step.Name] = step
+	}
+	transcodeUUIDs := make(map[uuid.UUID]bool)
+	for i, step := range body.Steps {
+		if !workflowStepNamePattern.MatchString(step.Name) {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/steps/%d/name", i),
+				Code:    "INVALID_WORKFLOW",
+				Message: fmt.Sprintf("Step name %q must be 1 to 32 lowercase letters, digits, '_' or '-'", step.Name),
+			})
+		}
+		switch internal.WorkflowStepType(step.Type) {
+		case internal.WorkflowStepProbe:
+			if step.Path == nil || *step.Path == "" {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/steps/%d/path", i),
+					Code:    "INVALID_WORKFLOW",
+					Message: fmt.Sprintf("Probe step %q requires a path", step.Name),
+				})
+			} else if problem := validatePath(fmt.Sprintf("/steps/%d/path", i), *step.Path); problem != nil {
+				problems = append(problems, *problem)
+			} else if !s.pathAllowed(ctx, *step.Path) {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/steps/%d/path", i),
+					Code:    "PATH_NOT_ALLOWED",
+					Message: fmt.Sprintf("Path %q is not inside an allowed directory", *step.Path),
+				})
+			}
+		case internal.WorkflowStepTranscode:
+			if step.Transcode == nil {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/steps/%d/transcode", i),
+					Code:    "INVALID_WORKFLOW",
+					Message: fmt.Sprintf("Transcode step %q requires a transcode request", step.Name),
+				})
+				continue
+			}
+			if transcodeUUIDs[step.Transcode.Uuid] {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/steps/%d/transcode/uuid", i),
+					Code:    "INVALID_WORKFLOW",
+					Message: fmt.Sprintf("Transcode UUID %s is used by more than one step", step.Transcode.Uuid),
+				})
+			}
+			transcodeUUIDs[step.Transcode.Uuid] = true
+			for _, problem := range s.validateTranscodeRequest(ctx, step.Transcode) {
+				if problem.Field != nil {
+					problem.Field = fieldPointer("/steps/%d/transcode%s", i, *problem.Field)
+				}
+				problems = append(problems, problem)
+			}
+		case internal.WorkflowStepVerify:
+			target, ok := steps[derefString(step.Target)]
+			switch {
+			case step.Target == nil:
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/steps/%d/target", i),
+					Code:    "INVALID_WORKFLOW",
+					Message: fmt.Sprintf("Verify step %q requires a target", step.Name),
+				})
+			case !ok || target.Type != vtrest.WorkflowStepTypeTranscode || target.Transcode == nil:
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/steps/%d/target", i),
+					Code:    "INVALID_WORKFLOW",
+					Message: fmt.Sprintf("Verify step %q must target a transcode step", step.Name),
+				})
+			case internal.Profile(target.Transcode.Profile) == internal.ProfileRenditions || internal.Profile(target.Transcode.Profile) == internal.ProfileABR:
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/steps/%d/target", i),
+					Code:    "INVALID_WORKFLOW",
+					Message: fmt.Sprintf("Verify step %q can't check the several outputs of profile %q", step.Name, target.Transcode.Profile),
+				})
+			}
+		case internal.WorkflowStepWebhook:
+			if step.WebhookUri == nil || *step.WebhookUri == "" {
+				problems = append(problems, vtrest.FieldError{
+					Field:   fieldPointer("/steps/%d/webhookUri", i),
+					Code:    "INVALID_WORKFLOW",
+					Message: fmt.Sprintf("Webhook step %q requires a webhookUri", step.Name),
+				})
+			} else if problem := s.validateWebhookURI(ctx, fmt.Sprintf("/steps/%d/webhookUri", i), *step.WebhookUri); problem != nil {
+				problems = append(problems, *problem)
+			}
+			if problem := validateWebhookToken(fmt.Sprintf("/steps/%d/webhookToken", i), step.WebhookToken); problem != nil {
+				problems = append(problems, *problem)
+			}
+		default:
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/steps/%d/type", i),
+				Code:    "INVALID_WORKFLOW",
+				Message: fmt.Sprintf("Step %q has invalid type %q", step.Name, step.Type),
+			})
+		}
+		if step.Transcode != nil && step.Type != vtrest.WorkflowStepTypeTranscode {
+			problems = append(problems, vtrest.FieldError{
+				Field:   fieldPointer("/steps/%d/transcode", i),
+				Code:    "INVALID_WORKFLOW",
+				Message: fmt.Sprintf("Step %q has a transcode request but is a %s step", step.Name, step.Type),
+			})
+		}
+	}
+
+	if _, err := internal.SortWorkflowSteps(workflowRefs(body)); err != nil {
+		problems = append(problems, vtrest.FieldError{
+			Field:   fieldPointer("/steps"),
+			Code:    "INVALID_WORKFLOW",
+			Message: err.Error(),
+		})
+	}
+	return problems
+}
+
+// workflowRefs returns the workflow refs of the steps of a workflow request.  A verify
+// step depends on its target even if the request doesn't say so.
+func workflowRefs(body *vtrest.WorkflowRequest) []internal.WorkflowRef {
+	refs := make([]internal.WorkflowRef, len(body.Steps))
+	for i, step := range body.Steps {
+		refs[i] = internal.WorkflowRef{
+			ID:        body.Uuid,
+			Step:      step.Name,
+			DependsOn: slices.Clone(step.DependsOn),
+		}
+		if step.Type == vtrest.WorkflowStepTypeVerify && step.Target != nil && !slices.Contains(step.DependsOn, *step.Target) {
+			refs[i].DependsOn = append(refs[i].DependsOn, *step.Target)
+		}
+	}
+	return refs
+}
+
+// workflowInsertParams converts a validated workflow request into River bulk insert
+// parameters, in dependency order.  Steps with dependencies are inserted pending and
+// made available by the worker once their dependencies succeed.  Webhook tokens are
+// sealed with secrets, if it isn't nil.
+func workflowInsertParams(ctx context.Context, body *vtrest.WorkflowRequest, secrets internal.KeyWrapper) ([]river.InsertManyParams, error) {
+	metadata, err := json.Marshal(internal.JobMetadata{RequestID: internal.RequestIDFromContext(ctx)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal job metadata: %w", err)
+	}
+	refs, err := internal.SortWorkflowSteps(workflowRefs(body))
+	if err != nil {
+		return nil, err
+	}
+	steps := make(map[string]vtrest.WorkflowStep, len(body.Steps))
+	for _, step := range body.Steps {
+		steps[step.Name] = step
+	}
+
+	params := make([]river.InsertManyParams, len(refs))
+	for i, ref := range refs {
+		step := steps[ref.Step]
+		var args river.JobArgs
+		switch step.Type {
+		case vtrest.WorkflowStepTypeTranscode:
+			transcode := transcodeJobArgs(ctx, step.Transcode)
+			transcode.Workflow = &ref
+			if err := transcode.SealSecrets(ctx, secrets); err != nil {
+				return nil, err
+			}
+			args = transcode
+		case vtrest.WorkflowStepTypeVerify:
+			target := steps[*step.Target].Transcode
+			verify := internal.WorkflowStepJobArgs{Workflow: ref, Type: internal.WorkflowStepVerify, Path: target.DestinationPath, Tenant: tenantName(ctx)}
+			if step.CompareDuration == nil || *step.CompareDuration {
+				verify.SourcePath = target.SourcePath
+			}
+			args = verify
+		default:
+			stepArgs := internal.WorkflowStepJobArgs{
+				Workflow: ref,
+				Type:     internal.WorkflowStepType(step.Type),
+				Path:     derefString(step.Path),
+				URI:      derefString(step.WebhookUri),
+				Token:    step.WebhookToken,
+				Tenant:   tenantName(ctx),
+			}
+			if err := stepArgs.SealSecrets(ctx, secrets); err != nil {
+				return nil, err
+			}
+			args = stepArgs
+		}
+		params[i] = river.InsertManyParams{
+			Args:       args,
+			InsertOpts: &river.InsertOpts{Metadata: metadata, Pending: len(ref.DependsOn) > 0},
+		}
+	}
+	return params, nil
+}
+
+// workflowFromRiver builds the API representation of a workflow from its River jobs.
+func workflowFromRiver(id uuid.UUID, rows []*rivertype.JobRow) (*vtrest.Workflow, error) {
+	jobs, err := internal.WorkflowJobs(&river.JobListResult{Jobs: rows})
+	if err != nil {
+		return nil, err
+	}
+	if len(jobs) == 0 {
+		return nil, errWorkflowNotFound
+	}
+
+	workflow := &vtrest.Workflow{
+		Uuid:      id,
+		Steps:     make([]vtrest.WorkflowStepStatus, 0, len(jobs)),
+		CreatedAt: jobs[0].Row.CreatedAt.UTC(),
+		UpdatedAt: jobs[0].Row.CreatedAt.UTC(),
+	}
+	for _, job := range jobs {
+		step, err := workflowStepFromRiver(job)
+		if err != nil {
+			return nil, err
+		}
+		workflow.Steps = append(workflow.Steps, *step)
+
+		updatedAt := job.Row.CreatedAt
+		if job.Row.FinalizedAt != nil {
+			updatedAt = *job.Row.FinalizedAt
+		} else if job.Row.AttemptedAt != nil {
+			updatedAt = *job.Row.AttemptedAt
+		}
+		if job.Row.CreatedAt.Before(workflow.CreatedAt) {
+			workflow.CreatedAt = job.Row.CreatedAt.UTC()
+		}
+		if updatedAt.After(workflow.UpdatedAt) {
+			workflow.UpdatedAt = updatedAt.UTC()
+		}
+	}
+	statuses := make([]vtrest.TranscodeStatus, len(workflow.Steps))
+	for i, step := range workflow.Steps {
+		statuses[i] = step.Status
+	}
+	workflow.Status = aggregateStatus(statuses)
+	return workflow, nil
+}
+
+// workflowStepFromRiver builds the API representation of one workflow step.  A step
+// that completed with an error, such as a failed transcode whose webhooks were
+// enqueued, counts as failed.
+func workflowStepFromRiver(job internal.WorkflowJob) (*vtrest.WorkflowStepStatus, error) {
+	step := &vtrest.WorkflowStepStatus{
+		Name:      job.Ref.Step,
+		DependsOn: job.Ref.DependsOn,
+		Status:    mapRiverStateToTranscodeStatus(job.Row.State),
+	}
+	if step.Status == vtrest.Completed && !job.Succeeded() {
+		step.Status = vtrest.Failed
+	}
+
+	if job.Row.Kind == (internal.TranscodeJobArgs{}).Kind() {
+		transcode, err := transcodeJobFromRiver(job.Row)
+		if err != nil {
+			return nil, err
+		}
+		step.Type = vtrest.WorkflowStepTypeTranscode
+		step.Transcode = transcode
+		step.Error = transcode.Error
+		step.ErrorCode = transcode.ErrorCode
+	} else {
+		var args internal.WorkflowStepJobArgs
+		if err := json.Unmarshal(job.Row.EncodedArgs, &args); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal workflow step args: %w", err)
+		}
+		var status internal.WorkflowStepStatus
+		if output := job.Row.Output(); len(output) > 0 {
+			if err := json.Unmarshal(output, &status); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal workflow step output: %w", err)
+			}
+		}
+		step.Type = vtrest.WorkflowStepType(args.Type)
+		step.DurationSeconds = status.DurationSeconds
+		step.SizeBytes = status.SizeBytes
+		step.Error = status.Error
+		step.ErrorCode = (*vtrest.ErrorCode)(status.ErrorCode)
+		if step.Error == nil && step.Status == vtrest.Failed && len(job.Row.Errors) > 0 {
+			lastError := job.Row.Errors[len(job.Row.Errors)-1].Error
+			step.Error = &lastError
+		}
+	}
+
+	if step.Error == nil && job.Row.State == rivertype.JobStateCancelled && job.Row.Attempt == 0 {
+		message := "Cancelled because a step it depends on failed"
+		step.Error = &message
+	}
+	return step, nil
+}
+
+// derefString returns the value of s, or "" if s is nil.
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,60 @@
+package vtworker
+
+import (
+	"context"
+	"errors"
+	"log"
+	"log/slog"
+	"sync"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/riverqueue/river"
+)
+
+// CompareWorker compares outputs against their sources.
+type CompareWorker struct {
+	river.WorkerDefaults[internal.CompareJobArgs]
+	// Limits controls the resources available to ffmpeg while scoring VMAF.
+	Limits *internal.ProcessLimits
+	// Sandbox restricts what ffmpeg and ffprobe can do; nil runs them unsandboxed.
+	Sandbox *internal.Sandbox
+
+	// mu guards Limits against Reload while jobs are starting.
+	mu sync.RWMutex
+}
+
+// Reload applies the process limits in cfg to comparisons started afterwards.
+func (w *CompareWorker) Reload(cfg *internal.WorkerConfig) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.Limits = cfg.Limits
+}
+
+// Work records the comparison report as the job's output.  Transient failures are
+// retried; files that can't be read fail the comparison for good.
+func (w *CompareWorker) Work(ctx context.Context, job *river.Job[internal.CompareJobArgs]) error {
+	args := job.Args
+	log.Printf("Starting comparison uuid: %s, source: %s, output: %s, vmaf: %t, attempt: %d", args.UUID, args.SourcePath, args.OutputPath, args.VMAF, job.Attempt)
+
+	w.mu.RLock()
+	limits := w.Limits
+	w.mu.RUnlock()
+
+	report, err := internal.CompareMedia(ctx, w.Sandbox, limits, args)
+	if err != nil {
+		code, permanent := internal.ClassifyFailure(err)
+		errMsg := err.Error()
+		_ = river.RecordOutput(ctx, internal.ComparisonReport{Error: &errMsg, ErrorCode: code})
+		if permanent && !errors.Is(context.Cause(ctx), river.ErrJobCancelledRemotely) {
+			return river.JobCancel(err)
+		}
+		slog.Warn("Comparison failed", "uuid", args.UUID, "attempt", job.Attempt, "max_attempts", job.MaxAttempts, "error", err)
+		return err
+	}
+
+	if err := river.RecordOutput(ctx, report); err != nil {
+		slog.Warn("failed to record comparison report", "error", err)
+	}
+	log.Printf("Completed comparison uuid: %s, durations match: %t, stream layout matches: %t", args.UUID, report.DurationsMatch, report.StreamLayoutMatches)
+	return nil
+}
@@ -0,0 +1,264 @@
+package vtworker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/riverqueue/river"
+)
+
+// Config configures the worker.  Database is only used by the worker binary; an
+// embedded Worker uses the pool it is given.
+type Config = internal.WorkerConfig
+
+// ConfigFromEnv loads the worker configuration from the same environment variables
+// and config file as the worker binary.  It panics if a variable is invalid.
+func ConfigFromEnv() *Config {
+	return internal.NewWorkerConfigFromEnv()
+}
+
+// Worker is the set of River workers that transcode videos, compare outputs against
+// their sources, deliver webhooks, run workflow steps, and watch for stalled jobs, for
+// registering with another service's River client.
+type Worker struct {
+	pool *pgxpool.Pool
+	// builtins are the encoders found by New, which don't change on Reload.
+	builtins []internal.EncoderCheck
+
+	// mu guards the plugins and the encoders listed in GET /workers.
+	mu       sync.Mutex
+	plugins  []string
+	encoders []internal.EncoderCheck
+	// clientID is the ID the workers joined GET /workers with, if they have.
+	clientID string
+
+	signerKey string
+	signer    *internal.WebhookSigner
+	events    internal.EventPublisher
+	transcode *TranscodeWorker
+	compare   *CompareWorker
+	webhook   *WebhookWorker
+	workflow  *WorkflowStepWorker
+	watchdog  *WatchdogWorker
+}
+
+// New checks the mounts, sandbox, and encoders cfg describes, and prepares the workers
+// to store their progress through pool.  Call Close once they are no longer used.
+func New(ctx context.Context, pool *pgxpool.Pool, cfg *Config) (*Worker, error) {
+	// Verify media mounts before accepting any work
+	if err := internal.CheckMounts(cfg.Mounts); err != nil {
+		return nil, fmt.Errorf("mount check failed: %w", err)
+	}
+
+	if err := cfg.Sandbox.Check(); err != nil {
+		return nil, fmt.Errorf("sandbox check failed: %w", err)
+	}
+
+	// Find the encoders, so jobs for profiles that can't run here are left to other workers
+	builtins := internal.CheckEncoders(ctx)
+	encoders := slices.Clone(builtins)
+	for _, encoder := range builtins {
+		if encoder.Err != nil {
+			slog.Warn("Encoder is unavailable", "tool", encoder.Tool, "error", encoder.Err)
+		} else {
+			log.Printf("Found %s version %s at %s", encoder.Tool, encoder.Version, encoder.Path)
+		}
+	}
+	for _, path := range cfg.Plugins {
+		plugin, err := internal.LoadPlugin(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("Loaded plugin %s version %s from %s", plugin.Tool, plugin.Version, plugin.Path)
+		encoders = append(encoders, plugin)
+	}
+	tools := internal.AvailableTools(encoders)
+	var unsupported []internal.Profile
+	for _, profile := range internal.Profiles {
+		if len(profile.MissingTools(tools)) > 0 {
+			unsupported = append(unsupported, profile)
+		}
+	}
+	if len(unsupported) == len(internal.Profiles) {
+		return nil, fmt.Errorf("no profile can be encoded: %s and %s are required", internal.ToolFFmpeg, internal.ToolFFprobe)
+	} else if len(unsupported) > 0 {
+		log.Printf("Leaving jobs for profiles %v to other workers", unsupported)
+	}
+
+	// Clear out what crashed jobs left in the scratch directory
+	if cfg.Scratch != nil {
+		if err := cfg.Scratch.Clean(ctx, pool); err != nil {
+			return nil, fmt.Errorf("scratch cleanup failed: %w", err)
+		}
+	}
+
+	webhookClient, err := cfg.Webhooks.NewClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook client: %w", err)
+	}
+	var signer *internal.WebhookSigner
+	if cfg.WebhookSigningKey != "" {
+		if signer, err = internal.LoadWebhookSigner(cfg.WebhookSigningKey); err != nil {
+			return nil, err
+		}
+	}
+
+	secrets, err := internal.SecretsKeyWrapper(cfg.SecretsKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to the event bus, if one is configured
+	events, err := internal.NewEventPublisher(cfg.Events)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event publisher: %w", err)
+	}
+
+	return &Worker{
+		pool:      pool,
+		builtins:  builtins,
+		plugins:   cfg.Plugins,
+		encoders:  encoders,
+		signerKey: cfg.WebhookSigningKey,
+		signer:    signer,
+		events:    events,
+		transcode: &TranscodeWorker{
+			DBPool:            pool,
+			Mounts:            cfg.Mounts,
+			ProgressInterval:  cfg.ProgressInterval,
+			HeartbeatMinDelta: cfg.HeartbeatMinDelta,
+			Limits:            cfg.Limits,
+			Sandbox:           cfg.Sandbox,
+			Output:            cfg.Output,
+			Scratch:           cfg.Scratch,
+			Events:            events,
+			Tools:             tools,
+			ToolVersions:      internal.ToolVersions(encoders),
+			interrupt:         make(chan struct{}),
+		},
+		compare:  &CompareWorker{Limits: cfg.Limits, Sandbox: cfg.Sandbox},
+		webhook:  &WebhookWorker{HTTPClient: webhookClient, Signer: signer, Secrets: secrets},
+		workflow: &WorkflowStepWorker{DBPool: pool, HTTPClient: webhookClient, Sandbox: cfg.Sandbox, Signer: signer, Secrets: secrets},
+		watchdog: &WatchdogWorker{DBPool: pool, StallTimeout: cfg.StallTimeout},
+	}, nil
+}
+
+// Register adds the workers to workers.  The River client they are given to must also
+// work the queues returned by Queues and enqueue PeriodicJobs.
+func (w *Worker) Register(workers *river.Workers) {
+	river.AddWorker(workers, w.transcode)
+	river.AddWorker(workers, w.compare)
+	river.AddWorker(workers, w.webhook)
+	river.AddWorker(workers, w.workflow)
+	river.AddWorker(workers, w.watchdog)
+}
+
+// Queues returns the queues the workers' jobs are inserted into.  Transcodes run one at
+// a time, so the default queue has a single worker.
+func (w *Worker) Queues() map[string]river.QueueConfig {
+	return map[string]river.QueueConfig{
+		river.QueueDefault:        {MaxWorkers: 1},
+		internal.QueueMaintenance: {MaxWorkers: 1},
+	}
+}
+
+// PeriodicJobs returns the jobs the elected River leader enqueues, so only one
+// watchdog runs at a time.
+func (w *Worker) PeriodicJobs() []*river.PeriodicJob {
+	return []*river.PeriodicJob{
+		river.NewPeriodicJob(river.PeriodicInterval(internal.WatchdogInterval), func() (river.JobArgs, *river.InsertOpts) {
+			return internal.WatchdogJobArgs{}, nil
+		}, nil),
+	}
+}
+
+// Join lists the River client with ID clientID in GET /workers, along with the
+// encoders found by New, and keeps its heartbeat until ctx is cancelled.
+func (w *Worker) Join(ctx context.Context, clientID string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := internal.RegisterWorker(ctx, w.pool, clientID, w.encoders, w.signer.PublicKey()); err != nil {
+		return err
+	}
+	w.clientID = clientID
+	go internal.RunWorkerHeartbeat(ctx, w.pool, clientID)
+	return nil
+}
+
+// Leave removes the River client with ID clientID from GET /workers.
+func (w *Worker) Leave(ctx context.Context, clientID string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.clientID = ""
+	return internal.DeregisterWorker(ctx, w.pool, clientID)
+}
+
+// Interrupt stops running transcodes and requeues them, for when the River client is
+// being stopped and they can't be allowed to finish.
+func (w *Worker) Interrupt() {
+	w.transcode.Interrupt()
+}
+
+// Reload applies the job, webhook delivery, plugin, and log level settings in cfg, as
+// the worker binary does on SIGHUP.  Jobs already running keep the plugin they started
+// with, and the tools listed in GET /workers are updated once the new plugins load.  The
+// webhook signing key published there is only loaded at startup; a cfg that changes it,
+// or whose plugins fail to load, is rejected and nothing is applied.  The log level is
+// applied to the output installed by internal.SetupLogging.
+func (w *Worker) Reload(cfg *Config) error {
+	if cfg.WebhookSigningKey != w.signerKey {
+		return errors.New("the webhook signing key can't change without a restart")
+	}
+	webhookClient, err := cfg.Webhooks.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create webhook client: %w", err)
+	}
+	if err := w.reloadPlugins(cfg.Plugins); err != nil {
+		return err
+	}
+	internal.SetLogLevel(cfg.LogLevel)
+	w.transcode.Reload(cfg)
+	w.compare.Reload(cfg)
+	w.webhook.Reload(webhookClient)
+	w.workflow.Reload(webhookClient)
+	return nil
+}
+
+// reloadPlugins loads the plugins at paths in place of the ones loaded before, if they
+// changed, and lists their tools in GET /workers.
+func (w *Worker) reloadPlugins(paths []string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if slices.Equal(paths, w.plugins) {
+		return nil
+	}
+	ctx := context.Background()
+	encoders, err := w.transcode.reloadPlugins(ctx, paths, w.builtins)
+	if err != nil {
+		return err
+	}
+	for _, plugin := range encoders[len(w.builtins):] {
+		log.Printf("Loaded plugin %s version %s from %s", plugin.Tool, plugin.Version, plugin.Path)
+	}
+	w.plugins, w.encoders = paths, encoders
+
+	// The plugins are already in use, so a worker listed with stale tools is only logged
+	if w.clientID != "" {
+		if err := internal.UpdateWorkerEncoders(ctx, w.pool, w.clientID, encoders); err != nil {
+			slog.Warn("failed to list reloaded plugins", "error", err)
+		}
+	}
+	return nil
+}
+
+// Close disconnects from the event bus.
+func (w *Worker) Close() error {
+	return w.events.Close()
+}
@@ -0,0 +1,105 @@
+package vtworker
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-libs/deep"
+	"github.com/krelinga/go-libs/exam"
+	"github.com/krelinga/video-transcoder/internal"
+)
+
+// reloadTestWorker returns workers set up from cfg as New would, with ffmpeg and
+// ffprobe found and no plugins, without checking anything on the machine.
+func reloadTestWorker(cfg *Config) *Worker {
+	builtins := []internal.EncoderCheck{
+		{Tool: internal.ToolFFmpeg, Path: "/usr/bin/ffmpeg", Version: "7.1"},
+		{Tool: internal.ToolFFprobe, Path: "/usr/bin/ffprobe", Version: "7.1"},
+	}
+	return &Worker{
+		builtins:  builtins,
+		encoders:  builtins,
+		signerKey: cfg.WebhookSigningKey,
+		transcode: &TranscodeWorker{
+			Mounts:           cfg.Mounts,
+			ProgressInterval: cfg.ProgressInterval,
+			Limits:           cfg.Limits,
+			Tools:            internal.AvailableTools(builtins),
+			ToolVersions:     internal.ToolVersions(builtins),
+		},
+		compare:  &CompareWorker{Limits: cfg.Limits},
+		webhook:  &WebhookWorker{},
+		workflow: &WorkflowStepWorker{},
+	}
+}
+
+func TestWorkerReload(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+
+	// SetupLogging takes over the log package too, so put both back
+	previous, writer, flags := slog.Default(), log.Writer(), log.Flags()
+	defer func() {
+		slog.SetDefault(previous)
+		log.SetOutput(writer)
+		log.SetFlags(flags)
+	}()
+	internal.SetupLogging(slog.LevelInfo)
+
+	w := reloadTestWorker(&Config{Mounts: []string{"/media"}, ProgressInterval: 30 * time.Second})
+	limits := &internal.ProcessLimits{Nice: 10, IOLevel: 4}
+	cfg := &Config{Mounts: []string{"/media", "/archive"}, ProgressInterval: time.Minute, Limits: limits, LogLevel: slog.LevelDebug}
+	exam.Nil(e, env, w.Reload(cfg)).Must()
+	exam.Equal(e, env, []string{"/media", "/archive"}, w.transcode.Mounts)
+	exam.Equal(e, env, time.Minute, w.transcode.ProgressInterval)
+	exam.Equal(e, env, 10, w.transcode.Limits.Nice)
+	exam.Equal(e, env, 10, w.compare.Limits.Nice)
+	exam.Equal(e, env, true, slog.Default().Enabled(context.Background(), slog.LevelDebug))
+
+	// A new signing key is rejected without applying anything else
+	err := w.Reload(&Config{ProgressInterval: time.Hour, WebhookSigningKey: "/keys/webhooks.pem"})
+	exam.NotNil(e, env, err)
+	exam.Equal(e, env, time.Minute, w.transcode.ProgressInterval)
+}
+
+func TestWorkerReloadPlugins(t *testing.T) {
+	e := exam.New(t)
+	env := deep.NewEnv()
+	defer internal.ReloadPlugins(context.Background(), nil)
+
+	dir := t.TempDir()
+	plugin := filepath.Join(dir, "fake")
+	script := `#!/bin/sh
+echo '{"name": "fake", "version": "1.2.3", "profiles": ["x-fake-reload"]}'
+`
+	exam.Nil(e, env, os.WriteFile(plugin, []byte(script), 0o755)).Must()
+
+	w := reloadTestWorker(&Config{ProgressInterval: 30 * time.Second})
+	builtinTools := w.transcode.Tools
+
+	// Jobs started after the reload find the plugin and the tool it needs
+	exam.Nil(e, env, w.Reload(&Config{Plugins: []string{plugin}, ProgressInterval: 30 * time.Second})).Must()
+	exam.Equal(e, env, []string{internal.ToolFFmpeg, internal.ToolFFprobe, "fake"}, w.transcode.Tools)
+	exam.Equal(e, env, "1.2.3", w.transcode.ToolVersions["fake"])
+	exam.Equal(e, env, 3, len(w.encoders))
+	profile := internal.Profile("x-fake-reload")
+	exam.Equal(e, env, true, profile.HasTranscoder())
+	exam.Equal(e, env, 0, len(profile.MissingTools(w.transcode.Tools)))
+
+	// A plugin that doesn't load leaves the loaded ones, and every other setting, alone
+	err := w.Reload(&Config{Plugins: []string{filepath.Join(dir, "missing")}, ProgressInterval: time.Hour})
+	exam.NotNil(e, env, err)
+	exam.Equal(e, env, []string{plugin}, w.plugins)
+	exam.Equal(e, env, true, profile.HasTranscoder())
+	exam.Equal(e, env, 30*time.Second, w.transcode.ProgressInterval)
+
+	exam.Nil(e, env, w.Reload(&Config{ProgressInterval: 30 * time.Second})).Must()
+	exam.Equal(e, env, builtinTools, w.transcode.Tools)
+	exam.Equal(e, env, 2, len(w.encoders))
+	exam.Equal(e, env, false, profile.HasTranscoder())
+}
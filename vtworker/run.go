@@ -0,0 +1,87 @@
+// Package vtworker runs the River workers that transcode videos and deliver webhooks.
+// Run runs them the way the worker binary does; other Go services can instead register
+// a Worker with their own River client.
+package vtworker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/riverdriver/riverpgxv5"
+)
+
+// Run processes jobs until ctx is cancelled, then gives running transcodes
+// cfg.ShutdownGrace to finish before requeueing them.  The schema must already be
+// migrated.
+func Run(ctx context.Context, pool *pgxpool.Pool, cfg *Config) error {
+	worker, err := New(ctx, pool, cfg)
+	if err != nil {
+		return err
+	}
+	defer worker.Close()
+
+	// Create River client with workers
+	workers := river.NewWorkers()
+	worker.Register(workers)
+	riverClient, err := river.NewClient(riverpgxv5.New(pool), &river.Config{
+		Queues:       worker.Queues(),
+		PeriodicJobs: worker.PeriodicJobs(),
+		Schema:       internal.PoolSchema(pool),
+		Workers:      workers,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create river client: %w", err)
+	}
+
+	// List this worker in GET /workers for as long as it runs.  The heartbeat outlives
+	// the shutdown signal so the watchdog doesn't rescue jobs that are still draining.
+	heartbeatCtx, stopHeartbeat := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopHeartbeat()
+	if err := worker.Join(heartbeatCtx, riverClient.ID()); err != nil {
+		return err
+	}
+
+	// Start River client to begin processing jobs.  Jobs outlive the shutdown signal,
+	// since cancelling their context would fail them instead of letting them finish.
+	if err := riverClient.Start(context.WithoutCancel(ctx)); err != nil {
+		return fmt.Errorf("failed to start river client: %w", err)
+	}
+
+	// Reload job, webhook, plugin, and log settings on SIGHUP; the database can't change
+	// without a restart
+	internal.OnReload(ctx, func() {
+		if err := worker.Reload(ConfigFromEnv()); err != nil {
+			slog.Warn("failed to apply reloaded configuration", "error", err)
+		}
+	})
+
+	log.Println("Worker started, waiting for jobs...")
+
+	// Wait for shutdown signal
+	<-ctx.Done()
+	log.Println("Shutdown signal received, shutting down worker gracefully...")
+
+	// Give running transcodes the grace period to finish, then stop and requeue them
+	interruptTimer := time.AfterFunc(cfg.ShutdownGrace, worker.Interrupt)
+	defer interruptTimer.Stop()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownGrace+30*time.Second)
+	defer cancel()
+
+	// Stop River client gracefully
+	if err := riverClient.Stop(shutdownCtx); err != nil {
+		return fmt.Errorf("river client shutdown error: %w", err)
+	}
+	stopHeartbeat()
+	if err := worker.Leave(shutdownCtx, riverClient.ID()); err != nil {
+		slog.Warn("Failed to deregister worker", "error", err)
+	}
+
+	log.Println("Worker shutdown complete")
+	return nil
+}
@@ -0,0 +1,64 @@
+package vtworker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/riverqueue/river"
+)
+
+// WatchdogWorker rescues transcode jobs whose worker died or whose progress stalled.
+type WatchdogWorker struct {
+	river.WorkerDefaults[internal.WatchdogJobArgs]
+	DBPool       *pgxpool.Pool
+	StallTimeout time.Duration
+}
+
+// Work retries or fails the stalled jobs.  Jobs that fail for good get their failure
+// webhooks and cancel their workflow dependents in the same transaction, as if their
+// worker had failed them.
+func (w *WatchdogWorker) Work(ctx context.Context, job *river.Job[internal.WatchdogJobArgs]) error {
+	client := river.ClientFromContext[pgx.Tx](ctx)
+	if client == nil {
+		return fmt.Errorf("no river client in context for rescuing jobs")
+	}
+
+	tx, err := w.DBPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	stalled, err := internal.RescueStalledJobs(ctx, tx, w.StallTimeout)
+	if err != nil {
+		return err
+	}
+	for _, stalledJob := range stalled {
+		slog.Warn("Rescued stalled job", "uuid", stalledJob.Args.UUID, "final", stalledJob.Final, "request_id", stalledJob.RequestID, "error", stalledJob.Error)
+		if !stalledJob.Final {
+			continue
+		}
+		code := internal.ErrorCodeStalled
+		status := internal.TranscodeJobStatus{Error: &stalledJob.Error, ErrorCode: &code}
+		if webhooks := internal.CompletionWebhooks(stalledJob.Args, &status, stalledJob.RequestID); len(webhooks) > 0 {
+			if _, err := client.InsertManyTx(ctx, tx, webhookInsertParams(webhooks, nil)); err != nil {
+				return fmt.Errorf("failed to enqueue webhook job: %w", err)
+			}
+		}
+		if stalledJob.Args.Workflow != nil {
+			if err := internal.AdvanceWorkflow(ctx, tx, client, *stalledJob.Args.Workflow, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
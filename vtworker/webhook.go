@@ -0,0 +1,142 @@
+package vtworker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+)
+
+// WebhookWorker handles webhook notification jobs.
+type WebhookWorker struct {
+	river.WorkerDefaults[internal.WebhookJobArgs]
+	HTTPClient *http.Client
+	// Signer signs deliveries; nil sends them unsigned.
+	Signer *internal.WebhookSigner
+	// Secrets opens sealed webhook tokens; nil if no secrets keys are configured.
+	Secrets internal.KeyWrapper
+
+	// mu guards HTTPClient against Reload while deliveries are starting.
+	mu sync.RWMutex
+}
+
+// Reload makes deliveries started afterwards use client.
+func (w *WebhookWorker) Reload(client *http.Client) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.HTTPClient = client
+}
+
+// Work sends a POST request to the configured webhook URI.
+func (w *WebhookWorker) Work(ctx context.Context, job *river.Job[internal.WebhookJobArgs]) error {
+	impl := func() error {
+		if err := job.Args.OpenSecrets(ctx, w.Secrets); err != nil {
+			return err
+		}
+		payload := vtrest.WebhookPayload{
+			Uuid: job.Args.UUID,
+		}
+		if job.Args.TokenHeader == "" {
+			payload.Token = job.Args.Token
+		}
+		if job.Args.RequestID != "" {
+			payload.RequestId = &job.Args.RequestID
+		}
+		if job.Args.DestinationPath != "" {
+			payload.DestinationPath = &job.Args.DestinationPath
+		}
+		if job.Args.Profile != "" {
+			payload.Profile = (*string)(&job.Args.Profile)
+		}
+		if len(job.Args.Labels) > 0 {
+			payload.Labels = (*vtrest.Labels)(&job.Args.Labels)
+		}
+		if job.Args.Status != nil {
+			payload.Error = job.Args.Status.Error
+			payload.ErrorCode = (*vtrest.ErrorCode)(job.Args.Status.ErrorCode)
+			if job.Args.IsHeartbeat {
+				payload.Progress = &job.Args.Status.Progress
+				payload.Speed = job.Args.Status.Speed
+				payload.EstimatedSecondsRemaining = job.Args.Status.EstimatedSecondsRemaining
+				payload.Frame = job.Args.Status.Frame
+				payload.Fps = job.Args.Status.FPS
+				payload.BitrateKbps = job.Args.Status.BitrateKbps
+			} else {
+				payload.OutputSizeBytes = job.Args.Status.OutputSizeBytes
+				payload.OutputDurationSeconds = job.Args.Status.OutputDurationSeconds
+				payload.EncodeSeconds = job.Args.Status.EncodeSeconds
+				payload.Renditions = internal.RestRenditionStatuses(job.Args.Status.Renditions)
+			}
+		}
+
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to marshal webhook payload: %w", err)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.Args.URI, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("failed to create webhook request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if job.Args.RequestID != "" {
+			req.Header.Set(internal.RequestIDHeader, job.Args.RequestID)
+		}
+		if job.Args.TokenHeader != "" && len(job.Args.Token) > 0 {
+			req.Header.Set(job.Args.TokenHeader, internal.WebhookTokenHeaderValue(job.Args.TokenHeader, job.Args.Token))
+		}
+		w.Signer.Sign(req, body, time.Now())
+
+		w.mu.RLock()
+		client := w.HTTPClient
+		w.mu.RUnlock()
+		if client == nil {
+			client = &http.Client{Timeout: 30 * time.Second}
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to send webhook request: %w", err)
+		}
+		defer resp.Body.Close()
+
+		// Record the status code now, since River only keeps recorded output for jobs that complete
+		output := internal.WebhookDeliveryOutput{StatusCode: resp.StatusCode}
+		if client := river.ClientFromContext[pgx.Tx](ctx); client != nil {
+			if _, err := client.JobUpdate(ctx, job.ID, &river.JobUpdateParams{Output: output}); err != nil {
+				slog.Warn("failed to record webhook status code", "error", err)
+			}
+		}
+		if err := river.RecordOutput(ctx, output); err != nil {
+			slog.Warn("failed to record webhook output", "error", err)
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("webhook request failed with status %d", resp.StatusCode)
+		}
+
+		return nil
+	}
+	err := impl()
+	errString := "OK"
+	if err != nil {
+		errString = err.Error()
+	}
+	log.Printf("Webhook send for URI: %s, uuid: %s, status %v, error: %s, request_id: %s", job.Args.URI, job.Args.UUID, job.Args.Status, errString, job.Args.RequestID)
+	// Retrying a blocked host would only be blocked again
+	if errors.Is(err, internal.ErrWebhookHostNotAllowed) {
+		return river.JobCancel(err)
+	}
+	return err
+}
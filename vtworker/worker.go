@@ -0,0 +1,770 @@
+package vtworker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/riverqueue/river"
+)
+
+// missingEncoderSnooze is how long a job whose encoder isn't installed here waits for
+// another worker to pick it up.
+const missingEncoderSnooze = 30 * time.Second
+
+// softCancelInterval is how often a running job checks whether it has been soft-cancelled.
+const softCancelInterval = 5 * time.Second
+
+// scratchQuotaInterval is how often a running job checks the size of the scratch directory.
+const scratchQuotaInterval = 10 * time.Second
+
+// errJobRescued cancels a transcode that the watchdog has taken back as stalled.
+var errJobRescued = errors.New("job was rescued by the watchdog")
+
+// errWorkerShutdown cancels a transcode still running when the shutdown grace period ends.
+var errWorkerShutdown = fmt.Errorf("worker is shutting down: %w", internal.ErrGracefulStop)
+
+// errSoftCancelled cancels a transcode whose job was soft-cancelled, keeping the
+// output written so far.
+var errSoftCancelled = fmt.Errorf("job was cancelled, keeping the partial output: %w", internal.ErrGracefulStop)
+
+// TranscodeWorker handles video transcoding jobs.
+type TranscodeWorker struct {
+	river.WorkerDefaults[internal.TranscodeJobArgs]
+	DBPool *pgxpool.Pool
+	// Mounts lists media mount points verified before each job runs.
+	Mounts []string
+	// ProgressInterval is how often progress is recorded, unless overridden by the job.
+	ProgressInterval time.Duration
+	// HeartbeatMinDelta is how far progress must advance between heartbeat webhooks.
+	HeartbeatMinDelta float64
+	// Limits controls the resources available to encoder subprocesses.
+	Limits *internal.ProcessLimits
+	// Sandbox restricts what encoder subprocesses can do; nil runs them unsandboxed.
+	Sandbox *internal.Sandbox
+	// Output is the default ownership and mode of output files; nil leaves them as written.
+	Output *internal.OutputOwnership
+	// Scratch holds the intermediate files of jobs; nil keeps them next to the outputs.
+	Scratch *internal.ScratchDir
+	// Events receives job lifecycle events; nil publishes nothing.
+	Events internal.EventPublisher
+	// Tools are the encoding tools that ran at startup and the plugins now loaded.
+	Tools []string
+	// ToolVersions are the versions of Tools, recorded in the status of each job.
+	ToolVersions map[string]string
+
+	// mu guards the settings above against Reload and reloadPlugins while jobs are
+	// starting.
+	mu sync.RWMutex
+
+	// interrupt is closed by Interrupt to stop running transcodes.
+	interrupt     chan struct{}
+	interruptOnce sync.Once
+}
+
+// Interrupt stops running transcodes, which record their progress and are requeued
+// for another worker.  It is called once the shutdown grace period ends.
+func (w *TranscodeWorker) Interrupt() {
+	w.interruptOnce.Do(func() {
+		close(w.interrupt)
+	})
+}
+
+// watchForInterrupt cancels the transcode with errWorkerShutdown if the worker is
+// interrupted before it finishes.
+func (w *TranscodeWorker) watchForInterrupt(ctx context.Context, cancel context.CancelCauseFunc) {
+	select {
+	case <-w.interrupt:
+		cancel(errWorkerShutdown)
+	case <-ctx.Done():
+	}
+}
+
+// Reload applies the reloadable settings in cfg.  Jobs already running keep the
+// settings they started with.
+func (w *TranscodeWorker) Reload(cfg *internal.WorkerConfig) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.Mounts = cfg.Mounts
+	w.ProgressInterval = cfg.ProgressInterval
+	w.HeartbeatMinDelta = cfg.HeartbeatMinDelta
+	w.Limits = cfg.Limits
+	w.Output = cfg.Output
+}
+
+// reloadPlugins replaces the loaded plugins with the ones at paths, and Tools and
+// ToolVersions with those of builtins, the encoders found at startup, and the new
+// plugins.  It returns both.  Jobs already running keep the plugin they started with.
+func (w *TranscodeWorker) reloadPlugins(ctx context.Context, paths []string, builtins []internal.EncoderCheck) ([]internal.EncoderCheck, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	plugins, err := internal.ReloadPlugins(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	encoders := slices.Concat(builtins, plugins)
+	w.Tools = internal.AvailableTools(encoders)
+	w.ToolVersions = internal.ToolVersions(encoders)
+	return encoders, nil
+}
+
+// Work executes the transcoding job using the appropriate transcoder.
+func (w *TranscodeWorker) Work(ctx context.Context, job *river.Job[internal.TranscodeJobArgs]) error {
+	args := job.Args
+	requestID := internal.ParseJobMetadata(job.Metadata).RequestID
+	log.Printf("Starting transcode uuid: %s, attempt: %d, request_id: %s", args.UUID, job.Attempt, requestID)
+
+	// The transcoder is made along with the tools it needs, since reloadPlugins swaps both
+	w.mu.RLock()
+	mounts, progressInterval, heartbeatMinDelta, limits, output := w.Mounts, w.ProgressInterval, w.HeartbeatMinDelta, w.Limits, w.Output
+	hasTranscoder, missing := args.Profile.HasTranscoder(), args.Profile.MissingTools(w.Tools)
+	var transcoder internal.Transcoder
+	if hasTranscoder && len(missing) == 0 {
+		transcoder = internal.NewTranscoder(args.Profile)
+	}
+	toolVersions := args.Profile.ToolVersions(w.ToolVersions)
+	w.mu.RUnlock()
+
+	// Snoozing doesn't use up attempts, so the job waits for a worker with the encoder
+	if !hasTranscoder {
+		log.Printf("Snoozing transcode uuid: %s, no plugin on this worker encodes profile %s", args.UUID, args.Profile)
+		return river.JobSnooze(missingEncoderSnooze)
+	}
+	if len(missing) > 0 {
+		log.Printf("Snoozing transcode uuid: %s, profile %s needs %v, which this worker doesn't have", args.UUID, args.Profile, missing)
+		return river.JobSnooze(missingEncoderSnooze)
+	}
+
+	w.publish(ctx, job, internal.JobEventStarted, nil)
+
+	// Refuse to run if media mounts are missing, rather than writing into an empty local directory
+	if err := internal.CheckMounts(mounts); err != nil {
+		errMsg := err.Error()
+		status := internal.TranscodeJobStatus{Error: &errMsg}
+		return w.fail(ctx, job, &status, err)
+	}
+
+	// A missing source won't appear on retry, so catch it before starting the encoder
+	if _, err := os.Stat(args.SourcePath); err != nil {
+		err = fmt.Errorf("source not accessible: %w", err)
+		errMsg := err.Error()
+		status := internal.TranscodeJobStatus{Error: &errMsg}
+		return w.fail(ctx, job, &status, err)
+	}
+
+	// Leave outputs from an earlier submission alone if they check out
+	if args.SkipIfValid {
+		if err := internal.VerifyExistingOutputs(ctx, w.Sandbox, args); err != nil {
+			log.Printf("Transcoding uuid: %s, existing outputs didn't pass verification: %v", args.UUID, err)
+		} else {
+			log.Printf("Skipping transcode uuid: %s, its outputs already exist and pass verification", args.UUID)
+			status := w.outputStatus(ctx, args)
+			status.SkippedExisting = true
+			return w.complete(ctx, job, &status)
+		}
+	}
+
+	// Hand back the outputs of an identical job instead of encoding them again
+	var sourceSHA256, reuseKey string
+	if args.ReuseCompleted {
+		var err error
+		if sourceSHA256, err = internal.HashSource(ctx, args.SourcePath); err == nil {
+			reuseKey, err = args.ReuseKey(sourceSHA256)
+		}
+		if err != nil {
+			errMsg := err.Error()
+			status := internal.TranscodeJobStatus{Error: &errMsg}
+			return w.fail(ctx, job, &status, err)
+		}
+		if previous, err := internal.FindReusableJob(ctx, w.DBPool, job.ID, args, reuseKey); err != nil {
+			// Encoding again is always correct, just slower
+			slog.Warn("failed to look for a reusable job", "uuid", args.UUID, "error", err)
+		} else if previous != nil {
+			log.Printf("Reusing the outputs of uuid: %s for uuid: %s", previous.UUID, args.UUID)
+			status := previous.Status
+			status.ReusedFrom = &previous.UUID
+			status.EncodeSeconds = nil
+			return w.complete(ctx, job, &status)
+		}
+	}
+
+	// Encoding in segments lets a retry resume from the ones already encoded
+	if args.Profile.SupportsParallelSegments() {
+		parallel := 1
+		if args.ParallelSegments != nil {
+			parallel = *args.ParallelSegments
+		}
+		transcoder = internal.NewSegmentedTranscoder(transcoder, parallel)
+	}
+	transcoder = internal.NewPerTitleTranscoder(transcoder)
+	transcoder = internal.NewCaptionTranscoder(transcoder)
+	transcoder = internal.NewChapterTranscoder(transcoder)
+
+	// Track progress updates for throttling
+	lastUpdateTime := time.Now()
+	lastProgress := 0.0
+	updateInterval := progressInterval
+	if args.HeartbeatIntervalSeconds != nil {
+		updateInterval = time.Duration(*args.HeartbeatIntervalSeconds) * time.Second
+	}
+	firstHeartbeatSent := false
+	lastHeartbeatProgress := 0.0
+	hasHeartbeats := args.HasHeartbeatWebhooks()
+	transcodeStart := time.Now()
+	maxProgress, progressAt := 0.0, transcodeStart
+	var position time.Duration
+	commands := &internal.CommandRecorder{}
+
+	progressCallback := func(progress internal.Progress) {
+		currentProgress := progress.Percent
+		if currentProgress > maxProgress {
+			maxProgress, progressAt = currentProgress, time.Now()
+		}
+		position = max(position, progress.Position)
+
+		// Determine if we should send an update:
+		// - For heartbeat webhooks: always send the first one immediately, then every updateInterval
+		// - For regular progress: every updateInterval
+		shouldUpdate := time.Since(lastUpdateTime) >= updateInterval
+		needsFirstHeartbeat := hasHeartbeats && !firstHeartbeatSent
+
+		if shouldUpdate || needsFirstHeartbeat {
+			status := internal.TranscodeJobStatus{
+				Progress:     currentProgress,
+				ProgressAt:   &progressAt,
+				ToolVersions: toolVersions,
+				Commands:     commands.Commands(),
+			}
+			if progress.Speed > 0 {
+				status.Speed = &progress.Speed
+			}
+			if progress.Frame > 0 {
+				status.Frame = &progress.Frame
+			}
+			if progress.FPS > 0 {
+				status.FPS = &progress.FPS
+			}
+			if progress.BitrateKbps > 0 {
+				status.BitrateKbps = &progress.BitrateKbps
+			}
+			if position > 0 {
+				seconds := position.Seconds()
+				status.PositionSeconds = &seconds
+			}
+			status.Renditions = args.RenditionStatuses(currentProgress)
+			if remaining, ok := estimateRemaining(time.Since(transcodeStart), currentProgress); ok {
+				seconds := remaining.Seconds()
+				status.EstimatedSecondsRemaining = &seconds
+			}
+
+			w.publish(ctx, job, internal.JobEventProgress, &status)
+
+			// If heartbeat webhooks are configured, enqueue them atomically with job output
+			// update, skipping the ones that would repeat the last heartbeat's progress
+			if hasHeartbeats && (needsFirstHeartbeat || currentProgress-lastHeartbeatProgress >= heartbeatMinDelta) {
+				if err := w.enqueueHeartbeatWebhook(ctx, job, &status); err != nil {
+					// Log but don't fail the job on heartbeat webhook errors
+					slog.Warn("failed to enqueue heartbeat webhook", "error", err)
+				} else {
+					firstHeartbeatSent = true
+					lastHeartbeatProgress = currentProgress
+				}
+			} else {
+				// No heartbeat webhook, just record output
+				if err := river.RecordOutput(ctx, status); err != nil {
+					// Log but don't fail the job on progress update errors
+					slog.Warn("failed to record output", "error", err)
+					return
+				}
+			}
+			lastUpdateTime = time.Now()
+			lastProgress = currentProgress
+		}
+	}
+
+	// Keep intermediate files in the job's own scratch directory, which survives retries
+	var scratchDir string
+	if w.Scratch != nil {
+		var err error
+		if scratchDir, err = w.Scratch.JobDir(args.UUID); err != nil {
+			errMsg := err.Error()
+			status := internal.TranscodeJobStatus{Error: &errMsg}
+			return w.fail(ctx, job, &status, err)
+		}
+	}
+
+	// Record the encoder output for GET /transcodes/{uuid}/log
+	jobLog := internal.NewJobLogger(w.DBPool, job.ID, job.Attempt)
+
+	params := internal.TranscodeParams{
+		SourcePath:       args.SourcePath,
+		DestinationPath:  args.DestinationPath,
+		ProgressCallback: progressCallback,
+		LogCallback:      jobLog.Line,
+		Limits:           limits,
+		Sandbox:          w.Sandbox,
+		Audio:            args.Audio,
+		Subtitles:        args.Subtitles,
+		Video:            args.Video,
+		Streams:          args.Streams,
+		Animation:        args.Animation,
+		HandBrake:        args.HandBrake,
+		Chapters:         args.Chapters,
+		Renditions:       args.Renditions,
+		ScratchDir:       scratchDir,
+	}
+
+	// Stop if the watchdog decides the job has stalled and retries it elsewhere
+	transcodeCtx, cancelTranscode := context.WithCancelCause(internal.WithCommandRecorder(ctx, commands))
+	defer cancelTranscode(nil)
+	go w.watchForRescue(transcodeCtx, cancelTranscode, job)
+	go w.watchForInterrupt(transcodeCtx, cancelTranscode)
+	go w.watchForSoftCancel(transcodeCtx, cancelTranscode, job)
+	if w.Scratch != nil && w.Scratch.QuotaBytes > 0 {
+		go w.watchScratchQuota(transcodeCtx, cancelTranscode)
+	}
+
+	err := transcoder.Transcode(transcodeCtx, params)
+	jobLog.Close()
+	if cause := context.Cause(transcodeCtx); errors.Is(cause, internal.ErrScratchQuotaExceeded) {
+		err = cause
+	}
+	interrupted := errors.Is(context.Cause(transcodeCtx), errWorkerShutdown)
+	// A soft cancel that arrives as the encoder finishes doesn't undo its success
+	softCancelled := err != nil && errors.Is(context.Cause(transcodeCtx), errSoftCancelled)
+	if scratchDir != "" && !interrupted {
+		// Keep the intermediate files only for a retry that can resume from them
+		final := err == nil || softCancelled || job.Attempt >= job.MaxAttempts
+		if !final {
+			_, final = internal.ClassifyFailure(err)
+		}
+		if final {
+			if err := os.RemoveAll(scratchDir); err != nil {
+				slog.Warn("failed to remove scratch directory", "uuid", args.UUID, "error", err)
+			}
+		}
+	}
+	if errors.Is(context.Cause(transcodeCtx), errJobRescued) {
+		// The watchdog already recorded the failure and took care of webhooks.
+		log.Printf("Abandoned transcode uuid: %s, attempt: %d after the watchdog rescued it", args.UUID, job.Attempt)
+		return errJobRescued
+	}
+	if interrupted {
+		// Record where the encoder got to, rather than the last periodic update
+		pausedAt := time.Now()
+		status := internal.TranscodeJobStatus{
+			Progress:     maxProgress,
+			ProgressAt:   &progressAt,
+			PausedAt:     &pausedAt,
+			Renditions:   args.RenditionStatuses(maxProgress),
+			ToolVersions: toolVersions,
+			Commands:     commands.Commands(),
+		}
+		if position > 0 {
+			seconds := position.Seconds()
+			status.PositionSeconds = &seconds
+		}
+		return w.requeue(ctx, job, status)
+	}
+	if softCancelled {
+		return w.softCancel(ctx, job, args.Output.Or(output), maxProgress, position, time.Since(transcodeStart), toolVersions, commands.Commands())
+	}
+	if err != nil {
+		errMsg := err.Error()
+		encodeSeconds := time.Since(transcodeStart).Seconds()
+		status := internal.TranscodeJobStatus{
+			Progress:      lastProgress,
+			EncodeSeconds: &encodeSeconds,
+			Error:         &errMsg,
+			ToolVersions:  toolVersions,
+			Commands:      commands.Commands(),
+		}
+		return w.fail(ctx, job, &status, fmt.Errorf("transcoding failed: %w", err))
+	}
+
+	// Hand the outputs to their configured owner before anyone is told they're ready
+	if ownership := args.Output.Or(output); ownership != nil {
+		paths, err := args.OutputPaths()
+		if err == nil {
+			err = ownership.Apply(paths)
+		}
+		if err != nil {
+			errMsg := err.Error()
+			encodeSeconds := time.Since(transcodeStart).Seconds()
+			status := internal.TranscodeJobStatus{
+				Progress:      100.0,
+				EncodeSeconds: &encodeSeconds,
+				Error:         &errMsg,
+				ToolVersions:  toolVersions,
+				Commands:      commands.Commands(),
+			}
+			return w.fail(ctx, job, &status, err)
+		}
+	}
+
+	// Record final success status
+	encodeSeconds := time.Since(transcodeStart).Seconds()
+	if err := internal.RecordProfileStats(ctx, w.DBPool, w.Sandbox, args, encodeSeconds); err != nil {
+		// Only estimates depend on the stats
+		slog.Warn("failed to record profile stats", "uuid", args.UUID, "error", err)
+	}
+	status := w.outputStatus(ctx, args)
+	status.EncodeSeconds = &encodeSeconds
+	status.ToolVersions = toolVersions
+	status.Commands = commands.Commands()
+	status.SourceSHA256, status.ReuseKey = sourceSHA256, reuseKey
+	return w.complete(ctx, job, &status)
+}
+
+// outputStatus returns the status of a job whose outputs have been written, with the
+// size and duration of each output.
+func (w *TranscodeWorker) outputStatus(ctx context.Context, args internal.TranscodeJobArgs) internal.TranscodeJobStatus {
+	status := internal.TranscodeJobStatus{Progress: 100.0}
+	switch args.Profile {
+	case internal.ProfileABR:
+		// The segments are spread over many files, so only the ladder is reported.
+		status.Renditions = args.RenditionStatuses(100.0)
+	case internal.ProfileRenditions:
+		status.Renditions = args.RenditionStatuses(100.0)
+		for i := range status.Renditions {
+			rendition := &status.Renditions[i]
+			if output, err := internal.ProbeOutput(ctx, w.Sandbox, rendition.DestinationPath); err != nil {
+				slog.Warn("failed to probe rendition", "rendition", rendition.Name, "error", err)
+			} else {
+				outputSeconds := output.Duration.Seconds()
+				rendition.OutputSizeBytes = &output.SizeBytes
+				rendition.OutputDurationSeconds = &outputSeconds
+			}
+		}
+	default:
+		if output, err := internal.ProbeOutput(ctx, w.Sandbox, args.DestinationPath); err != nil {
+			// Log but don't fail the job; the output was written successfully
+			slog.Warn("failed to probe output", "error", err)
+		} else {
+			outputSeconds := output.Duration.Seconds()
+			status.OutputSizeBytes = &output.SizeBytes
+			status.OutputDurationSeconds = &outputSeconds
+		}
+	}
+	return status
+}
+
+// requeue records the progress of a transcode stopped by the worker shutting down, and
+// snoozes it so another worker picks it up straight away.  Snoozing doesn't use up an
+// attempt, unlike failing.
+func (w *TranscodeWorker) requeue(ctx context.Context, job *river.Job[internal.TranscodeJobArgs], status internal.TranscodeJobStatus) error {
+	// Snoozed jobs don't keep recorded output, so update it directly
+	if client := river.ClientFromContext[pgx.Tx](ctx); client != nil {
+		if _, err := client.JobUpdate(ctx, job.ID, &river.JobUpdateParams{Output: status}); err != nil {
+			slog.Warn("failed to record progress of interrupted job", "error", err)
+		}
+	}
+	log.Printf("Requeued transcode uuid: %s, attempt: %d at %.1f%% because the worker is shutting down", job.Args.UUID, job.Attempt, status.Progress)
+	return river.JobSnooze(0)
+}
+
+// softCancel reports the outputs a soft-cancelled encoder finalized and cancels the job.
+func (w *TranscodeWorker) softCancel(ctx context.Context, job *river.Job[internal.TranscodeJobArgs], ownership *internal.OutputOwnership, progress float64, position, elapsed time.Duration, toolVersions map[string]string, commands []string) error {
+	if ownership != nil {
+		paths, err := job.Args.OutputPaths()
+		if err == nil {
+			err = ownership.Apply(paths)
+		}
+		if err != nil {
+			slog.Warn("failed to set ownership of partial output", "uuid", job.Args.UUID, "error", err)
+		}
+	}
+
+	status := w.outputStatus(ctx, job.Args)
+	status.Progress = progress
+	if position > 0 {
+		seconds := position.Seconds()
+		status.PositionSeconds = &seconds
+	}
+	// Segmented encodes can't be finalized part of the way through
+	status.Partial = status.OutputSizeBytes != nil
+	for _, rendition := range status.Renditions {
+		status.Partial = status.Partial || rendition.OutputSizeBytes != nil
+	}
+	errMsg := errSoftCancelled.Error()
+	encodeSeconds := elapsed.Seconds()
+	status.Error = &errMsg
+	status.EncodeSeconds = &encodeSeconds
+	status.ToolVersions = toolVersions
+	status.Commands = commands
+	log.Printf("Soft-cancelled transcode uuid: %s at %.1f%%, partial output kept: %t", job.Args.UUID, progress, status.Partial)
+	return w.fail(ctx, job, &status, errSoftCancelled)
+}
+
+// complete records the final status of a successful job, and notifies its webhooks
+// and the workflow steps waiting on it.
+func (w *TranscodeWorker) complete(ctx context.Context, job *river.Job[internal.TranscodeJobArgs], status *internal.TranscodeJobStatus) error {
+	if err := river.RecordOutput(ctx, status); err != nil {
+		// Log but don't fail the job on final progress update error
+		slog.Warn("failed to record final output", "error", err)
+	}
+	w.publish(ctx, job, internal.JobEventCompleted, status)
+
+	// Enqueue webhook jobs if any webhook wants completions, and the comparison if
+	// the job asked for one, and start the workflow steps waiting on this one
+	compare := internal.CompareJobArgsFor(job.Args)
+	if webhooks := internal.CompletionWebhooks(job.Args, status, internal.ParseJobMetadata(job.Metadata).RequestID); len(webhooks) > 0 || compare != nil || job.Args.Workflow != nil {
+		if err := w.enqueueWebhooks(ctx, job, status, webhooks, compare); err != nil {
+			return fmt.Errorf("failed to enqueue webhook: %w", err)
+		}
+		return nil // Job completed via transaction
+	}
+
+	return nil
+}
+
+// publish emits a lifecycle event for job.  Publishing is best effort; failures are
+// logged and never fail the job.
+func (w *TranscodeWorker) publish(ctx context.Context, job *river.Job[internal.TranscodeJobArgs], eventType internal.JobEventType, status *internal.TranscodeJobStatus) {
+	if w.Events == nil {
+		return
+	}
+	event := internal.JobEvent{
+		Type:       eventType,
+		UUID:       job.Args.UUID,
+		OccurredAt: time.Now(),
+		Attempt:    job.Attempt,
+		RequestID:  internal.ParseJobMetadata(job.Metadata).RequestID,
+		Labels:     job.Args.Labels,
+		Status:     status,
+	}
+	if err := w.Events.Publish(ctx, event); err != nil {
+		slog.Warn("failed to publish event", "type", eventType, "uuid", job.Args.UUID, "error", err)
+	}
+}
+
+// estimateRemaining extrapolates the time left from the elapsed time and progress percentage,
+// assuming the encoding rate stays constant.
+func estimateRemaining(elapsed time.Duration, percent float64) (time.Duration, bool) {
+	if percent <= 0 || percent > 100 {
+		return 0, false
+	}
+	return time.Duration(float64(elapsed) * (100 - percent) / percent).Round(time.Second), true
+}
+
+// fail records the error status for a failed attempt and classifies err.  Transient
+// failures with attempts remaining return err so River retries.  Otherwise the failure
+// is final: the failed event is published, and if any webhook wants failures, the
+// webhooks are enqueued and the job is completed, and if not, permanent failures are
+// cancelled so River doesn't retry them.  Final failures of workflow steps also cancel
+// the steps that depend on them.
+func (w *TranscodeWorker) fail(ctx context.Context, job *river.Job[internal.TranscodeJobArgs], status *internal.TranscodeJobStatus, err error) error {
+	code, permanent := internal.ClassifyFailure(err)
+	cancelled := errors.Is(context.Cause(ctx), river.ErrJobCancelledRemotely)
+	// Soft cancels are final, but this worker has to cancel the job itself
+	if errors.Is(err, errSoftCancelled) {
+		permanent = true
+	}
+	if cancelled || errors.Is(err, errSoftCancelled) {
+		c := internal.ErrorCodeCancelled
+		code = &c
+	}
+	if status.ErrorCode == nil {
+		status.ErrorCode = code
+	}
+
+	// Record error status
+	_ = river.RecordOutput(ctx, status)
+
+	// River finalizes remotely cancelled jobs itself
+	if cancelled {
+		w.publish(context.WithoutCancel(ctx), job, internal.JobEventFailed, status)
+		w.failWorkflow(ctx, job)
+		return err
+	}
+
+	if !permanent && job.Attempt < job.MaxAttempts {
+		slog.Warn("Transient failure, will retry", "uuid", job.Args.UUID, "attempt", job.Attempt, "max_attempts", job.MaxAttempts, "error", err)
+		return err
+	}
+
+	// Only final failures are published, so consumers see one failed event per job
+	w.publish(context.WithoutCancel(ctx), job, internal.JobEventFailed, status)
+
+	// Enqueue webhook jobs if any webhook wants failures
+	if webhooks := internal.CompletionWebhooks(job.Args, status, internal.ParseJobMetadata(job.Metadata).RequestID); len(webhooks) > 0 {
+		if err := w.enqueueWebhooks(ctx, job, status, webhooks, nil); err != nil {
+			return fmt.Errorf("failed to enqueue webhook: %w", err)
+		}
+		return nil // Job completed via transaction
+	}
+	w.failWorkflow(ctx, job)
+
+	if permanent {
+		return river.JobCancel(err)
+	}
+	return err
+}
+
+// enqueueWebhooks inserts webhook jobs, and compare if it isn't nil, in the same
+// transaction that completes this job, which also advances the job's workflow if it has one.
+func (w *TranscodeWorker) enqueueWebhooks(ctx context.Context, job *river.Job[internal.TranscodeJobArgs], status *internal.TranscodeJobStatus, webhooks []internal.WebhookJobArgs, compare *internal.CompareJobArgs) error {
+	inserts := webhookInsertParams(webhooks, nil)
+	if compare != nil {
+		inserts = append(inserts, river.InsertManyParams{Args: *compare, InsertOpts: &river.InsertOpts{Metadata: job.Metadata}})
+	}
+	err := completeWorkflowJob(ctx, w.DBPool, job, inserts, job.Args.Workflow, status.Error == nil)
+	errString := "OK"
+	if err != nil {
+		errString = err.Error()
+	}
+	log.Printf("Webhook enqueue for URIs: %s, uuid: %s, status %v, error: %s, request_id: %s", webhookURIs(webhooks), job.Args.UUID, status, errString, internal.ParseJobMetadata(job.Metadata).RequestID)
+	return err
+}
+
+// watchForRescue cancels the transcode with errJobRescued once the watchdog has taken
+// this attempt of the job back, so a hung encoder doesn't keep running alongside the retry.
+func (w *TranscodeWorker) watchForRescue(ctx context.Context, cancel context.CancelCauseFunc, job *river.Job[internal.TranscodeJobArgs]) {
+	ticker := time.NewTicker(internal.WorkerHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rescued, err := internal.JobRescued(ctx, w.DBPool, job.ID, job.Attempt)
+			if err != nil {
+				slog.Warn("failed to check whether job was rescued", "uuid", job.Args.UUID, "error", err)
+				continue
+			}
+			if rescued {
+				cancel(errJobRescued)
+				return
+			}
+		}
+	}
+}
+
+// watchForSoftCancel cancels the transcode with errSoftCancelled once the job has been
+// soft-cancelled, so the encoder finalizes its output and exits.
+func (w *TranscodeWorker) watchForSoftCancel(ctx context.Context, cancel context.CancelCauseFunc, job *river.Job[internal.TranscodeJobArgs]) {
+	ticker := time.NewTicker(softCancelInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			requested, err := internal.SoftCancelRequested(ctx, w.DBPool, job.ID)
+			if err != nil {
+				slog.Warn("failed to check whether job was soft-cancelled", "uuid", job.Args.UUID, "error", err)
+				continue
+			}
+			if requested {
+				cancel(errSoftCancelled)
+				return
+			}
+		}
+	}
+}
+
+// watchScratchQuota cancels the transcode with an error wrapping
+// internal.ErrScratchQuotaExceeded once the scratch directory grows past its quota.
+func (w *TranscodeWorker) watchScratchQuota(ctx context.Context, cancel context.CancelCauseFunc) {
+	ticker := time.NewTicker(scratchQuotaInterval)
+	defer ticker.Stop()
+	for {
+		if err := w.Scratch.CheckQuota(); errors.Is(err, internal.ErrScratchQuotaExceeded) {
+			cancel(err)
+			return
+		} else if err != nil {
+			slog.Warn("failed to check scratch quota", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// failWorkflow cancels the workflow steps depending on a job that has failed for good.
+func (w *TranscodeWorker) failWorkflow(ctx context.Context, job *river.Job[internal.TranscodeJobArgs]) {
+	if job.Args.Workflow == nil {
+		return
+	}
+	if err := failWorkflowJob(context.WithoutCancel(ctx), w.DBPool, *job.Args.Workflow); err != nil {
+		slog.Warn("failed to cancel dependents of workflow step", "step", job.Args.Workflow.Step, "workflow", job.Args.Workflow.ID, "error", err)
+	}
+}
+
+// enqueueHeartbeatWebhook inserts heartbeat webhook jobs atomically with updating the job output.
+// Unlike completion webhooks, heartbeat webhooks use MaxAttempts=1 (no retries) since
+// another progress update will follow shortly.  The transaction is still retried while
+// the database is unavailable, so a Postgres restart doesn't lose the job's progress.
+func (w *TranscodeWorker) enqueueHeartbeatWebhook(ctx context.Context, job *river.Job[internal.TranscodeJobArgs], status *internal.TranscodeJobStatus) error {
+	impl := func() error {
+		webhooks := internal.HeartbeatWebhooks(job.Args, status, internal.ParseJobMetadata(job.Metadata).RequestID)
+
+		// Start a transaction to insert webhook job and update job output atomically
+		tx, err := w.DBPool.Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to begin transaction: %w", err)
+		}
+		defer tx.Rollback(ctx)
+
+		// Get River client from context
+		client := river.ClientFromContext[pgx.Tx](ctx)
+		if client == nil {
+			return fmt.Errorf("no river client in context for heartbeat webhook job insertion")
+		}
+
+		// Insert webhook jobs within transaction with no retries
+		insertOpts := &river.InsertOpts{MaxAttempts: 1}
+		if _, err := client.InsertManyTx(ctx, tx, webhookInsertParams(webhooks, insertOpts)); err != nil {
+			return fmt.Errorf("failed to enqueue heartbeat webhook job: %w", err)
+		}
+
+		// Update the current job's output within the same transaction
+		outputParams := &river.JobUpdateParams{Output: status}
+		if _, err := client.JobUpdateTx(ctx, tx, job.ID, outputParams); err != nil {
+			return fmt.Errorf("failed to update job output in transaction: %w", err)
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			return fmt.Errorf("failed to commit transaction: %w", err)
+		}
+
+		return nil
+	}
+	err := internal.RetryDB(ctx, impl)
+	errString := "OK"
+	if err != nil {
+		errString = err.Error()
+	}
+	slog.Debug("Heartbeat webhook enqueue", "uuid", job.Args.UUID, "status", status, "error", errString, "request_id", internal.ParseJobMetadata(job.Metadata).RequestID)
+	return err
+}
+
+// webhookInsertParams converts webhook job args into River bulk insert parameters.
+func webhookInsertParams(webhooks []internal.WebhookJobArgs, opts *river.InsertOpts) []river.InsertManyParams {
+	params := make([]river.InsertManyParams, len(webhooks))
+	for i, webhook := range webhooks {
+		params[i] = river.InsertManyParams{Args: webhook, InsertOpts: opts}
+	}
+	return params
+}
+
+// webhookURIs returns the destination URIs of webhooks, for logging.
+func webhookURIs(webhooks []internal.WebhookJobArgs) string {
+	uris := make([]string, len(webhooks))
+	for i, webhook := range webhooks {
+		uris[i] = webhook.URI
+	}
+	return strings.Join(uris, ", ")
+}
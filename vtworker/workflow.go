@@ -0,0 +1,245 @@
+package vtworker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtrest"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/riverdriver/riverpgxv5"
+)
+
+// errVerificationFailed is returned by verify steps whose output doesn't match its source.
+var errVerificationFailed = errors.New("output verification failed")
+
+// WorkflowStepWorker handles the probe, verify, and webhook steps of workflows.
+type WorkflowStepWorker struct {
+	river.WorkerDefaults[internal.WorkflowStepJobArgs]
+	DBPool     *pgxpool.Pool
+	HTTPClient *http.Client
+	// Sandbox restricts what probes of outputs can do; nil runs them unsandboxed.
+	Sandbox *internal.Sandbox
+	// Signer signs webhook deliveries; nil sends them unsigned.
+	Signer *internal.WebhookSigner
+	// Secrets opens sealed webhook tokens; nil if no secrets keys are configured.
+	Secrets internal.KeyWrapper
+
+	// mu guards HTTPClient against Reload while deliveries are starting.
+	mu sync.RWMutex
+}
+
+// Reload makes webhook steps started afterwards use client.
+func (w *WorkflowStepWorker) Reload(client *http.Client) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.HTTPClient = client
+}
+
+// Work runs the step and advances its workflow.
+func (w *WorkflowStepWorker) Work(ctx context.Context, job *river.Job[internal.WorkflowStepJobArgs]) error {
+	args := job.Args
+	log.Printf("Starting workflow step %s (%s) of workflow %s, attempt: %d", args.Workflow.Step, args.Type, args.Workflow.ID, job.Attempt)
+
+	var status internal.WorkflowStepStatus
+	var err error
+	switch args.Type {
+	case internal.WorkflowStepProbe:
+		_, err = w.probeStep(ctx, args.Path, &status)
+	case internal.WorkflowStepVerify:
+		err = w.verify(ctx, args, &status)
+	case internal.WorkflowStepWebhook:
+		err = w.notify(ctx, job)
+	default:
+		err = fmt.Errorf("unknown workflow step type %q", args.Type)
+	}
+	if err != nil {
+		return w.fail(ctx, job, &status, err)
+	}
+
+	if err := river.RecordOutput(ctx, status); err != nil {
+		slog.Warn("failed to record workflow step output", "error", err)
+	}
+	if err := completeWorkflowJob(ctx, w.DBPool, job, nil, &args.Workflow, true); err != nil {
+		return fmt.Errorf("failed to advance workflow: %w", err)
+	}
+	return nil
+}
+
+// fail records the error for a failed attempt.  Transient failures with attempts
+// remaining are retried; otherwise the steps depending on this one are cancelled.
+func (w *WorkflowStepWorker) fail(ctx context.Context, job *river.Job[internal.WorkflowStepJobArgs], status *internal.WorkflowStepStatus, err error) error {
+	code, permanent := internal.ClassifyFailure(err)
+	permanent = permanent || errors.Is(err, errVerificationFailed) || errors.Is(err, internal.ErrWebhookHostNotAllowed)
+	errMsg := err.Error()
+	status.Error = &errMsg
+	status.ErrorCode = code
+	_ = river.RecordOutput(ctx, status)
+
+	cancelled := errors.Is(context.Cause(ctx), river.ErrJobCancelledRemotely)
+	if !cancelled && !permanent && job.Attempt < job.MaxAttempts {
+		slog.Warn("Transient failure of workflow step, will retry", "step", job.Args.Workflow.Step, "workflow", job.Args.Workflow.ID, "attempt", job.Attempt, "max_attempts", job.MaxAttempts, "error", err)
+		return err
+	}
+
+	if advanceErr := failWorkflowJob(context.WithoutCancel(ctx), w.DBPool, job.Args.Workflow); advanceErr != nil {
+		slog.Warn("failed to cancel dependents of workflow step", "step", job.Args.Workflow.Step, "workflow", job.Args.Workflow.ID, "error", advanceErr)
+	}
+	if permanent && !cancelled {
+		return river.JobCancel(err)
+	}
+	return err
+}
+
+// probeStep checks that path exists and decodes, recording its size and duration.
+func (w *WorkflowStepWorker) probeStep(ctx context.Context, path string, status *internal.WorkflowStepStatus) (*internal.OutputInfo, error) {
+	output, err := internal.ProbeOutput(ctx, w.Sandbox, path)
+	if err != nil {
+		return nil, err
+	}
+	seconds := output.Duration.Seconds()
+	status.DurationSeconds = &seconds
+	status.SizeBytes = &output.SizeBytes
+	return output, nil
+}
+
+// verify probes the output of a transcode step and, if the step has a source, checks
+// that the output's duration matches it.
+func (w *WorkflowStepWorker) verify(ctx context.Context, args internal.WorkflowStepJobArgs, status *internal.WorkflowStepStatus) error {
+	output, err := w.probeStep(ctx, args.Path, status)
+	if err != nil {
+		return err
+	}
+	if args.SourcePath == "" {
+		return nil
+	}
+	source, err := internal.ProbeOutput(ctx, w.Sandbox, args.SourcePath)
+	if err != nil {
+		return err
+	}
+	if !internal.DurationsMatch(source.Duration, output.Duration) {
+		return fmt.Errorf("%w: output is %s but source is %s", errVerificationFailed, output.Duration, source.Duration)
+	}
+	return nil
+}
+
+// notify posts a workflow webhook payload to the step's URI.
+func (w *WorkflowStepWorker) notify(ctx context.Context, job *river.Job[internal.WorkflowStepJobArgs]) error {
+	if err := job.Args.OpenSecrets(ctx, w.Secrets); err != nil {
+		return err
+	}
+	payload := vtrest.WorkflowWebhookPayload{
+		WorkflowId: job.Args.Workflow.ID,
+		Step:       job.Args.Workflow.Step,
+		Token:      job.Args.Token,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal workflow webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.Args.URI, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create workflow webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if requestID := internal.ParseJobMetadata(job.Metadata).RequestID; requestID != "" {
+		req.Header.Set(internal.RequestIDHeader, requestID)
+	}
+	w.Signer.Sign(req, body, time.Now())
+
+	w.mu.RLock()
+	client := w.HTTPClient
+	w.mu.RUnlock()
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send workflow webhook request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("workflow webhook request failed with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// completeWorkflowJob completes job in a transaction that also inserts follow-up jobs,
+// such as webhooks, and,
+// if the job is part of a workflow, starts or cancels the steps that depend on it.
+// Doing all three together means a worker dying between them can't lose a webhook or
+// leave the rest of the workflow pending forever.  The transaction is retried while the
+// database is unavailable, so a Postgres restart doesn't fail work that is already done.
+func completeWorkflowJob[T river.JobArgs](ctx context.Context, pool *pgxpool.Pool, job *river.Job[T], inserts []river.InsertManyParams, workflow *internal.WorkflowRef, succeeded bool) error {
+	return internal.RetryDB(ctx, func() error {
+		return completeWorkflowJobTx(ctx, pool, job, inserts, workflow, succeeded)
+	})
+}
+
+func completeWorkflowJobTx[T river.JobArgs](ctx context.Context, pool *pgxpool.Pool, job *river.Job[T], inserts []river.InsertManyParams, workflow *internal.WorkflowRef, succeeded bool) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	client := river.ClientFromContext[pgx.Tx](ctx)
+	if client == nil {
+		return fmt.Errorf("no river client in context for completing job")
+	}
+
+	if len(inserts) > 0 {
+		if _, err := client.InsertManyTx(ctx, tx, inserts); err != nil {
+			return fmt.Errorf("failed to enqueue follow-up jobs: %w", err)
+		}
+	}
+
+	if _, err := river.JobCompleteTx[*riverpgxv5.Driver](ctx, tx, job); err != nil {
+		return fmt.Errorf("failed to complete job in transaction: %w", err)
+	}
+
+	if workflow != nil {
+		if err := internal.AdvanceWorkflow(ctx, tx, client, *workflow, succeeded); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// failWorkflowJob cancels the steps depending on a step that has failed for good.
+// The step's own job is finalized by River once the worker returns.
+func failWorkflowJob(ctx context.Context, pool *pgxpool.Pool, workflow internal.WorkflowRef) error {
+	client := river.ClientFromContext[pgx.Tx](ctx)
+	if client == nil {
+		return fmt.Errorf("no river client in context for advancing workflow")
+	}
+	return internal.RetryDB(ctx, func() error {
+		tx, err := pool.Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to begin transaction: %w", err)
+		}
+		defer tx.Rollback(ctx)
+		if err := internal.AdvanceWorkflow(ctx, tx, client, workflow, false); err != nil {
+			return err
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return fmt.Errorf("failed to commit transaction: %w", err)
+		}
+		return nil
+	})
+}
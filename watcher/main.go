@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtclient"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatalf("watcher error: %v", err)
+	}
+}
+
+func run() error {
+	// Create context that listens for shutdown signals
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Load configuration
+	cfg := internal.NewWatcherConfigFromEnv()
+
+	// Create API client
+	var opts []vtclient.Option
+	if cfg.APIKey != "" {
+		opts = append(opts, vtclient.WithAPIKey(cfg.APIKey))
+	}
+	client, err := vtclient.New(cfg.ServerURL, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	watcher := NewWatcher(cfg, client)
+
+	log.Printf("Watcher started, scanning %d director(ies) every %s...", len(cfg.Dirs), cfg.ScanInterval)
+
+	ticker := time.NewTicker(cfg.ScanInterval)
+	defer ticker.Stop()
+	for {
+		watcher.Scan(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			log.Println("Shutdown signal received, shutting down gracefully...")
+			log.Println("Watcher shutdown complete")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
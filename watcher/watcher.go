@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krelinga/video-transcoder/internal"
+	"github.com/krelinga/video-transcoder/vtclient"
+	"github.com/krelinga/video-transcoder/vtrest"
+)
+
+// watchNamespace is the UUID namespace used to derive job UUIDs from source files,
+// so rescans and watcher restarts resubmit the same UUID and are rejected as duplicates.
+var watchNamespace = uuid.MustParse("6f0b8f36-3f0e-4c1a-9a59-2c0f4a3b7d21")
+
+// fileState tracks a candidate source file between scans.
+type fileState struct {
+	size      int64
+	modTime   time.Time
+	changedAt time.Time
+	submitted bool
+}
+
+// Watcher scans watched directories and submits transcode jobs for new files.
+type Watcher struct {
+	cfg    *internal.WatcherConfig
+	client *vtclient.Client
+	files  map[string]*fileState
+}
+
+// NewWatcher creates a new Watcher instance.
+func NewWatcher(cfg *internal.WatcherConfig, client *vtclient.Client) *Watcher {
+	return &Watcher{
+		cfg:    cfg,
+		client: client,
+		files:  make(map[string]*fileState),
+	}
+}
+
+// Scan walks every watched directory once, submitting files that have settled.
+func (w *Watcher) Scan(ctx context.Context, now time.Time) {
+	seen := make(map[string]bool)
+	for _, dir := range w.cfg.Dirs {
+		err := filepath.WalkDir(dir.Source, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				log.Printf("failed to scan %s: %v", path, err)
+				return nil
+			}
+			if d.IsDir() {
+				// Don't pick up our own outputs when a destination is nested inside a source.
+				if path != dir.Source && w.isDestination(path) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !slices.Contains(w.cfg.Extensions, strings.ToLower(filepath.Ext(path))) {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				log.Printf("failed to stat %s: %v", path, err)
+				return nil
+			}
+			seen[path] = true
+			w.observe(ctx, dir, path, info, now)
+			return nil
+		})
+		if err != nil {
+			log.Printf("failed to scan %s: %v", dir.Source, err)
+		}
+	}
+
+	// Forget files that have disappeared so they are picked up again if they return.
+	for path := range w.files {
+		if !seen[path] {
+			delete(w.files, path)
+		}
+	}
+}
+
+// isDestination reports whether path is one of the configured destination directories.
+func (w *Watcher) isDestination(path string) bool {
+	for _, dir := range w.cfg.Dirs {
+		if filepath.Clean(dir.Destination) == filepath.Clean(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// observe records the latest state of a file and submits it once it has settled.
+func (w *Watcher) observe(ctx context.Context, dir internal.WatchDir, path string, info fs.FileInfo, now time.Time) {
+	state, ok := w.files[path]
+	if !ok || state.size != info.Size() || !state.modTime.Equal(info.ModTime()) {
+		// New or still being written; restart the debounce period.
+		w.files[path] = &fileState{size: info.Size(), modTime: info.ModTime(), changedAt: now}
+		return
+	}
+	if state.submitted || now.Sub(state.changedAt) < w.cfg.Settle {
+		return
+	}
+
+	if err := w.submit(ctx, dir, path, info); err != nil {
+		log.Printf("failed to submit %s: %v", path, err)
+		return
+	}
+	state.submitted = true
+}
+
+// submit creates a transcode job for the given source file.
+func (w *Watcher) submit(ctx context.Context, dir internal.WatchDir, path string, info fs.FileInfo) error {
+	rel, err := filepath.Rel(dir.Source, path)
+	if err != nil {
+		return fmt.Errorf("failed to compute relative path: %w", err)
+	}
+	destination := filepath.Join(dir.Destination, strings.TrimSuffix(rel, filepath.Ext(rel))+w.cfg.OutputExtension)
+
+	// Modified files get a new UUID; unchanged files map to the already-submitted job.
+	jobUUID := uuid.NewSHA1(watchNamespace, fmt.Appendf(nil, "%s\n%d\n%d", path, info.Size(), info.ModTime().UnixNano()))
+
+	_, err = w.client.Submit(ctx, vtrest.TranscodeRequest{
+		Uuid:            jobUUID,
+		SourcePath:      path,
+		DestinationPath: destination,
+		Profile:         string(w.cfg.Profile),
+	})
+	var apiErr *vtclient.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict {
+		log.Printf("Already submitted %s as %s", path, jobUUID)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	log.Printf("Submitted %s -> %s as %s", path, destination, jobUUID)
+	return nil
+}